@@ -21,6 +21,9 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause"
+	msgpausekeeper "github.com/hyphacoop/cosmos-enoki/x/msgpause/keeper"
 )
 
 type HandlerOptions struct {
@@ -36,6 +39,7 @@ type HandlerOptions struct {
 	TXCounterStoreService  corestoretypes.KVStoreService
 	TxFeeChecker           ante.TxFeeChecker
 	FeeMarketKeeper        *feemarketkeeper.Keeper
+	MsgPauseKeeper         *msgpausekeeper.Keeper
 }
 
 // NewAnteHandler returns an ante handler responsible for attempting to route an
@@ -59,11 +63,16 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.New("feemarket handler is required for ante builder")
 	}
 
+	if options.MsgPauseKeeper == nil {
+		return nil, errors.New("msgpause keeper is required for ante builder")
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit), // after setup context to enforce limits early
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreService),
 		ante.NewValidateBasicDecorator(),
+		msgpause.NewPauseDecorator(*options.MsgPauseKeeper), // before any fee is charged
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
@@ -1,11 +1,11 @@
 package app
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
-	"path/filepath"
 	"sort"
 	"strings"
 
@@ -44,12 +44,55 @@ import (
 	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
+	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	tokenfactory "github.com/cosmos/tokenfactory/x/tokenfactory"
 	tokenfactorybindings "github.com/cosmos/tokenfactory/x/tokenfactory/bindings"
 	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
 	enokiante "github.com/hyphacoop/cosmos-enoki/app/ante"
+	icabindings "github.com/hyphacoop/cosmos-enoki/wasmbinding/ica"
+	nftbindings "github.com/hyphacoop/cosmos-enoki/wasmbinding/nft"
+	tokenpolicybindings "github.com/hyphacoop/cosmos-enoki/wasmbinding/tokenpolicy"
+	"github.com/hyphacoop/cosmos-enoki/x/channelallowlist"
+	channelallowlistkeeper "github.com/hyphacoop/cosmos-enoki/x/channelallowlist/keeper"
+	channelallowlisttypes "github.com/hyphacoop/cosmos-enoki/x/channelallowlist/types"
+	channelallowlistv2 "github.com/hyphacoop/cosmos-enoki/x/channelallowlist/v2"
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata"
+	denommetadatakeeper "github.com/hyphacoop/cosmos-enoki/x/denommetadata/keeper"
+	denommetadatatypes "github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+	denommetadatav2 "github.com/hyphacoop/cosmos-enoki/x/denommetadata/v2"
+	"github.com/hyphacoop/cosmos-enoki/x/govica"
+	govicakeeper "github.com/hyphacoop/cosmos-enoki/x/govica/keeper"
+	govicatypes "github.com/hyphacoop/cosmos-enoki/x/govica/types"
+	"github.com/hyphacoop/cosmos-enoki/x/lightclientquerier"
+	lightclientquerierkeeper "github.com/hyphacoop/cosmos-enoki/x/lightclientquerier/keeper"
+	lightclientqueriertypes "github.com/hyphacoop/cosmos-enoki/x/lightclientquerier/types"
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause"
+	msgpausekeeper "github.com/hyphacoop/cosmos-enoki/x/msgpause/keeper"
+	msgpausetypes "github.com/hyphacoop/cosmos-enoki/x/msgpause/types"
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer"
+	nfttransferkeeper "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/keeper"
+	nfttransfertypes "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard"
+	paramguardkeeper "github.com/hyphacoop/cosmos-enoki/x/paramguard/keeper"
+	paramguardtypes "github.com/hyphacoop/cosmos-enoki/x/paramguard/types"
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery"
+	pfmrecoverykeeper "github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/keeper"
+	pfmrecoverytypes "github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/types"
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives"
+	relayerincentiveskeeper "github.com/hyphacoop/cosmos-enoki/x/relayerincentives/keeper"
+	relayerincentivestypes "github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+	relayerincentivesv2 "github.com/hyphacoop/cosmos-enoki/x/relayerincentives/v2"
+	"github.com/hyphacoop/cosmos-enoki/x/soloallowlist"
+	soloallowlistkeeper "github.com/hyphacoop/cosmos-enoki/x/soloallowlist/keeper"
+	soloallowlisttypes "github.com/hyphacoop/cosmos-enoki/x/soloallowlist/types"
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy"
+	tokenpolicykeeper "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/keeper"
+	tokenpolicytypes "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+	tokenpolicyv2 "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/v2"
+	"github.com/hyphacoop/cosmos-enoki/x/tokenregistry"
+	tokenregistrytypes "github.com/hyphacoop/cosmos-enoki/x/tokenregistry/types"
 	"github.com/skip-mev/feemarket/x/feemarket"
 	feemarketkeeper "github.com/skip-mev/feemarket/x/feemarket/keeper"
 	feemarketpost "github.com/skip-mev/feemarket/x/feemarket/post"
@@ -68,6 +111,9 @@ import (
 	"cosmossdk.io/x/feegrant"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+	nftmodule "cosmossdk.io/x/nft/module"
 	"cosmossdk.io/x/upgrade"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
@@ -186,18 +232,20 @@ var (
 
 // module account permissions
 var maccPerms = map[string][]string{
-	authtypes.FeeCollectorName:      nil,
-	distrtypes.ModuleName:           nil,
-	icatypes.ModuleName:             nil,
-	minttypes.ModuleName:            {authtypes.Minter},
-	stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
-	stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
-	govtypes.ModuleName:             {authtypes.Burner},
-	ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
-	wasmtypes.ModuleName:            {authtypes.Burner},
-	feemarkettypes.ModuleName:       nil,
-	feemarkettypes.FeeCollectorName: nil,
-	tokenfactorytypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+	authtypes.FeeCollectorName:        nil,
+	distrtypes.ModuleName:             nil,
+	icatypes.ModuleName:               nil,
+	minttypes.ModuleName:              {authtypes.Minter},
+	stakingtypes.BondedPoolName:       {authtypes.Burner, authtypes.Staking},
+	stakingtypes.NotBondedPoolName:    {authtypes.Burner, authtypes.Staking},
+	govtypes.ModuleName:               {authtypes.Burner},
+	ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+	wasmtypes.ModuleName:              {authtypes.Burner},
+	feemarkettypes.ModuleName:         nil,
+	feemarkettypes.FeeCollectorName:   nil,
+	tokenfactorytypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
+	nft.ModuleName:                    nil,
+	relayerincentivestypes.ModuleName: nil,
 }
 
 var (
@@ -219,6 +267,9 @@ type EnokiApp struct {
 	txConfig          client.TxConfig
 	interfaceRegistry types.InterfaceRegistry
 
+	// VM running the 08-wasm light clients
+	ibcWasmVM *wasmvm.VM
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tkeys   map[string]*storetypes.TransientStoreKey
@@ -236,6 +287,7 @@ type EnokiApp struct {
 	AuthzKeeper           authzkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
+	NFTKeeper             nftkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	IBCKeeper           *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
@@ -249,8 +301,19 @@ type EnokiApp struct {
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 	RatelimitKeeper     ratelimitkeeper.Keeper
 
-	FeeMarketKeeper    *feemarketkeeper.Keeper
-	TokenFactoryKeeper tokenfactorykeeper.Keeper
+	FeeMarketKeeper          *feemarketkeeper.Keeper
+	TokenFactoryKeeper       tokenfactorykeeper.Keeper
+	GovICAKeeper             govicakeeper.Keeper
+	DenomMetadataKeeper      denommetadatakeeper.Keeper
+	ChannelAllowlistKeeper   channelallowlistkeeper.Keeper
+	SoloAllowlistKeeper      soloallowlistkeeper.Keeper
+	LightClientQuerierKeeper lightclientquerierkeeper.Keeper
+	PFMRecoveryKeeper        pfmrecoverykeeper.Keeper
+	NFTTransferKeeper        nfttransferkeeper.Keeper
+	RelayerIncentivesKeeper  relayerincentiveskeeper.Keeper
+	TokenPolicyKeeper        tokenpolicykeeper.Keeper
+	ParamGuardKeeper         paramguardkeeper.Keeper
+	MsgPauseKeeper           msgpausekeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -335,6 +398,7 @@ func NewEnokiApp(
 		feegrant.StoreKey,
 		evidencetypes.StoreKey,
 		authzkeeper.StoreKey,
+		nft.StoreKey,
 		// non sdk store keys
 		ibcexported.StoreKey,
 		ibctransfertypes.StoreKey,
@@ -344,6 +408,17 @@ func NewEnokiApp(
 		ratelimittypes.StoreKey,
 		feemarkettypes.StoreKey,
 		tokenfactorytypes.StoreKey,
+		govicatypes.StoreKey,
+		denommetadatatypes.StoreKey,
+		channelallowlisttypes.StoreKey,
+		soloallowlisttypes.StoreKey,
+		lightclientqueriertypes.StoreKey,
+		pfmrecoverytypes.StoreKey,
+		nfttransfertypes.StoreKey,
+		relayerincentivestypes.StoreKey,
+		tokenpolicytypes.StoreKey,
+		paramguardtypes.StoreKey,
+		msgpausetypes.StoreKey,
 		wasmtypes.StoreKey,
 		ibcwasmtypes.StoreKey,
 	)
@@ -458,6 +533,13 @@ func NewEnokiApp(
 		app.AccountKeeper,
 	)
 
+	app.NFTKeeper = nftkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[nft.StoreKey]),
+		appCodec,
+		app.AccountKeeper,
+		app.BankKeeper,
+	)
+
 	// get skipUpgradeHeights from the app options
 	skipUpgradeHeights := map[int64]bool{}
 	for _, h := range cast.ToIntSlice(appOpts.Get(server.FlagUnsafeSkipUpgrades)) {
@@ -482,7 +564,8 @@ func NewEnokiApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	// Create the tokenfactory keeper
+	// Create the tokenfactory keeper. Force transfers and burns from other
+	// accounts are compiled in and enabled per denom by the tokenpolicy module.
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		appCodec,
 		app.keys[tokenfactorytypes.StoreKey],
@@ -493,11 +576,26 @@ func NewEnokiApp(
 		[]string{
 			tokenfactorytypes.EnableSetMetadata,
 			tokenfactorytypes.EnableCommunityPoolFeeFunding,
+			tokenfactorytypes.EnableForceTransfer,
+			tokenfactorytypes.EnableBurnFrom,
 		},
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	wasmOpts = append(wasmOpts, tokenfactorybindings.RegisterCustomPlugins(app.BankKeeper, &app.TokenFactoryKeeper)...)
 
+	// The wasm keeper calling before send hook contracts is created below, the
+	// tokenpolicy keeper only uses it once the app runs.
+	app.TokenPolicyKeeper = tokenpolicykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[tokenpolicytypes.StoreKey]),
+		app.TokenFactoryKeeper,
+		app.BankKeeper,
+		&app.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.BankKeeper.AppendSendRestriction(app.TokenPolicyKeeper.SendRestriction)
+	wasmOpts = append(wasmOpts, tokenpolicybindings.RegisterCustomPlugins(app.TokenPolicyKeeper)...)
+
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[ibcexported.StoreKey]),
@@ -506,27 +604,37 @@ func NewEnokiApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.LightClientQuerierKeeper = lightclientquerierkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[lightclientqueriertypes.StoreKey]),
+		bApp.GRPCQueryRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	wasmLightClientQuerier := ibcwasmkeeper.QueryPlugins{
-		// Custom: MyCustomQueryPlugin(),
-		// `myAcceptList` is a `[]string` containing the list of gRPC query paths that the chain wants to allow for the `08-wasm` module to query.
-		// These queries must be registered in the chain's gRPC query router, be deterministic, and track their gas usage.
-		// The `AcceptListStargateQuerier` function will return a query plugin that will only allow queries for the paths in the `myAcceptList`.
+		// The gRPC query paths the `08-wasm` module may query are stored in the
+		// lightclientquerier params and updated by governance. The queries must
+		// be registered in the chain's gRPC query router and be deterministic.
 		// The query responses are encoded in protobuf unlike the implementation in `x/wasm`.
-		Stargate: ibcwasmkeeper.AcceptListStargateQuerier([]string{
-			"/ibc.core.client.v1.Query/ClientState",
-			"/ibc.core.client.v1.Query/ConsensusState",
-			"/ibc.core.connection.v1.Query/Connection",
-		}, bApp.GRPCQueryRouter()),
-		Custom: blsverifier.CustomQuerier(),
+		Stargate: app.LightClientQuerierKeeper.StargateQuerier(),
+		Custom:   blsverifier.CustomQuerier(),
 	}
 
-	dataDir := filepath.Join(homePath, "data")
-
-	var memCacheSizeMB uint32 = 100
-	lc08, err := wasmvm.NewVM(filepath.Join(dataDir, "08-light-client"), wasmkeeper.BuiltInCapabilities(), 32, false, memCacheSizeMB)
+	ibcWasmConfig, err := ReadIBCWasmConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading ibc-wasm config: %s", err))
+	}
+	lc08, err := wasmvm.NewVM(
+		ibcWasmConfig.VMDir(homePath),
+		wasmkeeper.BuiltInCapabilities(),
+		ibcWasmConfig.InstanceMemoryLimit,
+		ibcWasmConfig.Debug,
+		ibcWasmConfig.MemoryCacheSize,
+	)
 	if err != nil {
 		panic(fmt.Sprintf("failed to create VM for 08 light client: %s", err))
 	}
+	app.ibcWasmVM = lc08
 
 	app.WasmClientKeeper = ibcwasmkeeper.NewKeeperWithVM(
 		appCodec,
@@ -538,6 +646,21 @@ func NewEnokiApp(
 		ibcwasmkeeper.WithQueryPlugins(&wasmLightClientQuerier),
 	)
 
+	app.ParamGuardKeeper = paramguardkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[paramguardtypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// paused messages are refused by the router wherever they come from, the
+	// circuit is set before the modules register their services
+	app.MsgPauseKeeper = msgpausekeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[msgpausetypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	app.MsgServiceRouter().SetCircuit(app.MsgPauseKeeper)
+
 	govConfig := govtypes.DefaultConfig()
 	govConfig.MaxMetadataLen = 20000
 	govKeeper := govkeeper.NewKeeper(
@@ -547,7 +670,8 @@ func NewEnokiApp(
 		app.BankKeeper,
 		app.StakingKeeper,
 		app.DistrKeeper,
-		app.MsgServiceRouter(),
+		// proposal messages are checked against the parameter bounds
+		paramguard.NewMsgRouter(app.MsgServiceRouter(), app.ParamGuardKeeper),
 		govConfig,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -598,7 +722,23 @@ func NewEnokiApp(
 	ratelimitParamsKeeper.Subspace(ratelimittypes.ModuleName).WithKeyTable(ratelimittypes.ParamKeyTable())
 	ratelimitSubspace, _ := ratelimitParamsKeeper.GetSubspace(ratelimittypes.ModuleName)
 
-	// Create the ratelimit keeper
+	app.ChannelAllowlistKeeper = channelallowlistkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[channelallowlisttypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.SoloAllowlistKeeper = soloallowlistkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[soloallowlisttypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Create the ratelimit keeper, outgoing transfers are checked against the
+	// channel allowlist and the tokenfactory denom policies before reaching
+	// core IBC
 	app.RatelimitKeeper = *ratelimitkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[ratelimittypes.StoreKey]),
@@ -607,7 +747,10 @@ func NewEnokiApp(
 		app.BankKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.ClientKeeper,
-		app.IBCKeeper.ChannelKeeper,
+		channelallowlist.NewICS4Wrapper(
+			tokenpolicy.NewICS4Wrapper(app.IBCKeeper.ChannelKeeper, app.TokenPolicyKeeper),
+			app.ChannelAllowlistKeeper,
+		),
 	)
 
 	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
@@ -619,6 +762,14 @@ func NewEnokiApp(
 		app.MsgServiceRouter(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	wasmOpts = append(wasmOpts, icabindings.RegisterCustomPlugins(appCodec, &app.ICAControllerKeeper)...)
+
+	app.GovICAKeeper = govicakeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[govicatypes.StoreKey]),
+		&app.ICAControllerKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	// Create the packetfoward keeper
 	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
@@ -646,6 +797,42 @@ func NewEnokiApp(
 	// Must be called on PFMRouter AFTER TransferKeeper initialized
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 
+	app.PFMRecoveryKeeper = pfmrecoverykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[pfmrecoverytypes.StoreKey]),
+		runtime.NewKVStoreService(keys[packetforwardtypes.StoreKey]),
+		app.PacketForwardKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ConnectionKeeper,
+		app.IBCKeeper.ClientKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.DenomMetadataKeeper = denommetadatakeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[denommetadatatypes.StoreKey]),
+		app.BankKeeper,
+		app.TransferKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[nfttransfertypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper,
+		app.NFTKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	wasmOpts = append(wasmOpts, nftbindings.RegisterCustomPlugins(app.NFTKeeper, app.NFTTransferKeeper)...)
+
+	app.RelayerIncentivesKeeper = relayerincentiveskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[relayerincentivestypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	wasmDir := homePath
 	wasmConfig, err := wasm.ReadNodeConfig(appOpts)
 	if err != nil {
@@ -679,17 +866,23 @@ func NewEnokiApp(
 
 	// Create Transfer Stack (from bottom to top of stack)
 	// - core IBC
+	// - relayer incentives (added with the route)
+	// - channel allowlist
+	// - token policy
 	// - ratelimit
+	// - pfm recovery
 	// - pfm
 	// - callbacks
+	// - denom metadata
 	// - transfer
 	//
 	// This is how transfer stack will work in the end:
-	// * RecvPacket -> IBC core -> RateLimit -> PFM -> Callbacks -> Transfer (AddRoute)
-	// * SendPacket -> Transfer -> Callbacks -> PFM -> RateLimit -> IBC core (ICS4Wrapper)
+	// * RecvPacket -> IBC core -> RelayerIncentives -> ChannelAllowlist -> TokenPolicy -> RateLimit -> PFMRecovery -> PFM -> Callbacks -> DenomMetadata -> Transfer (AddRoute)
+	// * SendPacket -> Transfer -> Callbacks -> PFM -> RateLimit -> ChannelAllowlist -> TokenPolicy -> IBC core (ICS4Wrapper)
 
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = denommetadata.NewIBCMiddleware(transferStack, app.DenomMetadataKeeper)
 	cbStack := ibccallbacks.NewIBCMiddleware(transferStack, app.PacketForwardKeeper, wasmStackIBCHandler, MaxIBCCallbackGas)
 
 	transferStack = packetforward.NewIBCMiddleware(
@@ -699,33 +892,54 @@ func NewEnokiApp(
 		0,
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 	)
+	transferStack = pfmrecovery.NewIBCMiddleware(transferStack, app.PFMRecoveryKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(app.RatelimitKeeper, transferStack)
+	transferStack = tokenpolicy.NewIBCMiddleware(transferStack, app.TokenPolicyKeeper)
+	transferStack = channelallowlist.NewIBCMiddleware(transferStack, app.ChannelAllowlistKeeper)
 	app.TransferKeeper.WithICS4Wrapper(cbStack)
 
 	// Create ICAHost Stack
 	var icaHostStack porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
 
 	// Create ICAController Stack
-	var icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
+	// - the governance owned accounts are tracked by the govica module
+	// - contract owned accounts registered through the wasm bindings receive
+	//   typed sudo callbacks from the authentication module
+	// - all other accounts keep using the ibc-callbacks middleware
+	icaContractAuthModule := icabindings.NewIBCModule(app.WasmKeeper, MaxIBCCallbackGas)
+	icaAuthModule := govica.NewIBCModule(app.GovICAKeeper, icaContractAuthModule)
+	var icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddlewareWithAuth(icaAuthModule, app.ICAControllerKeeper)
 	icaControllerStack = ibccallbacks.NewIBCMiddleware(icaControllerStack, app.IBCKeeper.ChannelKeeper,
 		wasmStackIBCHandler, MaxIBCCallbackGas)
 	icaICS4Wrapper := icaControllerStack.(porttypes.ICS4Wrapper)
 	app.ICAControllerKeeper.WithICS4Wrapper(icaICS4Wrapper)
 	wasmStack := wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper)
 
+	// Create the ICS-721 NFT transfer application for x/nft classes. It does
+	// not escrow or mint cw721 tokens: contracts implementing ICS-721 for cw721
+	// collections keep using their own wasm port through the wasm route.
+	var nftTransferStack porttypes.IBCModule = nfttransfer.NewIBCModule(app.NFTTransferKeeper)
+
 	// Create IBCv2 Transfer Stack
 	var transferStackV2 ibcapi.IBCModule
 	transferStackV2 = transferv2.NewIBCModule(app.TransferKeeper)
+	transferStackV2 = denommetadatav2.NewIBCMiddleware(transferStackV2, app.DenomMetadataKeeper)
 	transferStackV2 = ibccallbacksv2.NewIBCMiddleware(transferStackV2, app.IBCKeeper.ChannelKeeperV2,
 		wasmStackIBCHandler, app.IBCKeeper.ChannelKeeperV2, MaxIBCCallbackGas)
 	transferStackV2 = ratelimitv2.NewIBCMiddleware(app.RatelimitKeeper, transferStackV2)
+	transferStackV2 = tokenpolicyv2.NewIBCMiddleware(transferStackV2, app.TokenPolicyKeeper)
+	transferStackV2 = channelallowlistv2.NewIBCMiddleware(transferStackV2, app.ChannelAllowlistKeeper)
+	transferStackV2 = relayerincentivesv2.NewIBCMiddleware(transferStackV2, app.RelayerIncentivesKeeper)
 
-	// Create static IBC router, add app routes, then set and seal it
+	// Create static IBC router, add app routes, then set and seal it. Every
+	// route records its relayers for the relayer incentives directly below
+	// core IBC.
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(icahosttypes.SubModuleName, icaHostStack)
-	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icaControllerStack)
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
-	ibcRouter.AddRoute(wasmtypes.ModuleName, wasmStack)
+	ibcRouter.AddRoute(icahosttypes.SubModuleName, relayerincentives.NewIBCMiddleware(icaHostStack, app.RelayerIncentivesKeeper))
+	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, relayerincentives.NewIBCMiddleware(icaControllerStack, app.RelayerIncentivesKeeper))
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, relayerincentives.NewIBCMiddleware(transferStack, app.RelayerIncentivesKeeper))
+	ibcRouter.AddRoute(wasmtypes.ModuleName, relayerincentives.NewIBCMiddleware(wasmStack, app.RelayerIncentivesKeeper))
+	ibcRouter.AddRoute(nfttransfertypes.PortID, relayerincentives.NewIBCMiddleware(nftTransferStack, app.RelayerIncentivesKeeper))
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// Create IBCv2 Router & seal
@@ -744,6 +958,14 @@ func NewEnokiApp(
 	tmLightClientModule := ibctm.NewLightClientModule(appCodec, clientKeeper.GetStoreProvider())
 	clientKeeper.AddRoute(ibctm.ModuleName, &tmLightClientModule)
 
+	// Solo machine clients are limited to governance allowed public keys
+	smLightClientModule := soloallowlist.NewLightClientModule(
+		appCodec,
+		solomachine.NewLightClientModule(appCodec, clientKeeper.GetStoreProvider()),
+		app.SoloAllowlistKeeper,
+	)
+	clientKeeper.AddRoute(solomachine.ModuleName, &smLightClientModule)
+
 	// Create WASM Light Client Stack
 	wasmLightClientModule := ibcwasm.NewLightClientModule(app.WasmClientKeeper, clientKeeper.GetStoreProvider())
 	clientKeeper.AddRoute(ibcwasmtypes.ModuleName, &wasmLightClientModule)
@@ -770,6 +992,7 @@ func NewEnokiApp(
 		evidence.NewAppModule(app.EvidenceKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.IBCKeeper),
 		ibcwasm.NewAppModule(app.WasmClientKeeper),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
@@ -782,8 +1005,24 @@ func NewEnokiApp(
 		packetforward.NewAppModule(app.PacketForwardKeeper, nil),
 		ratelimit.NewAppModule(appCodec, app.RatelimitKeeper),
 		feemarket.NewAppModule(appCodec, *app.FeeMarketKeeper),
-		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, nil),
+		tokenpolicy.NewTokenFactoryAppModule(
+			tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, nil),
+			app.TokenFactoryKeeper,
+			app.TokenPolicyKeeper,
+		),
+		govica.NewAppModule(app.GovICAKeeper),
+		denommetadata.NewAppModule(app.DenomMetadataKeeper),
+		channelallowlist.NewAppModule(app.ChannelAllowlistKeeper),
+		soloallowlist.NewAppModule(app.SoloAllowlistKeeper),
+		lightclientquerier.NewAppModule(app.LightClientQuerierKeeper),
+		pfmrecovery.NewAppModule(app.PFMRecoveryKeeper),
+		nfttransfer.NewAppModule(app.NFTTransferKeeper),
+		relayerincentives.NewAppModule(app.RelayerIncentivesKeeper),
+		tokenpolicy.NewAppModule(app.TokenPolicyKeeper),
+		paramguard.NewAppModule(app.ParamGuardKeeper),
+		msgpause.NewAppModule(app.MsgPauseKeeper),
 		ibctm.NewAppModule(tmLightClientModule),
+		solomachine.NewAppModule(smLightClientModule.LightClientModule),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -794,6 +1033,7 @@ func NewEnokiApp(
 		app.ModuleManager,
 		map[string]module.AppModuleBasic{
 			genutiltypes.ModuleName: genutil.NewAppModuleBasic(genutiltypes.DefaultMessageValidator),
+			icatypes.ModuleName:     icaModuleBasic{},
 		})
 	app.BasicModuleManager.RegisterLegacyAminoCodec(legacyAmino)
 	app.BasicModuleManager.RegisterInterfaces(interfaceRegistry)
@@ -811,6 +1051,8 @@ func NewEnokiApp(
 	app.ModuleManager.SetOrderBeginBlockers(
 		stakingtypes.ModuleName,
 		minttypes.ModuleName,
+		// takes its fee share before distribution allocates the fees
+		relayerincentivestypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -832,6 +1074,7 @@ func NewEnokiApp(
 		consensusparamtypes.ModuleName,
 		wasmtypes.ModuleName,
 		ibcwasmtypes.ModuleName,
+		msgpausetypes.ModuleName,
 	)
 
 	app.ModuleManager.SetOrderEndBlockers(
@@ -842,6 +1085,7 @@ func NewEnokiApp(
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
 		packetforwardtypes.ModuleName,
+		relayerincentivestypes.ModuleName,
 		ratelimittypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
@@ -882,9 +1126,21 @@ func NewEnokiApp(
 		ibctransfertypes.ModuleName,
 		ibcexported.ModuleName,
 		icatypes.ModuleName,
+		govicatypes.ModuleName,
+		denommetadatatypes.ModuleName,
+		channelallowlisttypes.ModuleName,
+		soloallowlisttypes.ModuleName,
+		lightclientqueriertypes.ModuleName,
+		pfmrecoverytypes.ModuleName,
+		nfttransfertypes.ModuleName,
+		relayerincentivestypes.ModuleName,
+		tokenpolicytypes.ModuleName,
+		paramguardtypes.ModuleName,
+		msgpausetypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
+		nft.ModuleName,
 		packetforwardtypes.ModuleName,
 		ratelimittypes.ModuleName,
 		upgradetypes.ModuleName,
@@ -920,6 +1176,16 @@ func NewEnokiApp(
 	}
 	reflectionv1.RegisterReflectionServiceServer(app.GRPCQueryRouter(), reflectionSvc)
 
+	// The tokenfactory denom registry joins the tokenfactory, bank, IBC and
+	// tokenpolicy state of every tokenfactory denom.
+	tokenregistrytypes.RegisterQueryServer(app.GRPCQueryRouter(), tokenregistry.NewQuerier(
+		app.TokenFactoryKeeper,
+		app.BankKeeper,
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.TokenPolicyKeeper,
+	))
+
 	// add test gRPC service for testing gRPC queries in isolation
 	// testdata_pulsar.RegisterQueryServer(app.GRPCQueryRouter(), testdata_pulsar.QueryImpl{})
 
@@ -950,6 +1216,7 @@ func NewEnokiApp(
 			IBCKeeper:             app.IBCKeeper,
 			WasmConfig:            &wasmConfig,
 			FeeMarketKeeper:       app.FeeMarketKeeper,
+			MsgPauseKeeper:        &app.MsgPauseKeeper,
 			TXCounterStoreService: runtime.NewKVStoreService(app.keys[wasmtypes.StoreKey]),
 		},
 	)
@@ -1006,6 +1273,16 @@ func NewEnokiApp(
 	}
 
 	if loadLatest {
+		// report store keys missing from the store upgrades before the
+		// multistore fails to load them
+		report, err := app.VerifyStoreKeys("")
+		if err == nil {
+			err = report.Err()
+		}
+		if err != nil {
+			panic(fmt.Errorf("error verifying store keys: %w", err))
+		}
+
 		if err := app.LoadLatestVersion(); err != nil {
 			panic(fmt.Errorf("error loading last version: %w", err))
 		}
@@ -1057,7 +1334,18 @@ func (app *EnokiApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
 func (app *EnokiApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.ModuleManager.PreBlock(ctx)
+	res, err := app.ModuleManager.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// a fork may patch the consensus params of the block
+	forked, err := app.BeginBlockForks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res.ConsensusParamsChanged = res.ConsensusParamsChanged || forked
+	return res, nil
 }
 
 // BeginBlocker application updates every begin block
@@ -1201,6 +1489,9 @@ func (app *EnokiApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIC
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	tokenfactory.NewAppModuleBasic().RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	if err := tokenregistrytypes.RegisterQueryHandlerClient(context.Background(), apiSvr.GRPCGatewayRouter, tokenregistrytypes.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 
 	// register swagger API from root so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
@@ -1250,6 +1541,8 @@ func BlockedAddresses() map[string]bool {
 
 	// allow the following addresses to receive funds
 	delete(blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the relayer reward pool is funded by community pool spends
+	delete(blockedAddrs, authtypes.NewModuleAddress(relayerincentivestypes.ModuleName).String())
 
 	return blockedAddrs
 }
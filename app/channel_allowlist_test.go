@@ -0,0 +1,178 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibccoretypes "github.com/cosmos/ibc-go/v10/modules/core/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	channelallowlisttypes "github.com/hyphacoop/cosmos-enoki/x/channelallowlist/types"
+)
+
+// hasEvent reports whether events contain an event of type eventType.
+func hasEvent(events []abci.Event, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func TestChannelAllowlistTransfer(t *testing.T) {
+	coordinator := newCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+	enokiA, enokiB := chainA.App.(*EnokiApp), chainB.App.(*EnokiApp)
+	sender, receiver := chainA.SenderAccount.GetAddress(), chainB.SenderAccount.GetAddress()
+
+	denom, err := tokenfactorytypes.GetTokenDenom(sender.String(), "mushroom")
+	require.NoError(t, err)
+	_, err = chainA.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(sender.String(), "mushroom"),
+		tokenfactorytypes.NewMsgMint(sender.String(), sdk.NewInt64Coin(denom, 1_000)),
+	)
+	require.NoError(t, err)
+
+	transfer := func(coin sdk.Coin) (channeltypes.Packet, error) {
+		msg := transfertypes.NewMsgTransfer(
+			transfertypes.PortID, path.EndpointA.ChannelID, coin,
+			sender.String(), receiver.String(), clienttypes.NewHeight(1, 1_000), 0, "",
+		)
+		res, err := chainA.SendMsgs(msg)
+		if err != nil {
+			return channeltypes.Packet{}, err
+		}
+		return ibctesting.ParsePacketFromEvents(res.Events)
+	}
+	voucher := func(denom string) string {
+		return transfertypes.NewDenom(denom, transfertypes.NewHop(transfertypes.PortID, path.EndpointB.ChannelID)).IBCDenom()
+	}
+
+	// chain B allows no channel, except for the vouchers of the bond denom of
+	// chain A over the path's channel
+	require.NoError(t, enokiB.ChannelAllowlistKeeper.Allowlist.Set(chainB.GetContext(), channelallowlisttypes.Params{
+		Enabled: true,
+		DenomExceptions: []channelallowlisttypes.DenomException{
+			{Denom: voucher(sdk.DefaultBondDenom), Channels: []string{path.EndpointB.ChannelID}},
+		},
+	}))
+	coordinator.CommitBlock(chainB)
+
+	packet, err := transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, int64(100), enokiB.BankKeeper.GetBalance(chainB.GetContext(), receiver, voucher(sdk.DefaultBondDenom)).Amount.Int64())
+
+	// other denoms are acknowledged with an error and refunded, the events
+	// of the failed receive being prefixed by core IBC
+	packet, err = transfer(sdk.NewInt64Coin(denom, 100))
+	require.NoError(t, err)
+	res, ack, err := path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.NewErrorAcknowledgement(channelallowlisttypes.ErrChannelNotAllowed).Acknowledgement(), ack)
+	require.True(t, hasEvent(res.Events, ibccoretypes.ErrorAttributeKeyPrefix+channelallowlisttypes.EventTypeTransferRejected))
+	require.True(t, enokiB.BankKeeper.GetBalance(chainB.GetContext(), receiver, voucher(denom)).IsZero())
+	require.Equal(t, int64(1_000), enokiA.BankKeeper.GetBalance(chainA.GetContext(), sender, denom).Amount.Int64())
+
+	// chain A rejects the send down the rate limit and allowlist ICS4
+	// wrappers before core IBC commits the packet
+	require.NoError(t, enokiA.ChannelAllowlistKeeper.Allowlist.Set(chainA.GetContext(), channelallowlisttypes.Params{
+		Enabled: true,
+		DenomExceptions: []channelallowlisttypes.DenomException{
+			{Denom: sdk.DefaultBondDenom},
+		},
+	}))
+	coordinator.CommitBlock(chainA)
+
+	sequence, found := enokiA.IBCKeeper.ChannelKeeper.GetNextSequenceSend(chainA.GetContext(), transfertypes.PortID, path.EndpointA.ChannelID)
+	require.True(t, found)
+	_, err = transfer(sdk.NewInt64Coin(denom, 100))
+	require.ErrorContains(t, err, channelallowlisttypes.ErrChannelNotAllowed.Error())
+	next, _ := enokiA.IBCKeeper.ChannelKeeper.GetNextSequenceSend(chainA.GetContext(), transfertypes.PortID, path.EndpointA.ChannelID)
+	require.Equal(t, sequence, next)
+	require.Equal(t, int64(1_000), enokiA.BankKeeper.GetBalance(chainA.GetContext(), sender, denom).Amount.Int64())
+
+	// the bond denom is excepted on every channel
+	packet, err = transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, err)
+	require.NoError(t, path.RelayPacket(packet))
+	require.Equal(t, int64(200), enokiB.BankKeeper.GetBalance(chainB.GetContext(), receiver, voucher(sdk.DefaultBondDenom)).Amount.Int64())
+}
+
+func TestChannelAllowlistTransferV2(t *testing.T) {
+	coordinator := newCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewPath(chainA, chainB)
+	path.SetupV2()
+	enokiA, enokiB := chainA.App.(*EnokiApp), chainB.App.(*EnokiApp)
+	sender, receiver := chainA.SenderAccount.GetAddress(), chainB.SenderAccount.GetAddress()
+	voucher := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(transfertypes.PortID, path.EndpointB.ClientID)).IBCDenom()
+
+	// IBC v2 transfers are sent over the client of chain B
+	transfer := func() (channeltypesv2.Packet, error) {
+		msg := transfertypes.NewMsgTransferWithEncoding(
+			transfertypes.PortID, path.EndpointA.ClientID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			sender.String(), receiver.String(), clienttypes.ZeroHeight(),
+			uint64(chainB.GetContext().BlockTime().Add(time.Hour).Unix()), "", transfertypes.EncodingProtobuf,
+		)
+		res, err := chainA.SendMsgs(msg)
+		if err != nil {
+			return channeltypesv2.Packet{}, err
+		}
+		packets, err := ibctesting.ParseIBCV2Packets(channeltypes.EventTypeSendPacket, res.Events)
+		require.NoError(t, err)
+		require.Len(t, packets, 1)
+		require.NoError(t, path.EndpointB.UpdateClient())
+		return packets[0], nil
+	}
+	ackCommitment := func(packet channeltypesv2.Packet) []byte {
+		return enokiB.IBCKeeper.ChannelKeeperV2.GetPacketAcknowledgement(chainB.GetContext(), path.EndpointB.ClientID, packet.Sequence)
+	}
+
+	// chain B allows no client, so the packet fails on receive
+	require.NoError(t, enokiB.ChannelAllowlistKeeper.Allowlist.Set(chainB.GetContext(), channelallowlisttypes.Params{Enabled: true}))
+	coordinator.CommitBlock(chainB)
+
+	packet, err := transfer()
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointB.MsgRecvPacket(packet))
+	errorAck := channeltypesv2.NewAcknowledgement(channeltypesv2.ErrorAcknowledgement[:])
+	require.Equal(t, channeltypesv2.CommitAcknowledgement(errorAck), ackCommitment(packet))
+	require.True(t, enokiB.BankKeeper.GetBalance(chainB.GetContext(), receiver, voucher).IsZero())
+
+	// a denom exception on the client lets the voucher in
+	require.NoError(t, enokiB.ChannelAllowlistKeeper.Allowlist.Set(chainB.GetContext(), channelallowlisttypes.Params{
+		Enabled: true,
+		DenomExceptions: []channelallowlisttypes.DenomException{
+			{Denom: voucher, Channels: []string{path.EndpointB.ClientID}},
+		},
+	}))
+	coordinator.CommitBlock(chainB)
+
+	packet, err = transfer()
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointB.MsgRecvPacket(packet))
+	require.NotEqual(t, channeltypesv2.CommitAcknowledgement(errorAck), ackCommitment(packet))
+	require.Equal(t, int64(100), enokiB.BankKeeper.GetBalance(chainB.GetContext(), receiver, voucher).Amount.Int64())
+
+	// chain A fails the send when its client is not allowlisted
+	require.NoError(t, enokiA.ChannelAllowlistKeeper.Allowlist.Set(chainA.GetContext(), channelallowlisttypes.Params{Enabled: true}))
+	coordinator.CommitBlock(chainA)
+
+	_, err = transfer()
+	require.ErrorContains(t, err, channelallowlisttypes.ErrChannelNotAllowed.Error())
+}
@@ -0,0 +1,135 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	denommetadatatypes "github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+func TestDenomMetadataTransfer(t *testing.T) {
+	coordinator := newCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+	enokiB := chainB.App.(*EnokiApp)
+	sender, receiver := chainA.SenderAccount.GetAddress(), chainB.SenderAccount.GetAddress()
+
+	denom, err := tokenfactorytypes.GetTokenDenom(sender.String(), "mushroom")
+	require.NoError(t, err)
+	_, err = chainA.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(sender.String(), "mushroom"),
+		tokenfactorytypes.NewMsgMint(sender.String(), sdk.NewInt64Coin(denom, 1_000)),
+	)
+	require.NoError(t, err)
+
+	transfer := func(coin sdk.Coin) []byte {
+		msg := transfertypes.NewMsgTransfer(
+			transfertypes.PortID, path.EndpointA.ChannelID, coin,
+			sender.String(), receiver.String(), clienttypes.NewHeight(1, 1_000), 0, "",
+		)
+		res, err := chainA.SendMsgs(msg)
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+		_, ack, err := path.RelayPacketWithResults(packet)
+		require.NoError(t, err)
+		return ack
+	}
+	received := func(denom string) transfertypes.Denom {
+		return transfertypes.NewDenom(denom, transfertypes.NewHop(transfertypes.PortID, path.EndpointB.ChannelID))
+	}
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	// the first receive of a denom registers the metadata of its voucher
+	stake := received(sdk.DefaultBondDenom)
+	_, found := enokiB.BankKeeper.GetDenomMetaData(chainB.GetContext(), stake.IBCDenom())
+	require.False(t, found)
+	require.Equal(t, successAck, transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	md, found := enokiB.BankKeeper.GetDenomMetaData(chainB.GetContext(), stake.IBCDenom())
+	require.True(t, found)
+	require.Equal(t, denommetadatatypes.NewVoucherMetadata(stake), md)
+
+	// a blank symbol override makes the metadata invalid, which leaves the
+	// acknowledgement, the credited tokens and the placeholder metadata of
+	// the transfer module alone
+	mushroom := received(denom)
+	require.NoError(t, enokiB.DenomMetadataKeeper.Symbols.Set(chainB.GetContext(), mushroom.IBCDenom(), " "))
+	coordinator.CommitBlock(chainB)
+	require.Equal(t, successAck, transfer(sdk.NewInt64Coin(denom, 100)))
+	require.Equal(t, int64(100), enokiB.BankKeeper.GetBalance(chainB.GetContext(), receiver, mushroom.IBCDenom()).Amount.Int64())
+	md, found = enokiB.BankKeeper.GetDenomMetaData(chainB.GetContext(), mushroom.IBCDenom())
+	require.True(t, found)
+	require.True(t, denommetadatatypes.IsTransferDefaultMetadata(md, mushroom))
+}
+
+func TestDenomMetadataTransferV2(t *testing.T) {
+	coordinator := newCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewPath(chainA, chainB)
+	path.SetupV2()
+	enokiA, enokiB := chainA.App.(*EnokiApp), chainB.App.(*EnokiApp)
+	sender, receiver := chainA.SenderAccount.GetAddress(), chainB.SenderAccount.GetAddress()
+
+	// IBC v2 transfers base denoms without slashes, unlike tokenfactory denoms
+	denom := "umushroom"
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 1_000))
+	require.NoError(t, enokiA.BankKeeper.MintCoins(chainA.GetContext(), minttypes.ModuleName, coins))
+	require.NoError(t, enokiA.BankKeeper.SendCoinsFromModuleToAccount(chainA.GetContext(), minttypes.ModuleName, sender, coins))
+	coordinator.CommitBlock(chainA)
+
+	// returns the commitment of the acknowledgement written by chain B
+	transfer := func(coin sdk.Coin) []byte {
+		msg := transfertypes.NewMsgTransferWithEncoding(
+			transfertypes.PortID, path.EndpointA.ClientID, coin,
+			sender.String(), receiver.String(), clienttypes.ZeroHeight(),
+			uint64(chainB.GetContext().BlockTime().Add(time.Hour).Unix()), "", transfertypes.EncodingProtobuf,
+		)
+		res, err := chainA.SendMsgs(msg)
+		require.NoError(t, err)
+		packets, err := ibctesting.ParseIBCV2Packets(channeltypes.EventTypeSendPacket, res.Events)
+		require.NoError(t, err)
+		require.Len(t, packets, 1)
+		require.NoError(t, path.EndpointB.UpdateClient())
+		require.NoError(t, path.EndpointB.MsgRecvPacket(packets[0]))
+		return enokiB.IBCKeeper.ChannelKeeperV2.GetPacketAcknowledgement(chainB.GetContext(), path.EndpointB.ClientID, packets[0].Sequence)
+	}
+	received := func(denom string) transfertypes.Denom {
+		return transfertypes.NewDenom(denom, transfertypes.NewHop(transfertypes.PortID, path.EndpointB.ClientID))
+	}
+	successAck := channeltypesv2.CommitAcknowledgement(channeltypesv2.NewAcknowledgement(
+		channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(),
+	))
+
+	// the first receive of a denom registers the metadata of its voucher
+	stake := received(sdk.DefaultBondDenom)
+	_, found := enokiB.BankKeeper.GetDenomMetaData(chainB.GetContext(), stake.IBCDenom())
+	require.False(t, found)
+	require.Equal(t, successAck, transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	md, found := enokiB.BankKeeper.GetDenomMetaData(chainB.GetContext(), stake.IBCDenom())
+	require.True(t, found)
+	require.Equal(t, denommetadatatypes.NewVoucherMetadata(stake), md)
+
+	// a failed registration leaves the acknowledgement, the credited tokens
+	// and the placeholder metadata of the transfer module alone
+	mushroom := received(denom)
+	require.NoError(t, enokiB.DenomMetadataKeeper.Symbols.Set(chainB.GetContext(), mushroom.IBCDenom(), " "))
+	coordinator.CommitBlock(chainB)
+	require.Equal(t, successAck, transfer(sdk.NewInt64Coin(denom, 100)))
+	require.Equal(t, int64(100), enokiB.BankKeeper.GetBalance(chainB.GetContext(), receiver, mushroom.IBCDenom()).Amount.Int64())
+	md, found = enokiB.BankKeeper.GetDenomMetaData(chainB.GetContext(), mushroom.IBCDenom())
+	require.True(t, found)
+	require.True(t, denommetadatatypes.IsTransferDefaultMetadata(md, mushroom))
+}
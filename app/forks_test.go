@@ -0,0 +1,99 @@
+package app
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	"github.com/hyphacoop/cosmos-enoki/app/upgrades"
+	tokenpolicytypes "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+// setForks replaces the forks of the app for the duration of the test.
+func setForks(t *testing.T, forks ...upgrades.Fork) {
+	previous := Forks
+	Forks = forks
+	t.Cleanup(func() { Forks = previous })
+}
+
+func TestBeginBlockForks(t *testing.T) {
+	coordinator := newCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	enoki := chain.App.(*EnokiApp)
+
+	// the fork patches the params of a module at its height, and only on its
+	// chain
+	forkHeight := chain.ProposedHeader.Height + 2
+	var applied []string
+	forkLogic := func(name string) func(sdk.Context, *upgrades.AppKeepers) error {
+		return func(ctx sdk.Context, keepers *upgrades.AppKeepers) error {
+			applied = append(applied, name)
+			params := tokenpolicytypes.DefaultParams()
+			params.BeforeSendHookGasLimit = 42
+			return keepers.TokenPolicyKeeper.ParamsStore.Set(ctx, params)
+		}
+	}
+	setForks(t,
+		upgrades.Fork{UpgradeName: "v2.3.1", ChainID: chain.ChainID, UpgradeHeight: forkHeight, BeginForkLogic: forkLogic("v2.3.1")},
+		upgrades.Fork{UpgradeName: "v2.3.1", ChainID: "other-chain", UpgradeHeight: forkHeight, BeginForkLogic: forkLogic("other-chain")},
+	)
+
+	coordinator.CommitBlock(chain)
+	params, err := enoki.TokenPolicyKeeper.ParamsStore.Get(chain.GetContext())
+	require.NoError(t, err)
+	require.Equal(t, tokenpolicytypes.DefaultBeforeSendHookGasLimit, params.BeforeSendHookGasLimit)
+	require.Empty(t, applied)
+
+	coordinator.CommitNBlocks(chain, 3)
+	require.Greater(t, enoki.LastBlockHeight(), forkHeight)
+	require.Equal(t, []string{"v2.3.1"}, applied)
+	params, err = enoki.TokenPolicyKeeper.ParamsStore.Get(chain.GetContext())
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), params.BeforeSendHookGasLimit)
+
+	// a failing fork fails the block
+	setForks(t, upgrades.Fork{
+		UpgradeName:   "v2.3.2",
+		ChainID:       chain.ChainID,
+		UpgradeHeight: forkHeight,
+		BeginForkLogic: func(sdk.Context, *upgrades.AppKeepers) error {
+			return errors.New("boom")
+		},
+	})
+	_, err = enoki.BeginBlockForks(chain.GetContext().WithBlockHeight(forkHeight))
+	require.ErrorContains(t, err, "applying fork v2.3.2: boom")
+}
+
+func TestValidateForks(t *testing.T) {
+	forkLogic := func(sdk.Context, *upgrades.AppKeepers) error { return nil }
+	fork := upgrades.Fork{UpgradeName: "v2.3.1", ChainID: "enoki-1", UpgradeHeight: 100, BeginForkLogic: forkLogic}
+
+	require.NoError(t, validateForks(nil))
+	require.NoError(t, validateForks([]upgrades.Fork{fork}))
+
+	// the same fork may be applied to several chains at their own heights
+	testnetFork := fork
+	testnetFork.ChainID, testnetFork.UpgradeHeight = "test-enoki-1", 50
+	require.NoError(t, validateForks([]upgrades.Fork{fork, testnetFork}))
+
+	sameHeight := fork
+	sameHeight.UpgradeName = "v2.3.2"
+	require.ErrorContains(t, validateForks([]upgrades.Fork{fork, sameHeight}), "more than one fork of enoki-1 at height 100")
+
+	sameName := fork
+	sameName.UpgradeHeight = 200
+	require.ErrorContains(t, validateForks([]upgrades.Fork{fork, sameName}), "more than one fork of enoki-1 named v2.3.1")
+
+	noLogic := fork
+	noLogic.BeginForkLogic = nil
+	require.ErrorContains(t, validateForks([]upgrades.Fork{noLogic}), "fork v2.3.1 has no fork logic")
+
+	noHeight := fork
+	noHeight.UpgradeHeight = 0
+	require.ErrorContains(t, validateForks([]upgrades.Fork{noHeight}), "needs a name, a chain ID and a positive height")
+}
@@ -2,6 +2,12 @@ package app
 
 import (
 	"encoding/json"
+
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icagenesistypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/genesis/types"
+	"github.com/hyphacoop/cosmos-enoki/app/upgrades"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // GenesisState of the blockchain is represented here as a map of raw json
@@ -12,3 +18,16 @@ import (
 // the ModuleBasicManager which populates json from each BasicModule
 // object provided to it during init.
 type GenesisState map[string]json.RawMessage
+
+// icaModuleBasic sets the Enoki ICA host allowlist in the default genesis.
+type icaModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the ICA default genesis with the host allowlist
+// from upgrades.ICAHostAllowMessages.
+func (icaModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := icagenesistypes.DefaultGenesis()
+	genesis.HostGenesisState.Params.AllowMessages = upgrades.ICAHostAllowMessages()
+	return cdc.MustMarshalJSON(genesis)
+}
@@ -0,0 +1,127 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+const (
+	flagIBCWasmDataDir             = "ibc-wasm.data_dir"
+	flagIBCWasmMemoryCacheSize     = "ibc-wasm.memory_cache_size"
+	flagIBCWasmInstanceMemoryLimit = "ibc-wasm.instance_memory_limit"
+	flagIBCWasmDebug               = "ibc-wasm.debug"
+	flagIBCWasmMetrics             = "ibc-wasm.metrics"
+)
+
+// IBCWasmConfig configures the VM running the 08-wasm light clients.
+type IBCWasmConfig struct {
+	// DataDir is the VM cache directory. Relative paths are resolved against
+	// the node home.
+	DataDir string `mapstructure:"data_dir"`
+	// MemoryCacheSize is the in-memory cache for light client code in MiB.
+	MemoryCacheSize uint32 `mapstructure:"memory_cache_size"`
+	// InstanceMemoryLimit is the memory limit of a light client instance in
+	// MiB.
+	InstanceMemoryLimit uint32 `mapstructure:"instance_memory_limit"`
+	// Debug prints light client debug output to the node logs.
+	Debug bool `mapstructure:"debug"`
+	// Metrics exposes the VM cache metrics when telemetry is enabled.
+	Metrics bool `mapstructure:"metrics"`
+}
+
+// DefaultIBCWasmConfig returns the VM settings used before they could be
+// configured.
+func DefaultIBCWasmConfig() IBCWasmConfig {
+	return IBCWasmConfig{
+		DataDir:             filepath.Join("data", "08-light-client"),
+		MemoryCacheSize:     100,
+		InstanceMemoryLimit: 32,
+		Debug:               false,
+		Metrics:             true,
+	}
+}
+
+// VMDir returns the VM cache directory for the node at homePath.
+func (c IBCWasmConfig) VMDir(homePath string) string {
+	if filepath.IsAbs(c.DataDir) {
+		return c.DataDir
+	}
+	return filepath.Join(homePath, c.DataDir)
+}
+
+// ReadIBCWasmConfig reads the [ibc-wasm] section of app.toml, unset values
+// keep their defaults.
+func ReadIBCWasmConfig(opts servertypes.AppOptions) (IBCWasmConfig, error) {
+	cfg := DefaultIBCWasmConfig()
+	var err error
+	if v := opts.Get(flagIBCWasmDataDir); v != nil {
+		if cfg.DataDir, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+		if cfg.DataDir == "" {
+			return cfg, fmt.Errorf("%s must not be empty", flagIBCWasmDataDir)
+		}
+	}
+	if v := opts.Get(flagIBCWasmMemoryCacheSize); v != nil {
+		if cfg.MemoryCacheSize, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagIBCWasmInstanceMemoryLimit); v != nil {
+		if cfg.InstanceMemoryLimit, err = cast.ToUint32E(v); err != nil {
+			return cfg, err
+		}
+		if cfg.InstanceMemoryLimit == 0 {
+			return cfg, fmt.Errorf("%s must be positive", flagIBCWasmInstanceMemoryLimit)
+		}
+	}
+	if v := opts.Get(flagIBCWasmDebug); v != nil {
+		if cfg.Debug, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagIBCWasmMetrics); v != nil {
+		if cfg.Metrics, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
+
+// IBCWasmConfigTemplate returns the app.toml template of the [ibc-wasm]
+// section.
+func IBCWasmConfigTemplate(c IBCWasmConfig) string {
+	return fmt.Sprintf(`
+[ibc-wasm]
+# Directory of the 08-wasm light client VM cache, relative to the node home
+# unless absolute
+data_dir = "%s"
+
+# in-memory cache for light client code. Set to 0 to disable.
+# The value is in MiB not bytes
+memory_cache_size = %d
+
+# Memory limit of a single light client instance in MiB
+instance_memory_limit = %d
+
+# Print light client debug output to the node logs
+debug = %t
+
+# Expose the light client VM cache metrics when telemetry is enabled
+metrics = %t
+`, c.DataDir, c.MemoryCacheSize, c.InstanceMemoryLimit, c.Debug, c.Metrics)
+}
+
+// RegisterIBCWasmVMMetrics registers the cache metrics of the 08-wasm light
+// client VM on r. The metrics are prefixed with ibc_ so they do not clash with
+// the x/wasm VM metrics.
+func (app *EnokiApp) RegisterIBCWasmVMMetrics(r prometheus.Registerer) {
+	wasmkeeper.NewWasmVMMetricsCollector(app.ibcWasmVM).Register(prometheus.WrapRegistererWithPrefix("ibc_", r))
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+)
+
+func TestReadIBCWasmConfig(t *testing.T) {
+	cfg, err := ReadIBCWasmConfig(simtestutil.EmptyAppOptions{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultIBCWasmConfig(), cfg)
+	require.Equal(t, filepath.Join("/home", "data", "08-light-client"), cfg.VMDir("/home"))
+
+	custom := IBCWasmConfig{
+		DataDir:             "/var/lib/enoki/light-clients",
+		MemoryCacheSize:     512,
+		InstanceMemoryLimit: 64,
+		Debug:               true,
+		Metrics:             false,
+	}
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(bytes.NewBufferString(IBCWasmConfigTemplate(custom))))
+
+	cfg, err = ReadIBCWasmConfig(v)
+	require.NoError(t, err)
+	require.Equal(t, custom, cfg)
+	require.Equal(t, custom.DataDir, cfg.VMDir("/home"))
+
+	_, err = ReadIBCWasmConfig(simtestutil.AppOptionsMap{flagIBCWasmInstanceMemoryLimit: 0})
+	require.Error(t, err)
+	_, err = ReadIBCWasmConfig(simtestutil.AppOptionsMap{flagIBCWasmMemoryCacheSize: "lots"})
+	require.Error(t, err)
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestMsgPauseCircuit(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.NewUncachedContext(false, cmtproto.Header{Time: time.Now()})
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	from, to := sdk.AccAddress("from________________"), sdk.AccAddress("to__________________")
+	send := banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	exec := authz.NewMsgExec(from, []sdk.Msg{send})
+
+	require.NoError(t, gapp.MsgPauseKeeper.Pause(ctx, authority, []string{sdk.MsgTypeURL(send)}, time.Hour, "incident"))
+
+	// the router refuses the paused message, nested in another one too
+	_, err := gapp.MsgServiceRouter().Handler(send)(ctx, send)
+	require.ErrorContains(t, err, "circuit breaker disables execution")
+	_, err = gapp.MsgServiceRouter().Handler(&exec)(ctx, &exec)
+	require.ErrorContains(t, err, "circuit breaker disables execution")
+
+	// the pause is removed once expired
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, gapp.MsgPauseKeeper.ExpirePauses(ctx))
+	_, err = gapp.MsgServiceRouter().Handler(send)(ctx, send)
+	require.ErrorContains(t, err, "insufficient funds")
+}
@@ -0,0 +1,165 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	nftbindings "github.com/hyphacoop/cosmos-enoki/wasmbinding/nft"
+	nftbindingstypes "github.com/hyphacoop/cosmos-enoki/wasmbinding/nft/types"
+	nfttransfertypes "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+)
+
+func newNFTTransferPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	t.Helper()
+	coordinator := newCoordinator(t, 2)
+	path := ibctesting.NewPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)))
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = nfttransfertypes.PortID
+		endpoint.ChannelConfig.Version = nfttransfertypes.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	path.Setup()
+	return coordinator, path
+}
+
+// sendNFTs transfers NFTs from the sender account of the source endpoint and
+// returns the sent packet.
+func sendNFTs(t *testing.T, source *ibctesting.Endpoint, classID, receiver string, timeoutHeight clienttypes.Height, tokenIDs ...string) channeltypes.Packet {
+	t.Helper()
+	var timeoutTimestamp uint64
+	if timeoutHeight.IsZero() {
+		timeoutTimestamp = uint64(source.Counterparty.Chain.GetContext().BlockTime().Add(time.Hour).UnixNano())
+	}
+	msg := nfttransfertypes.NewMsgTransfer(
+		source.ChannelConfig.PortID, source.ChannelID, classID, tokenIDs,
+		source.Chain.SenderAccount.GetAddress().String(), receiver, timeoutHeight, timeoutTimestamp, "",
+	)
+	res, err := source.Chain.SendMsgs(msg)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	return packet
+}
+
+func TestNFTTransfer(t *testing.T) {
+	coordinator, path := newNFTTransferPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	enokiA, enokiB := chainA.App.(*EnokiApp), chainB.App.(*EnokiApp)
+	senderA, senderB := chainA.SenderAccount.GetAddress(), chainB.SenderAccount.GetAddress()
+
+	// a native collection on chain A
+	classID := "kitties"
+	require.NoError(t, enokiA.NFTKeeper.SaveClass(chainA.GetContext(), nft.Class{Id: classID, Name: "Kitties", Uri: "ipfs://kitties"}))
+	for _, id := range []string{"1", "2"} {
+		require.NoError(t, enokiA.NFTKeeper.Mint(chainA.GetContext(), nft.NFT{ClassId: classID, Id: id, Uri: "ipfs://kitties/" + id}, senderA))
+	}
+	coordinator.CommitBlock(chainA)
+
+	// A -> B escrows the NFTs on A and mints vouchers on B
+	packet := sendNFTs(t, path.EndpointA, classID, senderB.String(), clienttypes.ZeroHeight(), "1", "2")
+	require.NoError(t, path.RelayPacket(packet))
+
+	escrowA := nfttransfertypes.GetEscrowAddress(nfttransfertypes.PortID, path.EndpointA.ChannelID)
+	require.Equal(t, escrowA, enokiA.NFTKeeper.GetOwner(chainA.GetContext(), classID, "1"))
+	require.Equal(t, escrowA, enokiA.NFTKeeper.GetOwner(chainA.GetContext(), classID, "2"))
+
+	trace := nfttransfertypes.ParseClassTrace(nfttransfertypes.GetClassPrefix(nfttransfertypes.PortID, path.EndpointB.ChannelID) + classID)
+	voucherClassID := trace.IBCClassID()
+	class, found := enokiB.NFTKeeper.GetClass(chainB.GetContext(), voucherClassID)
+	require.True(t, found)
+	require.Equal(t, "ipfs://kitties", class.Uri)
+	token, found := enokiB.NFTKeeper.GetNFT(chainB.GetContext(), voucherClassID, "1")
+	require.True(t, found)
+	require.Equal(t, "ipfs://kitties/1", token.Uri)
+	require.Equal(t, senderB, enokiB.NFTKeeper.GetOwner(chainB.GetContext(), voucherClassID, "2"))
+
+	res, err := enokiB.NFTTransferKeeper.ClassTrace(chainB.GetContext(), &nfttransfertypes.QueryClassTraceRequest{Hash: voucherClassID})
+	require.NoError(t, err)
+	require.Equal(t, trace, res.ClassTrace)
+
+	// B -> A burns the voucher on B and releases the escrowed NFT on A
+	packet = sendNFTs(t, path.EndpointB, voucherClassID, senderA.String(), clienttypes.ZeroHeight(), "1")
+	require.NoError(t, path.RelayPacket(packet))
+
+	require.False(t, enokiB.NFTKeeper.HasNFT(chainB.GetContext(), voucherClassID, "1"))
+	require.Equal(t, senderA, enokiA.NFTKeeper.GetOwner(chainA.GetContext(), classID, "1"))
+	require.Equal(t, escrowA, enokiA.NFTKeeper.GetOwner(chainA.GetContext(), classID, "2"))
+
+	// a timed out transfer of the voucher is minted again on B
+	timeoutHeight := clienttypes.GetSelfHeight(chainA.GetContext())
+	packet = sendNFTs(t, path.EndpointB, voucherClassID, senderA.String(), timeoutHeight, "2")
+	require.False(t, enokiB.NFTKeeper.HasNFT(chainB.GetContext(), voucherClassID, "2"))
+	require.NoError(t, path.EndpointB.UpdateClient())
+	require.NoError(t, path.EndpointB.TimeoutPacket(packet))
+	require.Equal(t, senderB, enokiB.NFTKeeper.GetOwner(chainB.GetContext(), voucherClassID, "2"))
+
+	// NFTs are not sent when sending is disabled
+	params := nfttransfertypes.Params{SendEnabled: false, ReceiveEnabled: true}
+	require.NoError(t, enokiA.NFTTransferKeeper.ParamsStore.Set(chainA.GetContext(), params))
+	coordinator.CommitBlock(chainA)
+	msg := nfttransfertypes.NewMsgTransfer(
+		nfttransfertypes.PortID, path.EndpointA.ChannelID, classID, []string{"1"}, senderA.String(), senderB.String(),
+		clienttypes.ZeroHeight(), uint64(chainB.GetContext().BlockTime().Add(time.Hour).UnixNano()), "",
+	)
+	_, err = chainA.SendMsgs(msg)
+	require.ErrorContains(t, err, nfttransfertypes.ErrSendDisabled.Error())
+}
+
+func TestNFTTransferFromContract(t *testing.T) {
+	coordinator, path := newNFTTransferPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	enokiA, enokiB := chainA.App.(*EnokiApp), chainB.App.(*EnokiApp)
+	contract := authtypes.NewModuleAddress("test-contract")
+	receiver := chainB.SenderAccount.GetAddress()
+
+	// a contract creates a collection and mints an NFT to itself
+	ctx := chainA.GetContext()
+	classID, err := nftbindings.PerformCreateClass(enokiA.NFTKeeper, ctx, contract, &nftbindingstypes.CreateClass{Subclass: "badges", URI: "ipfs://badges"})
+	require.NoError(t, err)
+	require.Equal(t, "wasm/"+contract.String()+"/badges", classID)
+	mint := &nftbindingstypes.MintNFT{ClassID: classID, TokenID: "gold", Recipient: contract.String()}
+	require.NoError(t, nftbindings.PerformMintNFT(enokiA.NFTKeeper, ctx, contract, mint))
+
+	// other contracts cannot mint in the collection
+	other := authtypes.NewModuleAddress("other-contract")
+	require.Error(t, nftbindings.PerformMintNFT(enokiA.NFTKeeper, ctx, other, &nftbindingstypes.MintNFT{ClassID: classID, TokenID: "silver", Recipient: other.String()}))
+
+	// and sends it to chain B
+	ctx = chainA.GetContext()
+	transfer := &nftbindingstypes.TransferNFT{Channel: path.EndpointA.ChannelID, ClassID: classID, TokenIDs: []string{"gold"}, Receiver: receiver.String(), Timeout: 3600}
+	res, err := nftbindings.PerformTransferNFT(enokiA.NFTTransferKeeper, ctx, contract, transfer)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.SequenceID)
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().ABCIEvents())
+	require.NoError(t, err)
+	coordinator.CommitBlock(chainA)
+	require.NoError(t, path.RelayPacket(packet))
+
+	voucherClassID := nfttransfertypes.ParseClassTrace(nfttransfertypes.GetClassPrefix(nfttransfertypes.PortID, path.EndpointB.ChannelID) + classID).IBCClassID()
+	require.Equal(t, receiver, enokiB.NFTKeeper.GetOwner(chainB.GetContext(), voucherClassID, "gold"))
+
+	// the trace of the voucher resolves to the contract collection
+	bz, err := nftbindings.QueryClassTrace(enokiB.NFTTransferKeeper, chainB.GetContext(), &nftbindingstypes.ClassTrace{ClassID: voucherClassID})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"path":"nfttransfer/`+path.EndpointB.ChannelID+`","base_class_id":"`+classID+`"}`, string(bz))
+
+	bz, err = nftbindings.QueryOwner(enokiB.NFTKeeper, chainB.GetContext(), &nftbindingstypes.Owner{ClassID: voucherClassID, TokenID: "gold"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"owner":"`+receiver.String()+`"}`, string(bz))
+
+	// the contract can no longer burn the escrowed NFT
+	err = nftbindings.PerformBurnNFT(enokiA.NFTKeeper, chainA.GetContext(), contract, &nftbindingstypes.BurnNFT{ClassID: classID, TokenID: "gold"})
+	require.Error(t, err)
+	require.Equal(t, sdk.AccAddress(nfttransfertypes.GetEscrowAddress(nfttransfertypes.PortID, path.EndpointA.ChannelID)), enokiA.NFTKeeper.GetOwner(chainA.GetContext(), classID, "gold"))
+}
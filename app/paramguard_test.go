@@ -0,0 +1,45 @@
+package app
+
+import (
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	paramguardtypes "github.com/hyphacoop/cosmos-enoki/x/paramguard/types"
+)
+
+func TestParamGuardProposals(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.NewUncachedContext(false, cmtproto.Header{})
+	msgType := sdk.MsgTypeURL(&feemarkettypes.MsgParams{})
+	require.NoError(t, gapp.ParamGuardKeeper.SetParams(ctx, paramguardtypes.Params{Bounds: []paramguardtypes.Bound{
+		{MsgTypeUrl: msgType, Path: "params.min_base_gas_price", Min: "0.0001", Max: "1"},
+	}}))
+	_, err := gapp.Commit()
+	require.NoError(t, err)
+
+	params, err := gapp.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// proposal messages run through the router of the governance module
+	params.MinBaseGasPrice = sdkmath.LegacyMustNewDecFromStr("0.5")
+	simulation, err := gapp.SimulateProposal([]sdk.Msg{&feemarkettypes.MsgParams{Authority: authority, Params: params}}, nil)
+	require.NoError(t, err)
+	require.Empty(t, simulation.Error)
+
+	params.MinBaseGasPrice = sdkmath.LegacyMustNewDecFromStr("5000")
+	simulation, err = gapp.SimulateProposal([]sdk.Msg{&feemarkettypes.MsgParams{Authority: authority, Params: params}}, nil)
+	require.NoError(t, err)
+	require.Contains(t, simulation.Error, "params.min_base_gas_price of "+msgType+" is 5000.000000000000000000, above the maximum 1")
+	require.Contains(t, simulation.Error, paramguardtypes.ErrOutOfBounds.Error())
+}
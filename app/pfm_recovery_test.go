@@ -0,0 +1,70 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+
+	pfmrecoverytypes "github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/types"
+)
+
+// TestPFMRecoveryInFlightPacketKey checks that pfmrecovery finds the
+// in-flight packets in the store of the packet forward middleware under the
+// keys its keeper reads and clears them with.
+func TestPFMRecoveryInFlightPacketKey(t *testing.T) {
+	coordinator := newCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	enoki := chain.App.(*EnokiApp)
+	ctx := chain.GetContext()
+
+	const (
+		channelID = "channel-1"
+		sequence  = uint64(7)
+	)
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", "enoki1receiver", "")
+	inFlightPacket := packetforwardtypes.InFlightPacket{
+		OriginalSenderAddress: "cosmos1sender",
+		RefundChannelId:       "channel-0",
+		RefundPortId:          transfertypes.PortID,
+		PacketSrcChannelId:    "channel-9",
+		PacketSrcPortId:       transfertypes.PortID,
+		PacketTimeoutHeight:   "0-0",
+		PacketData:            data.GetBytes(),
+		RefundSequence:        3,
+		Timeout:               uint64(time.Hour),
+	}
+	enoki.PacketForwardKeeper.InitGenesis(ctx, packetforwardtypes.GenesisState{
+		InFlightPackets: map[string]packetforwardtypes.InFlightPacket{
+			string(packetforwardtypes.RefundPacketKey(channelID, transfertypes.PortID, sequence)): inFlightPacket,
+		},
+	})
+
+	res, err := enoki.PFMRecoveryKeeper.InFlightPackets(ctx, &pfmrecoverytypes.QueryInFlightPacketsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 1)
+	forward := res.Packets[0]
+	require.Equal(t, channelID, forward.ChannelId)
+	require.Equal(t, transfertypes.PortID, forward.PortId)
+	require.Equal(t, sequence, forward.Sequence)
+	require.Equal(t, "cosmos1sender", forward.OriginalSenderAddress)
+	require.Equal(t, "transfer/channel-0/uatom", forward.Denom)
+
+	// the packet forward middleware clears the forward pfmrecovery lists
+	cleared := enoki.PacketForwardKeeper.GetAndClearInFlightPacket(ctx, forward.ChannelId, forward.PortId, forward.Sequence)
+	require.NotNil(t, cleared)
+	require.Equal(t, inFlightPacket.RefundSequence, cleared.RefundSequence)
+
+	_, err = enoki.PFMRecoveryKeeper.InFlightPacket(ctx, &pfmrecoverytypes.QueryInFlightPacketRequest{
+		ChannelId: channelID, PortId: transfertypes.PortID, Sequence: sequence,
+	})
+	require.Error(t, err)
+	res, err = enoki.PFMRecoveryKeeper.InFlightPackets(ctx, &pfmrecoverytypes.QueryInFlightPacketsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Packets)
+}
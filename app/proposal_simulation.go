@@ -0,0 +1,240 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/listenkv"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// ProposalSimulation is the outcome of running the messages of a governance
+// proposal on a branch of the last committed state.
+type ProposalSimulation struct {
+	// Height is the last committed height the proposal ran on.
+	Height int64 `json:"height"`
+	// Messages are the results of the messages, up to the first failure.
+	Messages []SimulatedMessage `json:"messages"`
+	GasUsed  uint64             `json:"gas_used"`
+	// Error is the reason the proposal would be rejected at submission or
+	// fail at execution.
+	Error string `json:"error,omitempty"`
+	// Changes are the store writes of a proposal executed without error.
+	Changes []StoreChange `json:"changes,omitempty"`
+	// Queries are run before and after the proposal.
+	Queries []SimulatedQuery `json:"queries,omitempty"`
+}
+
+// SimulatedMessage is the result of a proposal message.
+type SimulatedMessage struct {
+	TypeURL string       `json:"type_url"`
+	GasUsed uint64       `json:"gas_used"`
+	Events  []abci.Event `json:"events,omitempty"`
+	Error   string       `json:"error,omitempty"`
+}
+
+// StoreChange is a key written or deleted by a proposal. Before is nil for
+// an added key and After for a deleted one.
+type StoreChange struct {
+	Store  string `json:"store"`
+	Key    []byte `json:"key"`
+	Before []byte `json:"before"`
+	After  []byte `json:"after"`
+}
+
+// SimulatedQuery holds the responses of a query before and after a proposal.
+type SimulatedQuery struct {
+	Path   string `json:"path"`
+	Before []byte `json:"before,omitempty"`
+	After  []byte `json:"after,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// SimulateProposal runs the proposal messages through the router of the
+// governance module, parameter bounds included, on a branch of the last
+// committed state, without writing to it. The messages are checked like at
+// submission, then executed in order until one fails, in which case no
+// change is kept. The queries are answered by the query router on the state
+// before and after the proposal.
+func (app *EnokiApp) SimulateProposal(msgs []sdk.Msg, queries []abci.RequestQuery) (ProposalSimulation, error) {
+	cms := app.CommitMultiStore()
+	version := cms.LastCommitID().Version
+	if version == 0 {
+		return ProposalSimulation{}, errors.New("no committed state to simulate the proposal on")
+	}
+	before, err := cms.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return ProposalSimulation{}, err
+	}
+	branch, err := cms.CacheMultiStoreWithVersion(version)
+	if err != nil {
+		return ProposalSimulation{}, err
+	}
+
+	// the writes of the proposal reach the branch through listeners, which
+	// record them per store
+	listener := storetypes.NewMemoryListener()
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper)
+	keysByName := make(map[string]storetypes.StoreKey)
+	for _, key := range app.keys {
+		stores[key] = listenkv.NewStore(branch.GetKVStore(key), key, listener)
+		keysByName[key.Name()] = key
+	}
+	for _, key := range app.tkeys {
+		stores[key] = branch.GetKVStore(key)
+		keysByName[key.Name()] = key
+	}
+	for _, key := range app.memKeys {
+		stores[key] = branch.GetKVStore(key)
+		keysByName[key.Name()] = key
+	}
+	after := cachemulti.NewStore(dbm.NewMemDB(), stores, keysByName, nil, nil)
+
+	// the proposal runs in the end blocker of the next height
+	now := time.Now().UTC()
+	newContext := func(ms storetypes.MultiStore) sdk.Context {
+		ctx := app.NewUncachedContext(false, cmtproto.Header{ChainID: app.ChainID(), Height: version + 1, Time: now}).
+			WithHeaderInfo(header.Info{ChainID: app.ChainID(), Height: version + 1, Time: now}).
+			WithMultiStore(ms)
+		return ctx.WithConsensusParams(app.GetConsensusParams(ctx)).
+			WithGasMeter(storetypes.NewInfiniteGasMeter())
+	}
+
+	simulation := ProposalSimulation{Height: version}
+	if err := app.checkProposalMsgs(msgs); err != nil {
+		simulation.Error = err.Error()
+	} else {
+		ctx := newContext(after)
+		cacheCtx, write := ctx.CacheContext()
+		for _, msg := range msgs {
+			gasBefore := cacheCtx.GasMeter().GasConsumed()
+			res, err := executeProposalMsg(cacheCtx, app.GovKeeper.Router().Handler(msg), msg)
+			result := SimulatedMessage{TypeURL: sdk.MsgTypeURL(msg), GasUsed: cacheCtx.GasMeter().GasConsumed() - gasBefore}
+			if err != nil {
+				result.Error = err.Error()
+				simulation.Error = fmt.Sprintf("message %d failed: %s", len(simulation.Messages), err)
+			} else {
+				result.Events = res.Events
+			}
+			simulation.Messages = append(simulation.Messages, result)
+			if err != nil {
+				break
+			}
+		}
+		simulation.GasUsed = cacheCtx.GasMeter().GasConsumed()
+
+		if simulation.Error == "" {
+			write()
+			after.Write()
+			simulation.Changes = storeChanges(before, keysByName, listener.PopStateCache())
+		}
+	}
+
+	for _, query := range queries {
+		result := SimulatedQuery{Path: query.Path}
+		beforeRes, beforeErr := app.simulateQuery(newContext(before), query)
+		afterRes, afterErr := app.simulateQuery(newContext(branch), query)
+		if err := errors.Join(beforeErr, afterErr); err != nil {
+			result.Error = err.Error()
+		}
+		result.Before, result.After = beforeRes, afterRes
+		simulation.Queries = append(simulation.Queries, result)
+	}
+
+	return simulation, nil
+}
+
+// checkProposalMsgs runs the checks of the governance module on the
+// messages of a submitted proposal.
+func (app *EnokiApp) checkProposalMsgs(msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return errors.New("proposal has no messages")
+	}
+	authority := app.AccountKeeper.GetModuleAddress(govtypes.ModuleName)
+
+	var errs []error
+	for i, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				errs = append(errs, fmt.Errorf("message %d: %w", i, err))
+				continue
+			}
+		}
+		signers, _, err := app.appCodec.GetMsgV1Signers(msg)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("message %d: %w", i, err))
+			continue
+		}
+		if len(signers) != 1 || !bytes.Equal(signers[0], authority) {
+			errs = append(errs, fmt.Errorf("message %d: %w: the governance module account must be the only signer", i, govtypes.ErrInvalidSigner))
+			continue
+		}
+		if app.GovKeeper.Router().Handler(msg) == nil {
+			errs = append(errs, fmt.Errorf("message %d: %w: %s", i, govtypes.ErrUnroutableProposalMsg, sdk.MsgTypeURL(msg)))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// executeProposalMsg runs the message handler, turning panics into errors
+// like the governance module does.
+func executeProposalMsg(ctx sdk.Context, handler func(sdk.Context, sdk.Msg) (*sdk.Result, error), msg sdk.Msg) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handling proposal message %s panicked: %v", sdk.MsgTypeURL(msg), r)
+		}
+	}()
+	return handler(ctx, msg)
+}
+
+// simulateQuery answers the query with the query router.
+func (app *EnokiApp) simulateQuery(ctx sdk.Context, query abci.RequestQuery) ([]byte, error) {
+	handler := app.GRPCQueryRouter().Route(query.Path)
+	if handler == nil {
+		return nil, fmt.Errorf("unknown query path %s", query.Path)
+	}
+	res, err := handler(ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+	return res.Value, nil
+}
+
+// storeChanges returns the writes that change the state before the proposal,
+// sorted by store and key. Empty values are told apart from missing keys.
+func storeChanges(before storetypes.MultiStore, keysByName map[string]storetypes.StoreKey, writes []*storetypes.StoreKVPair) []StoreChange {
+	var changes []StoreChange
+	for _, write := range writes {
+		change := StoreChange{Store: write.StoreKey, Key: write.Key}
+		change.Before = before.GetKVStore(keysByName[write.StoreKey]).Get(write.Key)
+		if !write.Delete {
+			change.After = write.Value
+			if change.After == nil {
+				change.After = []byte{}
+			}
+		}
+		if (change.Before == nil) == (change.After == nil) && bytes.Equal(change.Before, change.After) {
+			continue
+		}
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Store != changes[j].Store {
+			return changes[i].Store < changes[j].Store
+		}
+		return bytes.Compare(changes[i].Key, changes[j].Key) < 0
+	})
+	return changes
+}
@@ -0,0 +1,84 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+func TestSimulateProposal(t *testing.T) {
+	gapp := Setup(t)
+	_, err := gapp.SimulateProposal(nil, nil)
+	require.ErrorContains(t, err, "no committed state")
+	_, err = gapp.Commit()
+	require.NoError(t, err)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	recipient := sdk.AccAddress("recipient___________").String()
+
+	query := func(denom string) abci.RequestQuery {
+		data, err := gapp.AppCodec().Marshal(&banktypes.QuerySendEnabledRequest{Denoms: []string{denom}})
+		require.NoError(t, err)
+		return abci.RequestQuery{Path: "/cosmos.bank.v1beta1.Query/SendEnabled", Data: data}
+	}
+	sendEnabled := func(bz []byte) []*banktypes.SendEnabled {
+		var res banktypes.QuerySendEnabledResponse
+		require.NoError(t, gapp.AppCodec().Unmarshal(bz, &res))
+		return res.SendEnabled
+	}
+	setSendEnabled := &banktypes.MsgSetSendEnabled{
+		Authority:   authority,
+		SendEnabled: []*banktypes.SendEnabled{{Denom: "ufoo", Enabled: false}},
+	}
+
+	// the changes of an executed proposal are reported but not written
+	simulation, err := gapp.SimulateProposal([]sdk.Msg{setSendEnabled}, []abci.RequestQuery{query("ufoo")})
+	require.NoError(t, err)
+	require.Empty(t, simulation.Error)
+	require.Equal(t, gapp.LastBlockHeight(), simulation.Height)
+	require.Len(t, simulation.Messages, 1)
+	require.Equal(t, sdk.MsgTypeURL(setSendEnabled), simulation.Messages[0].TypeURL)
+	require.NotZero(t, simulation.Messages[0].GasUsed)
+	require.Equal(t, simulation.Messages[0].GasUsed, simulation.GasUsed)
+	require.Len(t, simulation.Changes, 1)
+	require.Equal(t, banktypes.StoreKey, simulation.Changes[0].Store)
+	require.Nil(t, simulation.Changes[0].Before)
+	require.NotNil(t, simulation.Changes[0].After)
+	require.Len(t, simulation.Queries, 1)
+	require.Empty(t, simulation.Queries[0].Error)
+	require.Empty(t, sendEnabled(simulation.Queries[0].Before))
+	require.Equal(t, setSendEnabled.SendEnabled, sendEnabled(simulation.Queries[0].After))
+
+	ctx := gapp.NewUncachedContext(false, cmtproto.Header{})
+	_, found := gapp.BankKeeper.GetSendEnabledEntry(ctx, "ufoo")
+	require.False(t, found)
+
+	// a failing message discards the changes of the proposal
+	send := banktypes.NewMsgSend(authtypes.NewModuleAddress(govtypes.ModuleName), sdk.MustAccAddressFromBech32(recipient), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	simulation, err = gapp.SimulateProposal([]sdk.Msg{setSendEnabled, send}, []abci.RequestQuery{query("ufoo")})
+	require.NoError(t, err)
+	require.Contains(t, simulation.Error, "message 1 failed")
+	require.Len(t, simulation.Messages, 2)
+	require.Empty(t, simulation.Messages[0].Error)
+	require.Contains(t, simulation.Messages[1].Error, "insufficient funds")
+	require.Empty(t, simulation.Changes)
+	require.Equal(t, simulation.Queries[0].Before, simulation.Queries[0].After)
+
+	// messages rejected at submission are not executed
+	notGov := &banktypes.MsgSetSendEnabled{Authority: recipient}
+	simulation, err = gapp.SimulateProposal([]sdk.Msg{setSendEnabled, notGov}, nil)
+	require.NoError(t, err)
+	require.Contains(t, simulation.Error, "message 1: expected gov account as only signer for proposal message")
+	require.Empty(t, simulation.Messages)
+
+	simulation, err = gapp.SimulateProposal(nil, []abci.RequestQuery{{Path: "/unknown.Query/Foo"}})
+	require.NoError(t, err)
+	require.Equal(t, "proposal has no messages", simulation.Error)
+	require.Contains(t, simulation.Queries[0].Error, "unknown query path /unknown.Query/Foo")
+}
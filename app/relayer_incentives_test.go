@@ -0,0 +1,98 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	relayerincentivestypes "github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+)
+
+func TestRelayerIncentives(t *testing.T) {
+	coordinator := newCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+	enokiA, enokiB := chainA.App.(*EnokiApp), chainB.App.(*EnokiApp)
+	relayerA, relayerB := chainA.SenderAccount.GetAddress(), chainB.SenderAccount.GetAddress()
+
+	// A rewards acknowledgements and B rewards receipts on the path, of
+	// transfers of at least 100 of the bond denom of A
+	const epochBlocks = 1_000
+	reward := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	minTransfer := map[*ibctesting.Endpoint]sdk.Coins{
+		path.EndpointA: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		path.EndpointB: sdk.NewCoins(sdk.NewInt64Coin(transfertypes.NewDenom(sdk.DefaultBondDenom,
+			transfertypes.NewHop(transfertypes.PortID, path.EndpointB.ChannelID)).IBCDenom(), 100)),
+	}
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		enoki := endpoint.Chain.App.(*EnokiApp)
+		params := relayerincentivestypes.DefaultParams()
+		params.EpochBlocks = epochBlocks
+		params.ChannelRewards = []relayerincentivestypes.ChannelReward{{
+			PortId:             transfertypes.PortID,
+			ChannelId:          endpoint.ChannelID,
+			RecvReward:         reward,
+			AckReward:          reward,
+			MaxPacketsPerEpoch: 10,
+			MinTransfer:        minTransfer[endpoint],
+		}}
+		ctx := endpoint.Chain.GetContext()
+		require.NoError(t, enoki.RelayerIncentivesKeeper.Rewards.Set(ctx, params))
+
+		// the reward pool can be funded directly
+		pool := enoki.RelayerIncentivesKeeper.GetRewardPool()
+		require.NoError(t, enoki.BankKeeper.SendCoins(ctx, endpoint.Chain.SenderAccount.GetAddress(), pool, reward.MulInt(sdkmath.NewInt(10))))
+	}
+	coordinator.CommitBlock(chainA, chainB)
+
+	transfer := func(receiver string, amount int64) {
+		msg := transfertypes.NewMsgTransfer(
+			transfertypes.PortID, path.EndpointA.ChannelID, sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+			relayerA.String(), receiver, clienttypes.NewHeight(1, 1_000), 0, "",
+		)
+		res, err := chainA.SendMsgs(msg)
+		require.NoError(t, err)
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+		require.NoError(t, path.RelayPacket(packet))
+	}
+	transfer(relayerB.String(), 100)
+	// a packet acknowledged with an error only rewards its acknowledgement
+	transfer("invalid", 100)
+	// dust transfers are not rewarded
+	transfer(relayerB.String(), 99)
+
+	tallies, err := enokiB.RelayerIncentivesKeeper.Tallies(chainB.GetContext(), &relayerincentivestypes.QueryTalliesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []relayerincentivestypes.RelayerTally{{
+		Relayer: relayerB.String(), PortId: transfertypes.PortID, ChannelId: path.EndpointB.ChannelID,
+		Counts: relayerincentivestypes.PacketCounts{RecvPackets: 1},
+	}}, tallies.Tallies)
+
+	tallies, err = enokiA.RelayerIncentivesKeeper.Tallies(chainA.GetContext(), &relayerincentivestypes.QueryTalliesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []relayerincentivestypes.RelayerTally{{
+		Relayer: relayerA.String(), PortId: transfertypes.PortID, ChannelId: path.EndpointA.ChannelID,
+		Counts: relayerincentivestypes.PacketCounts{AckPackets: 2},
+	}}, tallies.Tallies)
+
+	// the relayers are paid at the end of the epoch
+	ctx := chainA.GetContext().WithBlockHeight(epochBlocks)
+	before := enokiA.BankKeeper.GetBalance(ctx, relayerA, sdk.DefaultBondDenom)
+	require.NoError(t, enokiA.RelayerIncentivesKeeper.PayRewards(ctx))
+	after := enokiA.BankKeeper.GetBalance(ctx, relayerA, sdk.DefaultBondDenom)
+	require.Equal(t, sdkmath.NewInt(2_000), after.Amount.Sub(before.Amount))
+
+	pool, err := enokiA.RelayerIncentivesKeeper.RewardPool(ctx, &relayerincentivestypes.QueryRewardPoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, reward.MulInt(sdkmath.NewInt(8)), pool.Balance)
+}
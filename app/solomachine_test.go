@@ -0,0 +1,80 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	soloallowlistkeeper "github.com/hyphacoop/cosmos-enoki/x/soloallowlist/keeper"
+	soloallowlisttypes "github.com/hyphacoop/cosmos-enoki/x/soloallowlist/types"
+)
+
+func TestSolomachineTransfer(t *testing.T) {
+	coordinator := newCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	enoki := chain.App.(*EnokiApp)
+	solo := ibctesting.NewSolomachine(t, chain.Codec, "custodian", "testing", 1)
+	sender := chain.SenderAccount.GetAddress()
+
+	// the solo machine key is not allowlisted yet
+	msgCreateClient, err := clienttypes.NewMsgCreateClient(solo.ClientState(), solo.ConsensusState(), sender.String())
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(msgCreateClient)
+	require.ErrorContains(t, err, soloallowlisttypes.ErrPublicKeyNotAllowed.Error())
+
+	params, err := soloallowlisttypes.NewParams(solo.PublicKey)
+	require.NoError(t, err)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = soloallowlistkeeper.NewMsgServerImpl(enoki.SoloAllowlistKeeper).UpdateParams(chain.GetContext(), soloallowlisttypes.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	coordinator.CommitBlock(chain)
+
+	// open a transfer channel to the solo machine
+	clientID := solo.CreateClient(chain)
+	connectionID := solo.ConnOpenInit(chain, clientID)
+	solo.ConnOpenAck(chain, clientID, connectionID)
+	channelID := solo.ChanOpenInit(chain, connectionID)
+	solo.ChanOpenAck(chain, channelID)
+
+	channel, found := enoki.IBCKeeper.ChannelKeeper.GetChannel(chain.GetContext(), transfertypes.PortID, channelID)
+	require.True(t, found)
+	require.Equal(t, channeltypes.OPEN, channel.State)
+
+	// send tokens to the solo machine
+	packet := solo.SendTransfer(chain, transfertypes.PortID, channelID)
+	solo.AcknowledgePacket(chain, packet)
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, channelID)
+	require.Equal(t, ibctesting.TestCoin, enoki.BankKeeper.GetBalance(chain.GetContext(), escrow, sdk.DefaultBondDenom))
+
+	// receive custodied tokens from the solo machine
+	data := transfertypes.NewFungibleTokenPacketData("ucustody", "1000", "custodian", sender.String(), "")
+	packet = channeltypes.NewPacket(
+		data.GetBytes(), 1,
+		transfertypes.PortID, channel.Counterparty.ChannelId,
+		transfertypes.PortID, channelID,
+		clienttypes.ZeroHeight(), uint64(chain.GetContext().BlockTime().Add(time.Hour).UnixNano()),
+	)
+	solo.RecvPacket(chain, packet)
+
+	voucher := transfertypes.NewDenom("ucustody", transfertypes.NewHop(transfertypes.PortID, channelID))
+	require.Equal(t, sdkmath.NewInt(1000), enoki.BankKeeper.GetBalance(chain.GetContext(), sender, voucher.IBCDenom()).Amount)
+
+	// the client cannot rotate to a key that is not allowlisted
+	header := solo.CreateHeader("rotated")
+	msgUpdateClient, err := clienttypes.NewMsgUpdateClient(clientID, header, sender.String())
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(msgUpdateClient)
+	require.ErrorContains(t, err, soloallowlisttypes.ErrPublicKeyNotAllowed.Error())
+}
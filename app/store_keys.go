@@ -0,0 +1,173 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/hyphacoop/cosmos-enoki/app/upgrades"
+)
+
+// StoreKeyReport compares the stores mounted by the app with the stores of
+// the last committed multistore, given the store upgrades of the upgrade
+// applied at the next version.
+type StoreKeyReport struct {
+	// Version is the last committed version, zero for a new chain.
+	Version int64
+	// Upgrade is the name of the upgrade whose store upgrades were checked.
+	Upgrade string
+	// Missing stores are mounted but neither committed nor added by the
+	// upgrade. Loading the multistore fails on them.
+	Missing []string
+	// Extra stores are committed but neither mounted nor deleted by the
+	// upgrade. Their state is dropped from the app hash.
+	Extra []string
+	// Conflicting stores are added by the upgrade while already committed, or
+	// deleted by it while still mounted.
+	Conflicting []string
+	// Renamed stores are moved by the upgrade.
+	Renamed []storetypes.StoreRename
+}
+
+// Err returns an error describing the missing, extra and conflicting stores
+// of the report, or nil if there are none.
+func (r StoreKeyReport) Err() error {
+	upgrade := "any upgrade"
+	if r.Upgrade != "" {
+		upgrade = "upgrade " + r.Upgrade
+	}
+
+	var errs []error
+	if len(r.Missing) > 0 {
+		errs = append(errs, fmt.Errorf("stores mounted but neither committed nor added by %s: %s", upgrade, strings.Join(r.Missing, ", ")))
+	}
+	if len(r.Extra) > 0 {
+		errs = append(errs, fmt.Errorf("stores committed but neither mounted nor deleted by %s: %s", upgrade, strings.Join(r.Extra, ", ")))
+	}
+	if len(r.Conflicting) > 0 {
+		errs = append(errs, fmt.Errorf("stores added by %s while committed or deleted while mounted: %s", upgrade, strings.Join(r.Conflicting, ", ")))
+	}
+	return errors.Join(errs...)
+}
+
+// VerifyStoreKeys compares the mounted stores with the stores of the last
+// committed version before the multistore is loaded. The store upgrades of
+// the named upgrade are applied to the comparison. Without a name, the
+// upgrade read from disk is used if the store loader applies it at the next
+// version.
+func (app *EnokiApp) VerifyStoreKeys(upgradeName string) (StoreKeyReport, error) {
+	cms, ok := app.CommitMultiStore().(*rootmulti.Store)
+	if !ok {
+		return StoreKeyReport{}, fmt.Errorf("unexpected commit multistore %T", app.CommitMultiStore())
+	}
+	version := cms.LastCommitID().Version
+
+	var storeUpgrades *storetypes.StoreUpgrades
+	if upgradeName != "" {
+		upgrade, ok := findUpgrade(upgradeName)
+		if !ok {
+			return StoreKeyReport{}, fmt.Errorf("unknown upgrade %s", upgradeName)
+		}
+		storeUpgrades = &upgrade.StoreUpgrades
+	} else {
+		// the store loader only applies an upgrade of this binary scheduled
+		// at the next version
+		upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+		if err != nil {
+			return StoreKeyReport{}, err
+		}
+		upgrade, ok := findUpgrade(upgradeInfo.Name)
+		if ok && upgradeInfo.Height == version+1 && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+			upgradeName = upgrade.UpgradeName
+			storeUpgrades = &upgrade.StoreUpgrades
+		}
+	}
+
+	// a new chain has no stores to compare with
+	if version == 0 {
+		return StoreKeyReport{Upgrade: upgradeName}, nil
+	}
+
+	commitInfo, err := cms.GetCommitInfo(version)
+	if err != nil {
+		return StoreKeyReport{}, err
+	}
+	committed := make([]string, 0, len(commitInfo.StoreInfos))
+	for _, storeInfo := range commitInfo.StoreInfos {
+		committed = append(committed, storeInfo.Name)
+	}
+	mounted := make([]string, 0, len(app.keys))
+	for name := range app.keys {
+		mounted = append(mounted, name)
+	}
+
+	report := checkStoreKeys(mounted, committed, storeUpgrades)
+	report.Version = version
+	report.Upgrade = upgradeName
+	return report, nil
+}
+
+// findUpgrade returns the upgrade of the app with the given name.
+func findUpgrade(name string) (upgrades.Upgrade, bool) {
+	for _, upgrade := range Upgrades {
+		if upgrade.UpgradeName == name {
+			return upgrade, true
+		}
+	}
+	return upgrades.Upgrade{}, false
+}
+
+// checkStoreKeys compares the mounted and committed stores like the
+// multistore does when it loads them with the store upgrades, which may be
+// nil.
+func checkStoreKeys(mounted, committed []string, storeUpgrades *storetypes.StoreUpgrades) StoreKeyReport {
+	isMounted, isCommitted := make(map[string]bool), make(map[string]bool)
+	for _, name := range mounted {
+		isMounted[name] = true
+	}
+	for _, name := range committed {
+		isCommitted[name] = true
+	}
+
+	var report StoreKeyReport
+	for _, name := range sortedNames(isMounted) {
+		switch oldName := storeUpgrades.RenamedFrom(name); {
+		case storeUpgrades.IsDeleted(name):
+			report.Conflicting = append(report.Conflicting, name)
+		case storeUpgrades.IsAdded(name) || oldName != "":
+			if isCommitted[name] {
+				report.Conflicting = append(report.Conflicting, name)
+			} else if oldName != "" && !isCommitted[oldName] {
+				report.Missing = append(report.Missing, name)
+			} else if oldName != "" {
+				report.Renamed = append(report.Renamed, storetypes.StoreRename{OldKey: oldName, NewKey: name})
+			}
+		case !isCommitted[name]:
+			report.Missing = append(report.Missing, name)
+		}
+	}
+	renamed := make(map[string]bool)
+	for _, rename := range report.Renamed {
+		renamed[rename.OldKey] = true
+	}
+	for _, name := range sortedNames(isCommitted) {
+		if !isMounted[name] && !storeUpgrades.IsDeleted(name) && !renamed[name] {
+			report.Extra = append(report.Extra, name)
+		}
+	}
+	return report
+}
+
+// sortedNames returns the names of the set in order.
+func sortedNames(set map[string]bool) []string {
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,126 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	v2_3_0 "github.com/hyphacoop/cosmos-enoki/app/upgrades/v2_3_0"
+)
+
+func TestCheckStoreKeys(t *testing.T) {
+	tests := []struct {
+		name          string
+		mounted       []string
+		committed     []string
+		storeUpgrades *storetypes.StoreUpgrades
+		expected      StoreKeyReport
+	}{
+		{
+			name:      "consistent",
+			mounted:   []string{"bank", "acc"},
+			committed: []string{"acc", "bank"},
+		},
+		{
+			name:      "store mounted without an upgrade",
+			mounted:   []string{"acc", "bank", "nft"},
+			committed: []string{"acc", "bank"},
+			expected:  StoreKeyReport{Missing: []string{"nft"}},
+		},
+		{
+			name:          "store mounted but not added by the upgrade",
+			mounted:       []string{"acc", "bank", "nft", "tokenpolicy"},
+			committed:     []string{"acc", "bank"},
+			storeUpgrades: &storetypes.StoreUpgrades{Added: []string{"nft"}},
+			expected:      StoreKeyReport{Missing: []string{"tokenpolicy"}},
+		},
+		{
+			name:          "store added by the upgrade",
+			mounted:       []string{"acc", "bank", "nft"},
+			committed:     []string{"acc", "bank"},
+			storeUpgrades: &storetypes.StoreUpgrades{Added: []string{"nft"}},
+		},
+		{
+			name:          "store added while committed",
+			mounted:       []string{"acc", "bank", "nft"},
+			committed:     []string{"acc", "bank", "nft"},
+			storeUpgrades: &storetypes.StoreUpgrades{Added: []string{"nft"}},
+			expected:      StoreKeyReport{Conflicting: []string{"nft"}},
+		},
+		{
+			name:      "store committed but not mounted",
+			mounted:   []string{"acc", "bank"},
+			committed: []string{"acc", "bank", "crisis"},
+			expected:  StoreKeyReport{Extra: []string{"crisis"}},
+		},
+		{
+			name:          "store deleted by the upgrade",
+			mounted:       []string{"acc", "bank"},
+			committed:     []string{"acc", "bank", "crisis"},
+			storeUpgrades: &storetypes.StoreUpgrades{Deleted: []string{"crisis"}},
+		},
+		{
+			name:          "store deleted while mounted",
+			mounted:       []string{"acc", "bank", "crisis"},
+			committed:     []string{"acc", "bank", "crisis"},
+			storeUpgrades: &storetypes.StoreUpgrades{Deleted: []string{"crisis"}},
+			expected:      StoreKeyReport{Conflicting: []string{"crisis"}},
+		},
+		{
+			name:          "store renamed by the upgrade",
+			mounted:       []string{"acc", "bank", "nfttransfer"},
+			committed:     []string{"acc", "bank", "nft-transfer"},
+			storeUpgrades: &storetypes.StoreUpgrades{Renamed: []storetypes.StoreRename{{OldKey: "nft-transfer", NewKey: "nfttransfer"}}},
+			expected:      StoreKeyReport{Renamed: []storetypes.StoreRename{{OldKey: "nft-transfer", NewKey: "nfttransfer"}}},
+		},
+		{
+			name:          "store renamed from a store not committed",
+			mounted:       []string{"acc", "bank", "nfttransfer"},
+			committed:     []string{"acc", "bank"},
+			storeUpgrades: &storetypes.StoreUpgrades{Renamed: []storetypes.StoreRename{{OldKey: "nft-transfer", NewKey: "nfttransfer"}}},
+			expected:      StoreKeyReport{Missing: []string{"nfttransfer"}},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			report := checkStoreKeys(tc.mounted, tc.committed, tc.storeUpgrades)
+			require.Equal(t, tc.expected, report)
+			if len(tc.expected.Missing)+len(tc.expected.Extra)+len(tc.expected.Conflicting) == 0 {
+				require.NoError(t, report.Err())
+			} else {
+				require.Error(t, report.Err())
+			}
+		})
+	}
+}
+
+func TestVerifyStoreKeys(t *testing.T) {
+	h := newUpgradeHarness(t, upgradeFixtureGenesis)
+	h.initChain([]string{"legacy"})
+	h.start(false, nil)
+
+	// a store of the previous binary is no longer mounted
+	report, err := h.app.VerifyStoreKeys("")
+	require.NoError(t, err)
+	require.Equal(t, StoreKeyReport{Version: 1, Extra: []string{"legacy"}}, report)
+	require.ErrorContains(t, report.Err(), "stores committed but neither mounted nor deleted by any upgrade: legacy")
+
+	// the upgrade the node halted for adds stores that are already committed
+	upgrade, ok := findUpgrade(v2_3_0.UpgradeName)
+	require.True(t, ok)
+	require.NoError(t, h.app.UpgradeKeeper.DumpUpgradeInfoToDisk(2, upgradetypes.Plan{Name: v2_3_0.UpgradeName, Height: 2}))
+	report, err = h.app.VerifyStoreKeys("")
+	require.NoError(t, err)
+	require.Equal(t, v2_3_0.UpgradeName, report.Upgrade)
+	require.ElementsMatch(t, upgrade.StoreUpgrades.Added, report.Conflicting)
+	require.Equal(t, []string{"legacy"}, report.Extra)
+
+	_, err = h.app.VerifyStoreKeys("v0.0.0")
+	require.ErrorContains(t, err, "unknown upgrade v0.0.0")
+
+	// the node refuses to start
+	require.PanicsWithError(t, "error verifying store keys: "+report.Err().Error(), func() { h.start(true, nil) })
+}
@@ -44,6 +44,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
 const chainID = "testing"
@@ -92,6 +94,30 @@ func setup(
 	return app, GenesisState{}
 }
 
+// NewTestingAppCreator returns an ibctesting.AppCreator building EnokiApp
+// instances with their own home directory. The fee market is disabled so the
+// zero fee transactions of the testing package are accepted.
+func NewTestingAppCreator(t testing.TB) ibctesting.AppCreator {
+	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app, genesisState := setup(t, "", true, 5)
+
+		var feemarketGenesis feemarkettypes.GenesisState
+		app.AppCodec().MustUnmarshalJSON(genesisState[feemarkettypes.ModuleName], &feemarketGenesis)
+		feemarketGenesis.Params.Enabled = false
+		genesisState[feemarkettypes.ModuleName] = app.AppCodec().MustMarshalJSON(&feemarketGenesis)
+
+		return app, genesisState
+	}
+}
+
+// newCoordinator returns a coordinator of n EnokiApp chains built by
+// NewTestingAppCreator. The global ibctesting.DefaultTestingAppInit is left
+// alone so that tests using it can run in parallel.
+func newCoordinator(t *testing.T, n int) *ibctesting.Coordinator {
+	t.Helper()
+	return ibctesting.NewCustomAppCoordinator(t, n, NewTestingAppCreator(t))
+}
+
 // NewChainAppWithCustomOptions initializes a new ChainApp with custom options.
 func NewChainAppWithCustomOptions(t *testing.T, isCheckTx bool, options SetupOptions) *EnokiApp {
 	t.Helper()
@@ -4,6 +4,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -23,6 +24,10 @@ func (app *EnokiApp) GetBaseApp() *baseapp.BaseApp {
 	return app.BaseApp
 }
 
+func (app *EnokiApp) GetTxConfig() client.TxConfig {
+	return app.TxConfig()
+}
+
 func (app *EnokiApp) GetBankKeeper() bankkeeper.Keeper {
 	return app.BankKeeper
 }
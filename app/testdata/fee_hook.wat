@@ -0,0 +1,35 @@
+;; fee_hook is a minimal CosmWasm contract used as a before send hook in the
+;; app tests. Its sudo entry point accepts every message and asks for a fee
+;; of 10 by returning the data {"fee":"10"}.
+;;
+;; fee_hook.wasm is assembled from this file with `wat2wasm fee_hook.wat`.
+(module
+  (memory (export "memory") 2)
+
+  ;; bump allocator over the memory after the static data
+  (global $heap (mut i32) (i32.const 1024))
+
+  ;; regions of the instantiate and sudo results
+  (data (i32.const 0) "\40\00\00\00\3e\00\00\00\3e\00\00\00")
+  (data (i32.const 12) "\7e\00\00\00\4c\00\00\00\4c\00\00\00")
+  (data (i32.const 64) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":null}}")
+  (data (i32.const 126) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":\"eyJmZWUiOiIxMCJ9\"}}")
+
+  (func (export "interface_version_8"))
+
+  (func (export "allocate") (param $size i32) (result i32)
+    (local $region i32)
+    (local.set $region (global.get $heap))
+    (i32.store offset=0 (local.get $region) (i32.add (local.get $region) (i32.const 12)))
+    (i32.store offset=4 (local.get $region) (local.get $size))
+    (i32.store offset=8 (local.get $region) (i32.const 0))
+    (global.set $heap (i32.add (i32.add (global.get $heap) (i32.const 12)) (local.get $size)))
+    (local.get $region))
+
+  (func (export "deallocate") (param $region i32))
+
+  (func (export "instantiate") (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    (i32.const 0))
+
+  (func (export "sudo") (param $env i32) (param $msg i32) (result i32)
+    (i32.const 12)))
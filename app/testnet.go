@@ -0,0 +1,225 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// TestnetValidatorTokens are the tokens self-delegated to the validator of an
+// in-place testnet.
+var TestnetValidatorTokens = sdkmath.NewInt(900_000_000_000_000)
+
+// TestnetConfig configures the state changes turning a copy of a chain into
+// an in-place testnet.
+type TestnetConfig struct {
+	// ValidatorPubKey is the consensus key of the only validator of the
+	// testnet.
+	ValidatorPubKey cryptotypes.PubKey
+	// OperatorAddress operates the validator and is funded like the
+	// accounts to fund.
+	OperatorAddress sdk.AccAddress
+	// AccountsToFund receive FundCoins, minted on the testnet.
+	AccountsToFund []sdk.AccAddress
+	FundCoins      sdk.Coins
+	// VotingPeriod replaces the governance voting and deposit periods. The
+	// expedited voting period is half of it.
+	VotingPeriod time.Duration
+	// KeepIBCClients keeps the IBC clients of the chain usable. Otherwise
+	// only the localhost client is allowed, so no packet of the testnet
+	// reaches the counterparties of the chain.
+	KeepIBCClients bool
+	// UpgradeToTrigger is applied at the first block of the testnet.
+	UpgradeToTrigger string
+}
+
+// InitForTestnet turns the last committed state into a single validator
+// testnet. The validators of the chain are jailed and unbonded, and a new
+// validator is created with the consensus key of the node. The changes are
+// committed with the first block of the testnet, none are made on error.
+func (app *EnokiApp) InitForTestnet(cfg TestnetConfig) error {
+	height := app.LastBlockHeight()
+	now := time.Now().UTC()
+	ctx, write := app.NewUncachedContext(false, cmtproto.Header{ChainID: app.ChainID(), Height: height, Time: now}).
+		WithHeaderInfo(header.Info{ChainID: app.ChainID(), Height: height, Time: now}).
+		CacheContext()
+
+	// jail every validator so none of them is bonded again
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return err
+	}
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return err
+	}
+	for _, validator := range validators {
+		if err := app.removeTestnetValidator(ctx, validator, bondDenom); err != nil {
+			return fmt.Errorf("removing validator %s: %w", validator.OperatorAddress, err)
+		}
+	}
+
+	// fund the operator with its self-delegation and the chosen accounts
+	fundCoins := cfg.FundCoins
+	if fundCoins.Empty() {
+		fundCoins = sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000_000_000))
+	}
+	selfDelegation := sdk.NewCoin(bondDenom, TestnetValidatorTokens)
+	accounts := append([]sdk.AccAddress{cfg.OperatorAddress}, cfg.AccountsToFund...)
+	for i, account := range accounts {
+		coins := fundCoins
+		if i == 0 {
+			coins = coins.Add(selfDelegation)
+		}
+		if err := app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, coins); err != nil {
+			return fmt.Errorf("funding %s: %w", account, err)
+		}
+	}
+
+	// the validator is bonded by the staking end blocker of the first block,
+	// it signs that block with the signing info set here
+	if err := app.createTestnetValidator(ctx, cfg, selfDelegation); err != nil {
+		return fmt.Errorf("creating validator: %w", err)
+	}
+	consAddr := sdk.ConsAddress(cfg.ValidatorPubKey.Address())
+	signingInfo := slashingtypes.NewValidatorSigningInfo(consAddr, height, 0, time.Unix(0, 0), false, 0)
+	if err := app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr, signingInfo); err != nil {
+		return err
+	}
+
+	if cfg.VotingPeriod > 0 {
+		params, err := app.GovKeeper.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		expedited := cfg.VotingPeriod / 2
+		params.VotingPeriod = &cfg.VotingPeriod
+		params.MaxDepositPeriod = &cfg.VotingPeriod
+		params.ExpeditedVotingPeriod = &expedited
+		if err := params.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid gov params: %w", err)
+		}
+		if err := app.GovKeeper.Params.Set(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	if !cfg.KeepIBCClients {
+		app.IBCKeeper.ClientKeeper.SetParams(ctx, ibcclienttypes.NewParams(ibcexported.Localhost))
+	}
+
+	if cfg.UpgradeToTrigger != "" {
+		if _, ok := findUpgrade(cfg.UpgradeToTrigger); !ok {
+			return fmt.Errorf("unknown upgrade %s", cfg.UpgradeToTrigger)
+		}
+		plan := upgradetypes.Plan{Name: cfg.UpgradeToTrigger, Height: height + 1, Info: "in-place testnet"}
+		if err := app.UpgradeKeeper.ScheduleUpgrade(ctx, plan); err != nil {
+			return err
+		}
+	}
+
+	write()
+	return nil
+}
+
+// removeTestnetValidator jails the validator and unbonds it at once if it is
+// bonded, keeping its delegations.
+func (app *EnokiApp) removeTestnetValidator(ctx sdk.Context, validator stakingtypes.Validator, bondDenom string) error {
+	if err := app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validator); err != nil {
+		return err
+	}
+	validator.Jailed = true
+
+	if validator.IsBonded() {
+		operator, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(validator.OperatorAddress)
+		if err != nil {
+			return err
+		}
+		if err := app.StakingKeeper.DeleteLastValidatorPower(ctx, operator); err != nil {
+			return err
+		}
+		tokens := sdk.NewCoins(sdk.NewCoin(bondDenom, validator.Tokens))
+		if err := app.BankKeeper.SendCoinsFromModuleToModule(ctx, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, tokens); err != nil {
+			return err
+		}
+		validator = validator.UpdateStatus(stakingtypes.Unbonded)
+		validator.UnbondingHeight = ctx.BlockHeight()
+		validator.UnbondingTime = ctx.BlockTime()
+	}
+
+	return app.StakingKeeper.SetValidator(ctx, validator)
+}
+
+// createTestnetValidator creates the validator of the testnet through the
+// staking message server.
+func (app *EnokiApp) createTestnetValidator(ctx sdk.Context, cfg TestnetConfig, selfDelegation sdk.Coin) error {
+	params, err := app.StakingKeeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	commission := stakingtypes.NewCommissionRates(
+		sdkmath.LegacyMaxDec(sdkmath.LegacyNewDecWithPrec(5, 2), params.MinCommissionRate),
+		sdkmath.LegacyOneDec(),
+		sdkmath.LegacyNewDecWithPrec(1, 2),
+	)
+	operator, err := app.StakingKeeper.ValidatorAddressCodec().BytesToString(cfg.OperatorAddress)
+	if err != nil {
+		return err
+	}
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		operator,
+		cfg.ValidatorPubKey,
+		selfDelegation,
+		stakingtypes.NewDescription("testnet", "", "", "", ""),
+		commission,
+		sdkmath.OneInt(),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(ctx, msg)
+	return err
+}
+
+// WriteTestnetUpgradeInfo writes the upgrade triggered on an in-place
+// testnet to the upgrade info file of the node, for the next height of the
+// database. The store loader then applies its store upgrades, as it does
+// when a node switches binaries for the upgrade.
+func WriteTestnetUpgradeInfo(db dbm.DB, homePath, upgradeName string) error {
+	if _, ok := findUpgrade(upgradeName); !ok {
+		return fmt.Errorf("unknown upgrade %s", upgradeName)
+	}
+	plan := upgradetypes.Plan{Name: upgradeName, Height: rootmulti.GetLatestVersion(db) + 1}
+	bz, err := json.Marshal(plan)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Join(homePath, "data")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, upgradetypes.UpgradeInfoFilename), bz, 0o600)
+}
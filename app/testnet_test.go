@@ -0,0 +1,135 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcclienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+func TestInitForTestnet(t *testing.T) {
+	h := newUpgradeHarness(t, upgradeFixtureGenesis)
+	h.initChain(nil)
+	h.finalizeBlock()
+
+	ctx := h.context()
+	chainValidators, err := h.app.StakingKeeper.GetLastValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, chainValidators)
+	bondDenom, err := h.app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	fundCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 5_000_000))
+	require.NoError(t, h.app.InitForTestnet(TestnetConfig{
+		ValidatorPubKey: pubKey,
+		OperatorAddress: operator,
+		AccountsToFund:  []sdk.AccAddress{account},
+		FundCoins:       fundCoins,
+		VotingPeriod:    time.Minute,
+	}))
+
+	// the first block is signed by the new validator, which replaces the
+	// validators of the chain in the validator updates
+	power := TestnetValidatorTokens.Quo(sdk.DefaultPowerReduction).Int64()
+	h.blockAt = h.blockAt.Add(upgradeBlockTime)
+	res, err := h.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: h.app.LastBlockHeight() + 1,
+		Time:   h.blockAt,
+		DecidedLastCommit: abci.CommitInfo{Votes: []abci.VoteInfo{{
+			Validator:   abci.Validator{Address: pubKey.Address(), Power: power},
+			BlockIdFlag: cmtproto.BlockIDFlagCommit,
+		}}},
+	})
+	require.NoError(t, err)
+	_, err = h.app.Commit()
+	require.NoError(t, err)
+	require.Len(t, res.ValidatorUpdates, 1)
+	require.Equal(t, pubKey.Bytes(), res.ValidatorUpdates[0].PubKey.GetEd25519())
+	require.Equal(t, power, res.ValidatorUpdates[0].Power)
+
+	ctx = h.context()
+	validators, err := h.app.StakingKeeper.GetLastValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	require.Equal(t, sdk.ValAddress(operator).String(), validators[0].OperatorAddress)
+	for _, chainValidator := range chainValidators {
+		validator, err := h.app.StakingKeeper.GetValidator(ctx, mustValAddress(t, chainValidator.OperatorAddress))
+		require.NoError(t, err)
+		require.True(t, validator.Jailed)
+		require.True(t, validator.IsUnbonded())
+	}
+	requireInvariants(t, h.app, ctx)
+
+	require.Equal(t, fundCoins, h.app.BankKeeper.GetAllBalances(ctx, operator))
+	require.Equal(t, fundCoins, h.app.BankKeeper.GetAllBalances(ctx, account))
+	govParams, err := h.app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, *govParams.VotingPeriod)
+	require.Equal(t, 30*time.Second, *govParams.ExpeditedVotingPeriod)
+	require.NoError(t, govParams.ValidateBasic())
+	require.Equal(t, ibcclienttypes.NewParams(ibcexported.Localhost), h.app.IBCKeeper.ClientKeeper.GetParams(ctx))
+
+	// the testnet keeps producing blocks after a restart
+	h.start(true, nil)
+	h.finalizeBlock()
+	requireInvariants(t, h.app, h.context())
+}
+
+func TestInitForTestnetTriggersUpgrade(t *testing.T) {
+	h := newUpgradeHarness(t, upgradeFixtureGenesis)
+	h.initChain(nil)
+
+	upgrade := Upgrades[len(Upgrades)-1]
+	cfg := TestnetConfig{
+		ValidatorPubKey:  ed25519.GenPrivKey().PubKey(),
+		OperatorAddress:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		UpgradeToTrigger: "unknown",
+		KeepIBCClients:   true,
+	}
+	require.ErrorContains(t, h.app.InitForTestnet(cfg), "unknown upgrade unknown")
+	require.ErrorContains(t, WriteTestnetUpgradeInfo(h.db, h.home, "unknown"), "unknown upgrade unknown")
+
+	// the upgrade info names the next height for the store loader
+	require.NoError(t, WriteTestnetUpgradeInfo(h.db, h.home, upgrade.UpgradeName))
+	upgradeInfo, err := h.app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, upgrade.UpgradeName, upgradeInfo.Name)
+	require.Equal(t, h.app.LastBlockHeight()+1, upgradeInfo.Height)
+
+	// the stores of the upgrade are mounted already, the handler runs at the
+	// first block
+	govParams, err := h.app.GovKeeper.Params.Get(h.context())
+	require.NoError(t, err)
+	cfg.UpgradeToTrigger = upgrade.UpgradeName
+	require.NoError(t, h.app.InitForTestnet(cfg))
+	height := h.app.LastBlockHeight() + 1
+	h.finalizeBlock()
+
+	ctx := h.context()
+	doneHeight, err := h.app.UpgradeKeeper.GetDoneHeight(ctx, upgrade.UpgradeName)
+	require.NoError(t, err)
+	require.Equal(t, height, doneHeight)
+	unchanged, err := h.app.GovKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, govParams, unchanged)
+	require.Equal(t, ibcclienttypes.NewParams(ibcclienttypes.AllowAllClients), h.app.IBCKeeper.ClientKeeper.GetParams(ctx))
+}
+
+func mustValAddress(t *testing.T, operator string) sdk.ValAddress {
+	t.Helper()
+	addr, err := sdk.ValAddressFromBech32(operator)
+	require.NoError(t, err)
+	return addr
+}
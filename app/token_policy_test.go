@@ -0,0 +1,353 @@
+package app
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtestdata "github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	tokenfactorybindingstypes "github.com/cosmos/tokenfactory/x/tokenfactory/bindings/types"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	tokenpolicybindings "github.com/hyphacoop/cosmos-enoki/wasmbinding/tokenpolicy"
+	tokenpolicytypes "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+func TestTokenPolicyTransfer(t *testing.T) {
+	coordinator := newCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+	enokiA := chainA.App.(*EnokiApp)
+	admin, receiver := chainA.SenderAccount.GetAddress(), chainB.SenderAccount.GetAddress()
+
+	// the admin creates a denom and limits it to the path's channel with a
+	// quota of 500 per hour
+	denom, err := tokenfactorytypes.GetTokenDenom(admin.String(), "mushroom")
+	require.NoError(t, err)
+	policy := tokenpolicytypes.IBCPolicy{
+		Denom:           denom,
+		AllowedChannels: []string{path.EndpointA.ChannelID},
+		OutboundQuota:   sdkmath.NewInt(500),
+		QuotaPeriod:     time.Hour,
+	}
+	_, err = chainA.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(admin.String(), "mushroom"),
+		tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 1_000)),
+		tokenpolicytypes.NewMsgSetIBCPolicy(admin.String(), policy),
+	)
+	require.NoError(t, err)
+
+	transfer := func(amount int64, receiver string) error {
+		msg := transfertypes.NewMsgTransfer(
+			transfertypes.PortID, path.EndpointA.ChannelID, sdk.NewInt64Coin(denom, amount),
+			admin.String(), receiver, clienttypes.NewHeight(1, 1_000), 0, "",
+		)
+		res, err := chainA.SendMsgs(msg)
+		if err != nil {
+			return err
+		}
+		packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+		require.NoError(t, err)
+		require.NoError(t, path.RelayPacket(packet))
+		return nil
+	}
+	remaining := func() sdkmath.Int {
+		res, err := enokiA.TokenPolicyKeeper.IBCPolicy(chainA.GetContext(), &tokenpolicytypes.QueryIBCPolicyRequest{Denom: denom})
+		require.NoError(t, err)
+		return *res.RemainingQuota
+	}
+
+	require.NoError(t, transfer(300, receiver.String()))
+	require.Equal(t, sdkmath.NewInt(200), remaining())
+	require.ErrorContains(t, transfer(300, receiver.String()), tokenpolicytypes.ErrQuotaExceeded.Error())
+
+	// a transfer acknowledged with an error is returned to the quota
+	require.NoError(t, transfer(200, "invalid"))
+	require.Equal(t, sdkmath.NewInt(200), remaining())
+
+	// other channels and disabled denoms are rejected before reaching core IBC.
+	// Channel ids are unique across the chains of a test run, so the channel
+	// of chain B is never the one of chain A.
+	policy.AllowedChannels = []string{path.EndpointB.ChannelID}
+	_, err = chainA.SendMsgs(tokenpolicytypes.NewMsgSetIBCPolicy(admin.String(), policy))
+	require.NoError(t, err)
+	require.ErrorContains(t, transfer(1, receiver.String()), tokenpolicytypes.ErrChannelNotAllowed.Error())
+
+	policy.Disabled = true
+	_, err = chainA.SendMsgs(tokenpolicytypes.NewMsgSetIBCPolicy(admin.String(), policy))
+	require.NoError(t, err)
+	require.ErrorContains(t, transfer(1, receiver.String()), tokenpolicytypes.ErrTransferDisabled.Error())
+
+	// removing the policy lets the denom move freely again
+	_, err = chainA.SendMsgs(tokenpolicytypes.NewMsgRemoveIBCPolicy(admin.String(), denom))
+	require.NoError(t, err)
+	require.NoError(t, transfer(500, receiver.String()))
+}
+
+func TestTokenFactoryCapabilities(t *testing.T) {
+	coordinator := newCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	enoki := chain.App.(*EnokiApp)
+	admin, holder := chain.SenderAccount.GetAddress(), chain.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// the admin opts in to both capabilities before minting the first
+	// tokens
+	denom, err := tokenfactorytypes.GetTokenDenom(admin.String(), "mushroom")
+	require.NoError(t, err)
+	capabilities := []string{tokenfactorytypes.EnableForceTransfer, tokenfactorytypes.EnableBurnFrom}
+	_, err = chain.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(admin.String(), "mushroom"),
+		tokenpolicytypes.NewMsgSetDenomCapabilities(admin.String(), denom, capabilities),
+		tokenfactorytypes.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(denom, 1_000), holder.String()),
+	)
+	require.NoError(t, err)
+
+	_, err = chain.SendMsgs(
+		tokenfactorytypes.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(denom, 100), holder.String(), admin.String()),
+		tokenfactorytypes.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(denom, 100), holder.String()),
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(800), enoki.BankKeeper.GetBalance(chain.GetContext(), holder, denom).Amount.Int64())
+
+	// a denom minted without opting in cannot opt in later
+	plain, err := tokenfactorytypes.GetTokenDenom(admin.String(), "plain")
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(admin.String(), "plain"),
+		tokenfactorytypes.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(plain, 1_000), holder.String()),
+	)
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(tokenpolicytypes.NewMsgSetDenomCapabilities(admin.String(), plain, capabilities))
+	require.ErrorContains(t, err, tokenpolicytypes.ErrDenomHasSupply.Error())
+	_, err = chain.SendMsgs(tokenfactorytypes.NewMsgForceTransfer(admin.String(), sdk.NewInt64Coin(plain, 100), holder.String(), admin.String()))
+	require.ErrorContains(t, err, tokenfactorytypes.ErrCapabilityNotEnabled.Error())
+	_, err = chain.SendMsgs(tokenfactorytypes.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(plain, 100), holder.String()))
+	require.ErrorContains(t, err, tokenfactorytypes.ErrCapabilityNotEnabled.Error())
+
+	// the tokenfactory bindings are held to the same capabilities
+	messenger := tokenpolicybindings.CustomMessageDecorator(enoki.TokenPolicyKeeper)(nopMessenger{})
+	dispatch := func(msg tokenfactorybindingstypes.TokenFactoryMsg) error {
+		bz, err := json.Marshal(msg)
+		require.NoError(t, err)
+		_, _, _, err = messenger.DispatchMsg(chain.GetContext(), admin, "", wasmvmtypes.CosmosMsg{Custom: bz})
+		return err
+	}
+	forceTransfer := func(denom string) tokenfactorybindingstypes.TokenFactoryMsg {
+		return tokenfactorybindingstypes.TokenFactoryMsg{ForceTransfer: &tokenfactorybindingstypes.ForceTransfer{
+			Denom: denom, Amount: sdkmath.NewInt(1), FromAddress: holder.String(), ToAddress: admin.String(),
+		}}
+	}
+	burnFrom := func(denom string) tokenfactorybindingstypes.TokenFactoryMsg {
+		return tokenfactorybindingstypes.TokenFactoryMsg{BurnTokens: &tokenfactorybindingstypes.BurnTokens{
+			Denom: denom, Amount: sdkmath.NewInt(1), BurnFromAddress: holder.String(),
+		}}
+	}
+	require.NoError(t, dispatch(forceTransfer(denom)))
+	require.NoError(t, dispatch(burnFrom(denom)))
+	require.ErrorIs(t, dispatch(forceTransfer(plain)), tokenfactorytypes.ErrCapabilityNotEnabled)
+	require.ErrorIs(t, dispatch(burnFrom(plain)), tokenfactorytypes.ErrCapabilityNotEnabled)
+
+	// governance can enable a capability for every denom
+	params := tokenpolicytypes.DefaultParams()
+	params.EnabledCapabilities = []string{tokenfactorytypes.EnableBurnFrom}
+	require.NoError(t, enoki.TokenPolicyKeeper.ParamsStore.Set(chain.GetContext(), params))
+	_, err = chain.SendMsgs(tokenfactorytypes.NewMsgBurnFrom(admin.String(), sdk.NewInt64Coin(plain, 100), holder.String()))
+	require.NoError(t, err)
+}
+
+// nopMessenger accepts every message.
+type nopMessenger struct{}
+
+func (nopMessenger) DispatchMsg(sdk.Context, sdk.AccAddress, string, wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	return nil, nil, nil, nil
+}
+
+func TestBeforeSendHook(t *testing.T) {
+	coordinator := newCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	enoki := chain.App.(*EnokiApp)
+	admin, holder := chain.SenderAccount.GetAddress(), chain.SenderAccounts[1].SenderAccount.GetAddress()
+
+	contract := instantiateBlockingHook(t, chain, admin)
+
+	denom, err := tokenfactorytypes.GetTokenDenom(admin.String(), "mushroom")
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(admin.String(), "mushroom"),
+		tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 1_000)),
+	)
+	require.NoError(t, err)
+
+	// only contracts can be hooks
+	_, err = chain.SendMsgs(tokenpolicytypes.NewMsgSetBeforeSendHook(admin.String(), denom, holder.String()))
+	require.ErrorContains(t, err, tokenpolicytypes.ErrInvalidHook.Error())
+	_, err = chain.SendMsgs(tokenpolicytypes.NewMsgSetBeforeSendHook(admin.String(), denom, contract.String()))
+	require.NoError(t, err)
+
+	// bank sends and mints of the denom now go through the hook, other
+	// denoms are unaffected
+	_, err = chain.SendMsgs(banktypes.NewMsgSend(admin, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	require.ErrorContains(t, err, tokenpolicytypes.ErrSendBlocked.Error())
+	_, err = chain.SendMsgs(tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 1_000)))
+	require.ErrorContains(t, err, tokenpolicytypes.ErrSendBlocked.Error())
+	_, err = chain.SendMsgs(banktypes.NewMsgSend(admin, holder, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))
+	require.NoError(t, err)
+
+	_, err = chain.SendMsgs(tokenpolicytypes.NewMsgSetBeforeSendHook(admin.String(), denom, ""))
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(banktypes.NewMsgSend(admin, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	require.NoError(t, err)
+	require.Equal(t, int64(100), enoki.BankKeeper.GetBalance(chain.GetContext(), holder, denom).Amount.Int64())
+
+	// a hook asking for a fee is paid by the sender on top of the transfer
+	feeHook := instantiateFeeHook(t, chain, admin)
+	_, err = chain.SendMsgs(tokenpolicytypes.NewMsgSetBeforeSendHook(admin.String(), denom, feeHook.String()))
+	require.NoError(t, err)
+	res, err := chain.SendMsgs(banktypes.NewMsgSend(admin, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	require.NoError(t, err)
+	require.True(t, hasEvent(res.Events, tokenpolicytypes.EventTypeHookFee))
+	require.Equal(t, int64(200), enoki.BankKeeper.GetBalance(chain.GetContext(), holder, denom).Amount.Int64())
+	require.Equal(t, int64(10), enoki.BankKeeper.GetBalance(chain.GetContext(), feeHook, denom).Amount.Int64())
+	require.Equal(t, int64(790), enoki.BankKeeper.GetBalance(chain.GetContext(), admin, denom).Amount.Int64())
+}
+
+func TestBeforeSendHookTransferRefund(t *testing.T) {
+	coordinator := newCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+	enokiA := chainA.App.(*EnokiApp)
+	admin, receiver := chainA.SenderAccount.GetAddress(), chainB.SenderAccount.GetAddress()
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+	feeHook, blockingHook := instantiateFeeHook(t, chainA, admin), instantiateBlockingHook(t, chainA, admin)
+
+	denom, err := tokenfactorytypes.GetTokenDenom(admin.String(), "mushroom")
+	require.NoError(t, err)
+	_, err = chainA.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(admin.String(), "mushroom"),
+		tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 1_000)),
+		tokenpolicytypes.NewMsgSetBeforeSendHook(admin.String(), denom, feeHook.String()),
+	)
+	require.NoError(t, err)
+	balance := func(addr sdk.AccAddress) int64 {
+		return enokiA.BankKeeper.GetBalance(chainA.GetContext(), addr, denom).Amount.Int64()
+	}
+
+	// escrowing the tokens goes through the hook and pays its fee
+	timeoutHeight := clienttypes.GetSelfHeight(chainB.GetContext()).Increment().(clienttypes.Height)
+	res, err := chainA.SendMsgs(transfertypes.NewMsgTransfer(
+		transfertypes.PortID, path.EndpointA.ChannelID, sdk.NewInt64Coin(denom, 100),
+		admin.String(), receiver.String(), timeoutHeight, 0, "",
+	))
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+	require.Equal(t, int64(890), balance(admin))
+	require.Equal(t, int64(100), balance(escrow))
+
+	// once the hook blocks every send, the refund of the timed out transfer
+	// still leaves the escrow account
+	_, err = chainA.SendMsgs(tokenpolicytypes.NewMsgSetBeforeSendHook(admin.String(), denom, blockingHook.String()))
+	require.NoError(t, err)
+	coordinator.CommitNBlocks(chainB, 2)
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+	require.Equal(t, int64(990), balance(admin))
+	require.Zero(t, balance(escrow))
+}
+
+// instantiateBlockingHook instantiates hackatom on chain. Its sudo entry
+// point does not understand block_before_send, so as a hook it blocks every
+// transfer.
+func instantiateBlockingHook(t *testing.T, chain *ibctesting.TestChain, creator sdk.AccAddress) sdk.AccAddress {
+	t.Helper()
+	initMsg, err := json.Marshal(map[string]string{"verifier": creator.String(), "beneficiary": creator.String()})
+	require.NoError(t, err)
+	return instantiateContract(t, chain, creator, wasmtestdata.HackatomContractWasm(), initMsg)
+}
+
+// instantiateFeeHook instantiates testdata/fee_hook.wasm on chain. As a hook
+// it lets every transfer through for a fee of 10.
+func instantiateFeeHook(t *testing.T, chain *ibctesting.TestChain, creator sdk.AccAddress) sdk.AccAddress {
+	t.Helper()
+	wasm, err := os.ReadFile("testdata/fee_hook.wasm")
+	require.NoError(t, err)
+	return instantiateContract(t, chain, creator, wasm, []byte("{}"))
+}
+
+func instantiateContract(t *testing.T, chain *ibctesting.TestChain, creator sdk.AccAddress, wasm, initMsg []byte) sdk.AccAddress {
+	t.Helper()
+	contractKeeper := wasmkeeper.NewDefaultPermissionKeeper(&chain.App.(*EnokiApp).WasmKeeper)
+	codeID, _, err := contractKeeper.Create(chain.GetContext(), creator, wasm, nil)
+	require.NoError(t, err)
+	contract, _, err := contractKeeper.Instantiate(chain.GetContext(), codeID, creator, nil, initMsg, "hook", nil)
+	require.NoError(t, err)
+	return contract
+}
+
+func TestSupplyPolicy(t *testing.T) {
+	coordinator := newCoordinator(t, 1)
+	chain := coordinator.GetChain(ibctesting.GetChainID(1))
+	enoki := chain.App.(*EnokiApp)
+	admin := chain.SenderAccount.GetAddress()
+
+	// the admin caps the supply at 1000 and mints at most 600 per hour
+	denom, err := tokenfactorytypes.GetTokenDenom(admin.String(), "mushroom")
+	require.NoError(t, err)
+	policy := tokenpolicytypes.SupplyPolicy{
+		Denom:      denom,
+		MaxSupply:  sdkmath.NewInt(1_000),
+		MintLimit:  sdkmath.NewInt(600),
+		MintPeriod: time.Hour,
+	}
+	_, err = chain.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(admin.String(), "mushroom"),
+		tokenpolicytypes.NewMsgSetSupplyPolicy(admin.String(), policy),
+		tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 600)),
+	)
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 1)))
+	require.ErrorContains(t, err, tokenpolicytypes.ErrMintLimitExceeded.Error())
+
+	// the tokenfactory bindings are held to the same policy
+	messenger := tokenpolicybindings.CustomMessageDecorator(enoki.TokenPolicyKeeper)(nopMessenger{})
+	bz, err := json.Marshal(tokenfactorybindingstypes.TokenFactoryMsg{MintTokens: &tokenfactorybindingstypes.MintTokens{
+		Denom: denom, Amount: sdkmath.NewInt(1), MintToAddress: admin.String(),
+	}})
+	require.NoError(t, err)
+	_, _, _, err = messenger.DispatchMsg(chain.GetContext(), admin, "", wasmvmtypes.CosmosMsg{Custom: bz})
+	require.ErrorIs(t, err, tokenpolicytypes.ErrMintLimitExceeded)
+
+	// in the next period the cap stops the mints
+	coordinator.IncrementTimeBy(time.Hour)
+	_, err = chain.SendMsgs(tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 401)))
+	require.ErrorContains(t, err, tokenpolicytypes.ErrMaxSupplyExceeded.Error())
+	_, err = chain.SendMsgs(tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 400)))
+	require.NoError(t, err)
+
+	res, err := enoki.TokenPolicyKeeper.SupplyPolicy(chain.GetContext(), &tokenpolicytypes.QuerySupplyPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, policy, res.Policy)
+	require.True(t, res.RemainingSupply.IsZero())
+	require.Equal(t, sdkmath.NewInt(200), *res.RemainingMint)
+
+	// the admin cannot lift the cap
+	policy.MaxSupply = sdkmath.NewInt(2_000)
+	_, err = chain.SendMsgs(tokenpolicytypes.NewMsgSetSupplyPolicy(admin.String(), policy))
+	require.ErrorContains(t, err, tokenpolicytypes.ErrInvalidSupplyPolicy.Error())
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	tokenpolicytypes "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+	tokenregistrytypes "github.com/hyphacoop/cosmos-enoki/x/tokenregistry/types"
+)
+
+func TestTokenRegistry(t *testing.T) {
+	coordinator := newCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+	enokiA := chainA.App.(*EnokiApp)
+	creator, holder := chainA.SenderAccount.GetAddress(), chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// the creator launches a documented, capped token and hands a plain one
+	// over to the holder
+	token, err := tokenfactorytypes.GetTokenDenom(creator.String(), "mushroom")
+	require.NoError(t, err)
+	plain, err := tokenfactorytypes.GetTokenDenom(creator.String(), "plain")
+	require.NoError(t, err)
+	policy := tokenpolicytypes.SupplyPolicy{Denom: token, MaxSupply: sdkmath.NewInt(10_000), MintLimit: sdkmath.ZeroInt()}
+	metadata := banktypes.Metadata{
+		Description: "Mushroom token",
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: token}, {Denom: "mushroom", Exponent: 6}},
+		Base:        token,
+		Display:     "mushroom",
+		Name:        "Mushroom",
+		Symbol:      "MSH",
+	}
+	_, err = chainA.SendMsgs(
+		tokenfactorytypes.NewMsgCreateDenom(creator.String(), "mushroom"),
+		tokenfactorytypes.NewMsgSetDenomMetadata(creator.String(), metadata),
+		tokenpolicytypes.NewMsgSetSupplyPolicy(creator.String(), policy),
+		tokenfactorytypes.NewMsgMint(creator.String(), sdk.NewInt64Coin(token, 1_000)),
+		banktypes.NewMsgSend(creator, holder, sdk.NewCoins(sdk.NewInt64Coin(token, 100))),
+		tokenfactorytypes.NewMsgCreateDenom(creator.String(), "plain"),
+		tokenfactorytypes.NewMsgChangeAdmin(creator.String(), plain, holder.String()),
+	)
+	require.NoError(t, err)
+
+	// some of the token leaves over IBC
+	msg := transfertypes.NewMsgTransfer(
+		transfertypes.PortID, path.EndpointA.ChannelID, sdk.NewInt64Coin(token, 300),
+		creator.String(), chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 1_000), 0, "",
+	)
+	_, err = chainA.SendMsgs(msg)
+	require.NoError(t, err)
+
+	// the registry is served by the app's gRPC query router
+	queryRegistry := func(method string, req, res codec.ProtoMarshaler) {
+		bz, err := enokiA.AppCodec().Marshal(req)
+		require.NoError(t, err)
+		resp, err := enokiA.Query(chainA.GetContext(), &abci.RequestQuery{Path: "/enoki.tokenregistry.v1.Query/" + method, Data: bz})
+		require.NoError(t, err)
+		require.Zero(t, resp.Code, resp.Log)
+		require.NoError(t, enokiA.AppCodec().Unmarshal(resp.Value, res))
+	}
+
+	var denomRes tokenregistrytypes.QueryDenomResponse
+	queryRegistry("Denom", &tokenregistrytypes.QueryDenomRequest{Denom: token}, &denomRes)
+	info := denomRes.Denom
+	require.Equal(t, creator.String(), info.Creator)
+	require.Equal(t, creator.String(), info.Admin)
+	require.True(t, info.HasMetadata)
+	require.Equal(t, "MSH", info.Metadata.Symbol)
+	require.Equal(t, sdk.NewInt64Coin(token, 1_000), info.Supply)
+	// the creator, the holder and the transfer escrow account
+	require.Equal(t, uint64(3), info.Holders)
+	require.Equal(t, sdk.NewInt64Coin(token, 300), info.IbcEscrowed)
+	require.Equal(t, []tokenregistrytypes.ChannelExposure{{ChannelId: path.EndpointA.ChannelID, Escrowed: sdkmath.NewInt(300)}}, info.ChannelExposure)
+	require.Equal(t, &policy, info.SupplyPolicy)
+
+	denoms := func(req *tokenregistrytypes.QueryDenomsRequest) []string {
+		var res tokenregistrytypes.QueryDenomsResponse
+		queryRegistry("Denoms", req, &res)
+		var denoms []string
+		for _, d := range res.Denoms {
+			denoms = append(denoms, d.Denom)
+		}
+		return denoms
+	}
+	require.ElementsMatch(t, []string{token, plain}, denoms(&tokenregistrytypes.QueryDenomsRequest{}))
+	require.ElementsMatch(t, []string{token, plain}, denoms(&tokenregistrytypes.QueryDenomsRequest{Creator: creator.String()}))
+	require.Empty(t, denoms(&tokenregistrytypes.QueryDenomsRequest{Creator: holder.String()}))
+	require.Equal(t, []string{plain}, denoms(&tokenregistrytypes.QueryDenomsRequest{Admin: holder.String()}))
+	require.Equal(t, []string{token}, denoms(&tokenregistrytypes.QueryDenomsRequest{Metadata: tokenregistrytypes.METADATA_FILTER_WITH}))
+	require.Equal(t, []string{plain}, denoms(&tokenregistrytypes.QueryDenomsRequest{Metadata: tokenregistrytypes.METADATA_FILTER_WITHOUT}))
+	require.Len(t, denoms(&tokenregistrytypes.QueryDenomsRequest{Pagination: &query.PageRequest{Limit: 1}}), 1)
+
+	// listings count holders on request only
+	var denomsRes tokenregistrytypes.QueryDenomsResponse
+	queryRegistry("Denoms", &tokenregistrytypes.QueryDenomsRequest{Metadata: tokenregistrytypes.METADATA_FILTER_WITH}, &denomsRes)
+	require.Zero(t, denomsRes.Denoms[0].Holders)
+	require.Equal(t, info.Supply, denomsRes.Denoms[0].Supply)
+	queryRegistry("Denoms", &tokenregistrytypes.QueryDenomsRequest{Metadata: tokenregistrytypes.METADATA_FILTER_WITH, CountHolders: true}, &denomsRes)
+	require.Equal(t, uint64(3), denomsRes.Denoms[0].Holders)
+}
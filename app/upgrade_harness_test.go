@@ -0,0 +1,324 @@
+package app
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvm "github.com/CosmWasm/wasmvm/v2"
+
+	"github.com/hyphacoop/cosmos-enoki/app/upgrades"
+)
+
+// upgradeFixtureGenesis is the genesis the upgrade tests start from.
+const upgradeFixtureGenesis = "../testnet/genesis.json"
+
+// upgradeBlockTime is the time between the blocks of the upgrade harness.
+const upgradeBlockTime = 5 * time.Second
+
+// upgradeHarness runs an upgrade in process against the state of a fixture
+// genesis. The app is restarted from the same database and home directory
+// at the upgrade height, like a node switching binaries, so the upgrade goes
+// through RegisterUpgradeHandlers, its store loader and the PreBlocker.
+type upgradeHarness struct {
+	t       *testing.T
+	db      dbm.DB
+	home    string
+	genesis *genutiltypes.AppGenesis
+
+	app      *EnokiApp
+	wasmVM   *wasmvm.VM
+	blockAt  time.Time
+	upgraded int64
+}
+
+// newUpgradeHarness returns a harness for a chain started from the fixture
+// genesis.
+func newUpgradeHarness(t *testing.T, genesisFile string) *upgradeHarness {
+	t.Helper()
+
+	genesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	require.NoError(t, err)
+	h := &upgradeHarness{
+		t:       t,
+		db:      dbm.NewMemDB(),
+		home:    t.TempDir(),
+		genesis: genesis,
+		blockAt: genesis.GenesisTime,
+	}
+	t.Cleanup(h.stop)
+	setBech32Prefixes(t)
+	return h
+}
+
+// setBech32Prefixes sets the address prefixes of the chain for the duration
+// of the test, so the addresses of the fixture genesis can be decoded. The
+// address cache is disabled so no address is cached with these prefixes.
+func setBech32Prefixes(t *testing.T) {
+	cacheEnabled := sdk.IsAddrCacheEnabled()
+	sdk.SetAddrCacheEnabled(false)
+	config := sdk.GetConfig()
+	accAddr, accPub := config.GetBech32AccountAddrPrefix(), config.GetBech32AccountPubPrefix()
+	valAddr, valPub := config.GetBech32ValidatorAddrPrefix(), config.GetBech32ValidatorPubPrefix()
+	consAddr, consPub := config.GetBech32ConsensusAddrPrefix(), config.GetBech32ConsensusPubPrefix()
+	t.Cleanup(func() {
+		config.SetBech32PrefixForAccount(accAddr, accPub)
+		config.SetBech32PrefixForValidator(valAddr, valPub)
+		config.SetBech32PrefixForConsensusNode(consAddr, consPub)
+		sdk.SetAddrCacheEnabled(cacheEnabled)
+	})
+
+	config.SetBech32PrefixForAccount(Bech32PrefixAccAddr, Bech32PrefixAccPub)
+	config.SetBech32PrefixForValidator(Bech32PrefixValAddr, Bech32PrefixValPub)
+	config.SetBech32PrefixForConsensusNode(Bech32PrefixConsAddr, Bech32PrefixConsPub)
+}
+
+// initChain starts the chain from the fixture genesis, with the given stores
+// of a previous binary mounted, and commits its first block. Modules added
+// since the fixture was exported start from their default genesis.
+func (h *upgradeHarness) initChain(stores []string) {
+	h.t.Helper()
+
+	h.start(false, stores)
+	require.NoError(h.t, h.app.LoadLatestVersion())
+
+	var fixture GenesisState
+	require.NoError(h.t, json.Unmarshal(h.genesis.AppState, &fixture))
+	state := h.app.DefaultGenesis()
+	for module, moduleState := range fixture {
+		state[module] = moduleState
+	}
+	stateBytes, err := json.Marshal(state)
+	require.NoError(h.t, err)
+
+	genDoc, err := h.genesis.ToGenesisDoc()
+	require.NoError(h.t, err)
+	consensusParams := genDoc.ConsensusParams.ToProto()
+	_, err = h.app.InitChain(&abci.RequestInitChain{
+		Time:            h.genesis.GenesisTime,
+		ChainId:         h.genesis.ChainID,
+		ConsensusParams: &consensusParams,
+		AppStateBytes:   stateBytes,
+		InitialHeight:   h.genesis.InitialHeight,
+	})
+	require.NoError(h.t, err)
+	h.finalizeBlock()
+}
+
+// start creates the app on the harness database with the given stores of a
+// previous binary mounted. A node only runs one app per home directory, so
+// the wasm VMs of the previous app are released first.
+func (h *upgradeHarness) start(loadLatest bool, stores []string) {
+	h.t.Helper()
+	h.stop()
+
+	vm, err := wasmvm.NewVM(filepath.Join(h.home, "wasm"), wasmkeeper.BuiltInCapabilities(), 32, false, 0)
+	require.NoError(h.t, err)
+	h.wasmVM = vm
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = h.home
+	h.app = NewEnokiApp(
+		log.NewNopLogger(),
+		h.db,
+		nil,
+		loadLatest,
+		appOptions,
+		[]wasmkeeper.Option{wasmkeeper.WithWasmEngine(vm)},
+		bam.SetChainID(h.genesis.ChainID),
+	)
+	if len(stores) > 0 {
+		h.app.MountKVStores(storetypes.NewKVStoreKeys(stores...))
+	}
+}
+
+// stop releases the wasm VMs of the running app.
+func (h *upgradeHarness) stop() {
+	if h.app == nil {
+		return
+	}
+	h.wasmVM.Cleanup()
+	h.app.ibcWasmVM.Cleanup()
+	h.app = nil
+}
+
+// finalizeBlock finalizes and commits the next block.
+func (h *upgradeHarness) finalizeBlock() {
+	h.t.Helper()
+	h.blockAt = h.blockAt.Add(upgradeBlockTime)
+	_, err := h.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: h.app.LastBlockHeight() + 1,
+		Time:   h.blockAt,
+	})
+	require.NoError(h.t, err)
+	_, err = h.app.Commit()
+	require.NoError(h.t, err)
+}
+
+// context returns a context on the committed state of the app.
+func (h *upgradeHarness) context() sdk.Context {
+	height := h.app.LastBlockHeight()
+	return h.app.NewUncachedContext(false, cmtproto.Header{ChainID: h.genesis.ChainID, Height: height, Time: h.blockAt}).
+		WithHeaderInfo(header.Info{ChainID: h.genesis.ChainID, Height: height, Time: h.blockAt})
+}
+
+// runUpgrade rolls the state back to the stores the chain had before the
+// upgrade, as declared by its store upgrades, schedules the upgrade for the
+// next height and restarts the app to apply it. Stores added by later
+// upgrades are part of the starting state since this binary mounts them.
+func (h *upgradeHarness) runUpgrade(upgrade upgrades.Upgrade) {
+	h.t.Helper()
+	storeUpgrades := upgrade.StoreUpgrades
+
+	// the stores created by the upgrade did not exist before it, and the
+	// ones it removes or renames did
+	var created, removed []string
+	created = append(created, storeUpgrades.Added...)
+	for _, rename := range storeUpgrades.Renamed {
+		created = append(created, rename.NewKey)
+		removed = append(removed, rename.OldKey)
+	}
+	removed = append(removed, storeUpgrades.Deleted...)
+
+	h.initChain(removed)
+	h.start(false, removed)
+	rollbackHeight := h.app.LastBlockHeight() + 1
+	h.app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(rollbackHeight, &storetypes.StoreUpgrades{Deleted: created}))
+	require.NoError(h.t, h.app.LoadLatestVersion())
+
+	ctx := h.context().WithBlockHeight(rollbackHeight).WithHeaderInfo(header.Info{Height: rollbackHeight, Time: h.blockAt})
+	upgradeStore := ctx.KVStore(h.app.GetKey(upgradetypes.StoreKey))
+	for _, storeKey := range created {
+		// the modules of created stores are initialized by the upgrade
+		if _, ok := h.app.ModuleManager.Modules[storeKey]; ok {
+			upgradeStore.Delete(append([]byte{upgradetypes.VersionMapByte}, storeKey...))
+		}
+	}
+	plan := upgradetypes.Plan{Name: upgrade.UpgradeName, Height: rollbackHeight + 1}
+	require.NoError(h.t, h.app.UpgradeKeeper.ScheduleUpgrade(ctx, plan))
+	h.app.CommitMultiStore().Commit()
+	for _, storeKey := range created {
+		h.deleteStoreData(storeKey)
+	}
+
+	// the previous binary halts at the upgrade height and leaves the plan
+	// for the next one
+	require.NoError(h.t, h.app.UpgradeKeeper.DumpUpgradeInfoToDisk(plan.Height, plan))
+	h.start(true, nil)
+	h.finalizeBlock()
+	h.upgraded = plan.Height
+	h.requireUpgraded(upgrade)
+
+	// the upgraded chain keeps producing blocks across restarts
+	h.finalizeBlock()
+	h.start(true, nil)
+	h.finalizeBlock()
+	requireInvariants(h.t, h.app, h.context())
+}
+
+// deleteStoreData removes every version of a store from the database, as if
+// it had never been mounted.
+func (h *upgradeHarness) deleteStoreData(name string) {
+	h.t.Helper()
+	iter, err := dbm.IteratePrefix(h.db, []byte("s/k:"+name+"/"))
+	require.NoError(h.t, err)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	require.NoError(h.t, iter.Close())
+	for _, key := range keys {
+		require.NoError(h.t, h.db.Delete(key))
+	}
+}
+
+// requireUpgraded checks that the upgrade was applied, that the committed
+// stores are the ones of this binary, that every module is at its current
+// version and that the invariants hold.
+func (h *upgradeHarness) requireUpgraded(upgrade upgrades.Upgrade) {
+	h.t.Helper()
+	ctx := h.context()
+
+	doneHeight, err := h.app.UpgradeKeeper.GetDoneHeight(ctx, upgrade.UpgradeName)
+	require.NoError(h.t, err)
+	require.Equal(h.t, h.upgraded, doneHeight)
+
+	commitInfo, err := h.app.CommitMultiStore().(*rootmulti.Store).GetCommitInfo(h.app.LastBlockHeight())
+	require.NoError(h.t, err)
+	var committed, mounted []string
+	for _, storeInfo := range commitInfo.StoreInfos {
+		committed = append(committed, storeInfo.Name)
+	}
+	for name := range h.app.keys {
+		mounted = append(mounted, name)
+	}
+	require.ElementsMatch(h.t, mounted, committed)
+
+	versions, err := h.app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.NoError(h.t, err)
+	require.Equal(h.t, h.app.ModuleManager.GetVersionMap(), versions)
+
+	requireInvariants(h.t, h.app, ctx)
+}
+
+// requireInvariants checks that the total supply matches the balances and
+// that the staking pools hold the tokens of the validators and unbonding
+// delegations.
+func requireInvariants(t *testing.T, app *EnokiApp, ctx sdk.Context) {
+	t.Helper()
+
+	var balances, supply sdk.Coins
+	app.BankKeeper.IterateAllBalances(ctx, func(_ sdk.AccAddress, coin sdk.Coin) bool {
+		balances = balances.Add(coin)
+		return false
+	})
+	app.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		supply = supply.Add(coin)
+		return false
+	})
+	require.Equal(t, supply.String(), balances.String(), "total supply")
+
+	bondDenom, err := app.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	bonded, notBonded := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	for _, validator := range validators {
+		if validator.IsBonded() {
+			bonded = bonded.Add(validator.Tokens)
+		} else {
+			notBonded = notBonded.Add(validator.Tokens)
+		}
+	}
+	require.NoError(t, app.StakingKeeper.IterateUnbondingDelegations(ctx, func(_ int64, ubd stakingtypes.UnbondingDelegation) bool {
+		for _, entry := range ubd.Entries {
+			notBonded = notBonded.Add(entry.Balance)
+		}
+		return false
+	}))
+	bondedPool := app.AccountKeeper.GetModuleAddress(stakingtypes.BondedPoolName)
+	notBondedPool := app.AccountKeeper.GetModuleAddress(stakingtypes.NotBondedPoolName)
+	require.Equal(t, bonded.String(), app.BankKeeper.GetBalance(ctx, bondedPool, bondDenom).Amount.String(), "bonded pool")
+	require.Equal(t, notBonded.String(), app.BankKeeper.GetBalance(ctx, notBondedPool, bondDenom).Amount.String(), "not bonded pool")
+}
@@ -6,17 +6,26 @@ import (
 	"github.com/hyphacoop/cosmos-enoki/app/upgrades"
 	"github.com/hyphacoop/cosmos-enoki/app/upgrades/noop"
 
+	errorsmod "cosmossdk.io/errors"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	v2_1_0 "github.com/hyphacoop/cosmos-enoki/app/upgrades/v2_1_0"
 	v2_2_0 "github.com/hyphacoop/cosmos-enoki/app/upgrades/v2_2_0"
+	v2_3_0 "github.com/hyphacoop/cosmos-enoki/app/upgrades/v2_3_0"
 )
 
 // Upgrades list of chain upgrades
 var Upgrades = []upgrades.Upgrade{
 	v2_1_0.NewUpgrade(),
 	v2_2_0.NewUpgrade(),
+	v2_3_0.NewUpgrade(),
 }
 
+// Forks list of chain hard forks
+var Forks = []upgrades.Fork{}
+
 // RegisterUpgradeHandlers registers the chain upgrade handlers
 func (app *EnokiApp) RegisterUpgradeHandlers() {
 	if len(Upgrades) == 0 {
@@ -24,13 +33,10 @@ func (app *EnokiApp) RegisterUpgradeHandlers() {
 		Upgrades = append(Upgrades, noop.NewUpgrade(app.Version()))
 	}
 
-	keepers := upgrades.AppKeepers{
-		AccountKeeper:         &app.AccountKeeper,
-		ConsensusParamsKeeper: &app.ConsensusParamsKeeper,
-		IBCKeeper:             app.IBCKeeper,
-		Codec:                 app.appCodec,
-		GetStoreKey:           app.GetKey,
-		TokenFactoryKeeper:    &app.TokenFactoryKeeper,
+	keepers := app.upgradeKeepers()
+
+	if err := validateForks(Forks); err != nil {
+		panic(fmt.Sprintf("invalid forks: %s", err))
 	}
 
 	// register all upgrade handlers
@@ -62,3 +68,89 @@ func (app *EnokiApp) RegisterUpgradeHandlers() {
 		}
 	}
 }
+
+// upgradeKeepers returns the keepers passed to upgrade handlers and forks.
+func (app *EnokiApp) upgradeKeepers() upgrades.AppKeepers {
+	return upgrades.AppKeepers{
+		Codec:       app.appCodec,
+		GetStoreKey: app.GetKey,
+
+		AccountKeeper:         &app.AccountKeeper,
+		BankKeeper:            &app.BankKeeper,
+		StakingKeeper:         app.StakingKeeper,
+		SlashingKeeper:        &app.SlashingKeeper,
+		MintKeeper:            &app.MintKeeper,
+		DistrKeeper:           &app.DistrKeeper,
+		GovKeeper:             &app.GovKeeper,
+		UpgradeKeeper:         app.UpgradeKeeper,
+		AuthzKeeper:           &app.AuthzKeeper,
+		EvidenceKeeper:        &app.EvidenceKeeper,
+		FeeGrantKeeper:        &app.FeeGrantKeeper,
+		NFTKeeper:             &app.NFTKeeper,
+		ConsensusParamsKeeper: &app.ConsensusParamsKeeper,
+
+		IBCKeeper:           app.IBCKeeper,
+		WasmClientKeeper:    &app.WasmClientKeeper,
+		ICAControllerKeeper: &app.ICAControllerKeeper,
+		ICAHostKeeper:       &app.ICAHostKeeper,
+		TransferKeeper:      &app.TransferKeeper,
+
+		WasmKeeper:               &app.WasmKeeper,
+		PacketForwardKeeper:      app.PacketForwardKeeper,
+		RatelimitKeeper:          &app.RatelimitKeeper,
+		FeeMarketKeeper:          app.FeeMarketKeeper,
+		TokenFactoryKeeper:       &app.TokenFactoryKeeper,
+		GovICAKeeper:             &app.GovICAKeeper,
+		DenomMetadataKeeper:      &app.DenomMetadataKeeper,
+		ChannelAllowlistKeeper:   &app.ChannelAllowlistKeeper,
+		SoloAllowlistKeeper:      &app.SoloAllowlistKeeper,
+		LightClientQuerierKeeper: &app.LightClientQuerierKeeper,
+		PFMRecoveryKeeper:        &app.PFMRecoveryKeeper,
+		NFTTransferKeeper:        &app.NFTTransferKeeper,
+		RelayerIncentivesKeeper:  &app.RelayerIncentivesKeeper,
+		TokenPolicyKeeper:        &app.TokenPolicyKeeper,
+		ParamGuardKeeper:         &app.ParamGuardKeeper,
+		MsgPauseKeeper:           &app.MsgPauseKeeper,
+	}
+}
+
+// validateForks checks that the forks have their logic and that no two forks
+// of a chain share a height or a name.
+func validateForks(forks []upgrades.Fork) error {
+	heights, names := make(map[string]bool), make(map[string]bool)
+	for _, fork := range forks {
+		if fork.UpgradeName == "" || fork.ChainID == "" || fork.UpgradeHeight <= 0 {
+			return fmt.Errorf("fork %q needs a name, a chain ID and a positive height", fork.UpgradeName)
+		}
+		if fork.BeginForkLogic == nil {
+			return fmt.Errorf("fork %s has no fork logic", fork.UpgradeName)
+		}
+		height, name := fmt.Sprintf("%s/%d", fork.ChainID, fork.UpgradeHeight), fork.ChainID+"/"+fork.UpgradeName
+		if heights[height] {
+			return fmt.Errorf("more than one fork of %s at height %d", fork.ChainID, fork.UpgradeHeight)
+		}
+		if names[name] {
+			return fmt.Errorf("more than one fork of %s named %s", fork.ChainID, fork.UpgradeName)
+		}
+		heights[height], names[name] = true, true
+	}
+	return nil
+}
+
+// BeginBlockForks applies the fork of the chain scheduled at the height of
+// the block, if any, and reports whether one was applied.
+func (app *EnokiApp) BeginBlockForks(ctx sdk.Context) (bool, error) {
+	for _, fork := range Forks {
+		if ctx.ChainID() != fork.ChainID || ctx.BlockHeight() != fork.UpgradeHeight {
+			continue
+		}
+
+		ctx.Logger().Info("Applying fork", "name", fork.UpgradeName, "height", fork.UpgradeHeight)
+		keepers := app.upgradeKeepers()
+		if err := fork.BeginForkLogic(ctx, &keepers); err != nil {
+			return false, errorsmod.Wrapf(err, "applying fork %s", fork.UpgradeName)
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,33 @@
+package upgrades
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feemarketkeeper "github.com/skip-mev/feemarket/x/feemarket/keeper"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// SetFeeMarketParams updates the feemarket params the way a governance
+// params message does: the enabled height is set when the fee market gets
+// enabled and the base gas price and learning rate are reset to the new
+// minimums.
+func SetFeeMarketParams(ctx sdk.Context, k *feemarketkeeper.Keeper, params feemarkettypes.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid feemarket params: %w", err)
+	}
+
+	current, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !current.Enabled && params.Enabled {
+		k.SetEnabledHeight(ctx, ctx.BlockHeight())
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+	return k.SetState(ctx, feemarkettypes.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate))
+}
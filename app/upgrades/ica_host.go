@@ -0,0 +1,84 @@
+package upgrades
+
+import (
+	"slices"
+
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// ICAHostAllowMessages returns the message types that interchain accounts
+// hosted on this chain are allowed to execute.
+func ICAHostAllowMessages() []string {
+	return []string{
+		// bank
+		sdk.MsgTypeURL(&banktypes.MsgSend{}),
+		sdk.MsgTypeURL(&banktypes.MsgMultiSend{}),
+		// staking
+		sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}),
+		sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{}),
+		// distribution
+		sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}),
+		sdk.MsgTypeURL(&distrtypes.MsgSetWithdrawAddress{}),
+		sdk.MsgTypeURL(&distrtypes.MsgFundCommunityPool{}),
+		// gov
+		sdk.MsgTypeURL(&govv1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgVote{}),
+		sdk.MsgTypeURL(&govv1beta1.MsgVoteWeighted{}),
+		// wasm
+		sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{}),
+		// tokenfactory
+		sdk.MsgTypeURL(&tokenfactorytypes.MsgMint{}),
+		sdk.MsgTypeURL(&tokenfactorytypes.MsgBurn{}),
+	}
+}
+
+// SetICAHostAllowMessages replaces the ICA host allowlist with
+// ICAHostAllowMessages, keeping the host enabled flag as is.
+func SetICAHostAllowMessages(ctx sdk.Context, k *icahostkeeper.Keeper) {
+	params := k.GetParams(ctx)
+	params.AllowMessages = ICAHostAllowMessages()
+	k.SetParams(ctx, params)
+}
+
+// AddICAHostAllowMessages adds the message types to the ICA host allowlist.
+// Message types already allowed are skipped.
+func AddICAHostAllowMessages(ctx sdk.Context, k *icahostkeeper.Keeper, msgTypes ...string) error {
+	params := k.GetParams(ctx)
+	for _, msgType := range msgTypes {
+		if !slices.Contains(params.AllowMessages, msgType) {
+			params.AllowMessages = append(params.AllowMessages, msgType)
+		}
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// RemoveICAHostAllowMessages removes the message types from the ICA host
+// allowlist.
+func RemoveICAHostAllowMessages(ctx sdk.Context, k *icahostkeeper.Keeper, msgTypes ...string) error {
+	params := k.GetParams(ctx)
+	params.AllowMessages = slices.DeleteFunc(params.AllowMessages, func(msgType string) bool {
+		return slices.Contains(msgTypes, msgType)
+	})
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.SetParams(ctx, params)
+	return nil
+}
@@ -0,0 +1,28 @@
+package upgrades
+
+import (
+	"fmt"
+
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// AddRateLimits adds the rate limits on behalf of governance. The channel
+// value of each denom must not be zero and the rate limits must not exist.
+func AddRateLimits(ctx sdk.Context, k *ratelimitkeeper.Keeper, rateLimits ...ratelimittypes.MsgAddRateLimit) error {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	for _, msg := range rateLimits {
+		msg.Authority = authority
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid rate limit for %s on %s: %w", msg.Denom, msg.ChannelOrClientId, err)
+		}
+		if err := k.AddRateLimit(ctx, &msg); err != nil {
+			return fmt.Errorf("adding rate limit for %s on %s: %w", msg.Denom, msg.ChannelOrClientId, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package upgrades
+
+import (
+	"context"
+
+	tokenpolicykeeper "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/keeper"
+	tokenpolicytypes "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+// SetTokenFactoryCapabilities moves the tokenfactory force transfer and burn
+// from capabilities under governance control. They were compiled out before,
+// so no denom gets them: existing denoms already have a supply and cannot opt
+// in, and new denoms may opt in at creation.
+func SetTokenFactoryCapabilities(ctx context.Context, k *tokenpolicykeeper.Keeper) error {
+	if err := k.ParamsStore.Set(ctx, tokenpolicytypes.DefaultParams()); err != nil {
+		return err
+	}
+	return k.Capabilities.Clear(ctx, nil)
+}
@@ -3,27 +3,99 @@ package upgrades
 import (
 	"context"
 
+	packetforwardkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/keeper"
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
+	ibcwasmkeeper "github.com/cosmos/ibc-go/modules/light-clients/08-wasm/v10/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
+
 	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	consensusparamkeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	feemarketkeeper "github.com/skip-mev/feemarket/x/feemarket/keeper"
+
+	channelallowlistkeeper "github.com/hyphacoop/cosmos-enoki/x/channelallowlist/keeper"
+	denommetadatakeeper "github.com/hyphacoop/cosmos-enoki/x/denommetadata/keeper"
+	govicakeeper "github.com/hyphacoop/cosmos-enoki/x/govica/keeper"
+	lightclientquerierkeeper "github.com/hyphacoop/cosmos-enoki/x/lightclientquerier/keeper"
+	msgpausekeeper "github.com/hyphacoop/cosmos-enoki/x/msgpause/keeper"
+	nfttransferkeeper "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/keeper"
+	paramguardkeeper "github.com/hyphacoop/cosmos-enoki/x/paramguard/keeper"
+	pfmrecoverykeeper "github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/keeper"
+	relayerincentiveskeeper "github.com/hyphacoop/cosmos-enoki/x/relayerincentives/keeper"
+	soloallowlistkeeper "github.com/hyphacoop/cosmos-enoki/x/soloallowlist/keeper"
+	tokenpolicykeeper "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/keeper"
 )
 
+// AppKeepers gives upgrade handlers and forks access to every keeper of the
+// app, named like the fields of EnokiApp.
 type AppKeepers struct {
+	Codec       codec.Codec
+	GetStoreKey func(storeKey string) *storetypes.KVStoreKey
+
+	// sdk keepers
 	AccountKeeper         *authkeeper.AccountKeeper
+	BankKeeper            *bankkeeper.BaseKeeper
+	StakingKeeper         *stakingkeeper.Keeper
+	SlashingKeeper        *slashingkeeper.Keeper
+	MintKeeper            *mintkeeper.Keeper
+	DistrKeeper           *distrkeeper.Keeper
+	GovKeeper             *govkeeper.Keeper
+	UpgradeKeeper         *upgradekeeper.Keeper
+	AuthzKeeper           *authzkeeper.Keeper
+	EvidenceKeeper        *evidencekeeper.Keeper
+	FeeGrantKeeper        *feegrantkeeper.Keeper
+	NFTKeeper             *nftkeeper.Keeper
 	ConsensusParamsKeeper *consensusparamkeeper.Keeper
-	Codec                 codec.Codec
-	GetStoreKey           func(storeKey string) *storetypes.KVStoreKey
-	IBCKeeper             *ibckeeper.Keeper
-	TokenFactoryKeeper    *tokenfactorykeeper.Keeper
+
+	// ibc keepers
+	IBCKeeper           *ibckeeper.Keeper
+	WasmClientKeeper    *ibcwasmkeeper.Keeper
+	ICAControllerKeeper *icacontrollerkeeper.Keeper
+	ICAHostKeeper       *icahostkeeper.Keeper
+	TransferKeeper      *ibctransferkeeper.Keeper
+
+	// custom keepers
+	WasmKeeper               *wasmkeeper.Keeper
+	PacketForwardKeeper      *packetforwardkeeper.Keeper
+	RatelimitKeeper          *ratelimitkeeper.Keeper
+	FeeMarketKeeper          *feemarketkeeper.Keeper
+	TokenFactoryKeeper       *tokenfactorykeeper.Keeper
+	GovICAKeeper             *govicakeeper.Keeper
+	DenomMetadataKeeper      *denommetadatakeeper.Keeper
+	ChannelAllowlistKeeper   *channelallowlistkeeper.Keeper
+	SoloAllowlistKeeper      *soloallowlistkeeper.Keeper
+	LightClientQuerierKeeper *lightclientquerierkeeper.Keeper
+	PFMRecoveryKeeper        *pfmrecoverykeeper.Keeper
+	NFTTransferKeeper        *nfttransferkeeper.Keeper
+	RelayerIncentivesKeeper  *relayerincentiveskeeper.Keeper
+	TokenPolicyKeeper        *tokenpolicykeeper.Keeper
+	ParamGuardKeeper         *paramguardkeeper.Keeper
+	MsgPauseKeeper           *msgpausekeeper.Keeper
 }
+
 type ModuleManager interface {
 	RunMigrations(ctx context.Context, cfg module.Configurator, fromVM module.VersionMap) (module.VersionMap, error)
 	GetVersionMap() module.VersionMap
@@ -39,5 +111,25 @@ type Upgrade struct {
 
 	// CreateUpgradeHandler defines the function that creates an upgrade handler
 	CreateUpgradeHandler func(ModuleManager, module.Configurator, *AppKeepers) upgradetypes.UpgradeHandler
-	StoreUpgrades        storetypes.StoreUpgrades
+	// StoreUpgrades declares the stores added, renamed and deleted by the
+	// upgrade. The upgrade tests roll the state back to the stores before the
+	// upgrade from this declaration.
+	StoreUpgrades storetypes.StoreUpgrades
+}
+
+// Fork defines a hard fork applied at a fixed height without a governance
+// proposal, e.g. to patch state after a security incident. Every validator
+// must run a binary with the fork before its height, and any other change of
+// the fork must be gated on the height to keep the binary compatible with the
+// blocks before it.
+type Fork struct {
+	// Fork name, e.g. `v2.3.1`
+	UpgradeName string
+	// ChainID of the chain the fork applies to
+	ChainID string
+	// UpgradeHeight is the height of the block the fork is applied in
+	UpgradeHeight int64
+
+	// BeginForkLogic patches the state at the beginning of the fork block
+	BeginForkLogic func(ctx sdk.Context, keepers *AppKeepers) error
 }
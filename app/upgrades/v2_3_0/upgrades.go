@@ -0,0 +1,82 @@
+package v2_3_0
+
+import (
+	"context"
+
+	"github.com/hyphacoop/cosmos-enoki/app/upgrades"
+	channelallowlisttypes "github.com/hyphacoop/cosmos-enoki/x/channelallowlist/types"
+	denommetadatatypes "github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+	govicatypes "github.com/hyphacoop/cosmos-enoki/x/govica/types"
+	lightclientqueriertypes "github.com/hyphacoop/cosmos-enoki/x/lightclientquerier/types"
+	msgpausetypes "github.com/hyphacoop/cosmos-enoki/x/msgpause/types"
+	nfttransfertypes "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+	paramguardtypes "github.com/hyphacoop/cosmos-enoki/x/paramguard/types"
+	pfmrecoverytypes "github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/types"
+	relayerincentivestypes "github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+	soloallowlisttypes "github.com/hyphacoop/cosmos-enoki/x/soloallowlist/types"
+	tokenpolicytypes "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+const UpgradeName = "v2.3.0"
+
+// NewUpgrade constructor
+func NewUpgrade() upgrades.Upgrade {
+	return upgrades.Upgrade{
+		UpgradeName:          UpgradeName,
+		CreateUpgradeHandler: CreateUpgradeHandler,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added: []string{
+				govicatypes.StoreKey,
+				denommetadatatypes.StoreKey,
+				channelallowlisttypes.StoreKey,
+				soloallowlisttypes.StoreKey,
+				lightclientqueriertypes.StoreKey,
+				pfmrecoverytypes.StoreKey,
+				nft.StoreKey,
+				nfttransfertypes.StoreKey,
+				relayerincentivestypes.StoreKey,
+				tokenpolicytypes.StoreKey,
+				paramguardtypes.StoreKey,
+				msgpausetypes.StoreKey,
+			},
+		},
+	}
+}
+
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	ak *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+
+		ctx.Logger().Info("Starting upgrade", "name", UpgradeName)
+
+		// Run migrations
+		fromVM, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return fromVM, errorsmod.Wrapf(err, "running module migrations")
+		}
+
+		// Replace the ICA host allowlist with the curated defaults
+		upgrades.SetICAHostAllowMessages(ctx, ak.ICAHostKeeper)
+
+		// Put the tokenfactory capabilities compiled in with this release
+		// under governance control, off for every existing denom
+		if err := upgrades.SetTokenFactoryCapabilities(ctx, ak.TokenPolicyKeeper); err != nil {
+			return fromVM, errorsmod.Wrapf(err, "setting tokenfactory capabilities")
+		}
+
+		ctx.Logger().Info("Upgrade complete", "name", UpgradeName)
+		return fromVM, nil
+	}
+}
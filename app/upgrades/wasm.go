@@ -0,0 +1,21 @@
+package upgrades
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+// PinWasmCodes pins the wasm codes in the cache of the VM, like a governance
+// pin codes message.
+func PinWasmCodes(ctx sdk.Context, k *wasmkeeper.Keeper, codeIDs ...uint64) error {
+	permissioned := wasmkeeper.NewGovPermissionKeeper(k)
+	for _, codeID := range codeIDs {
+		if err := permissioned.PinCode(ctx, codeID); err != nil {
+			return fmt.Errorf("pinning code %d: %w", codeID, err)
+		}
+	}
+	return nil
+}
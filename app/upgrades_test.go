@@ -0,0 +1,251 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtestdata "github.com/CosmWasm/wasmd/x/wasm/keeper/testdata"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/hyphacoop/cosmos-enoki/app/upgrades"
+	v2_3_0 "github.com/hyphacoop/cosmos-enoki/app/upgrades/v2_3_0"
+	tokenpolicytypes "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+var (
+	icaHostAllowedMsgs = []sdk.Msg{
+		&banktypes.MsgSend{},
+		&stakingtypes.MsgDelegate{},
+		&govv1.MsgVote{},
+		&wasmtypes.MsgExecuteContract{},
+		&tokenfactorytypes.MsgMint{},
+		&tokenfactorytypes.MsgBurn{},
+	}
+	icaHostForbiddenMsgs = []sdk.Msg{
+		&authz.MsgExec{},
+		&govv1.MsgSubmitProposal{},
+		&stakingtypes.MsgCreateValidator{},
+		&wasmtypes.MsgStoreCode{},
+		&wasmtypes.MsgInstantiateContract{},
+		&wasmtypes.MsgMigrateContract{},
+		&tokenfactorytypes.MsgForceTransfer{},
+		&tokenfactorytypes.MsgChangeAdmin{},
+	}
+)
+
+func requireICAHostAllowlist(t *testing.T, params icahosttypes.Params) {
+	t.Helper()
+	require.NoError(t, params.Validate())
+	require.ElementsMatch(t, upgrades.ICAHostAllowMessages(), params.AllowMessages)
+	for _, msg := range icaHostAllowedMsgs {
+		require.True(t, icahosttypes.ContainsMsgType(params.AllowMessages, msg), sdk.MsgTypeURL(msg))
+	}
+	for _, msg := range icaHostForbiddenMsgs {
+		require.False(t, icahosttypes.ContainsMsgType(params.AllowMessages, msg), sdk.MsgTypeURL(msg))
+	}
+}
+
+func TestICAHostDefaultGenesisAllowlist(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+
+	requireICAHostAllowlist(t, gapp.ICAHostKeeper.GetParams(ctx))
+}
+
+func TestV2_3_0UpgradeSetsICAHostAllowlist(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+
+	gapp.ICAHostKeeper.SetParams(ctx, icahosttypes.DefaultParams())
+	require.True(t, icahosttypes.ContainsMsgType(gapp.ICAHostKeeper.GetParams(ctx).AllowMessages, &authz.MsgExec{}))
+
+	require.NoError(t, gapp.TokenPolicyKeeper.ParamsStore.Set(ctx, tokenpolicytypes.Params{EnabledCapabilities: tokenpolicytypes.Capabilities}))
+
+	handler := v2_3_0.CreateUpgradeHandler(gapp.ModuleManager, gapp.configurator, &upgrades.AppKeepers{
+		ICAHostKeeper:     &gapp.ICAHostKeeper,
+		TokenPolicyKeeper: &gapp.TokenPolicyKeeper,
+	})
+	_, err := handler(ctx, upgradetypes.Plan{Name: v2_3_0.UpgradeName}, gapp.ModuleManager.GetVersionMap())
+	require.NoError(t, err)
+
+	params := gapp.ICAHostKeeper.GetParams(ctx)
+	require.True(t, params.HostEnabled)
+	requireICAHostAllowlist(t, params)
+
+	tokenPolicyParams, err := gapp.TokenPolicyKeeper.ParamsStore.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, tokenpolicytypes.DefaultParams(), tokenPolicyParams)
+}
+
+func TestUpgrades(t *testing.T) {
+	for _, upgrade := range Upgrades {
+		t.Run(upgrade.UpgradeName, func(t *testing.T) {
+			newUpgradeHarness(t, upgradeFixtureGenesis).runUpgrade(upgrade)
+		})
+	}
+}
+
+func TestUpgradesAddModuleStores(t *testing.T) {
+	genesis, err := genutiltypes.AppGenesisFromFile(upgradeFixtureGenesis)
+	require.NoError(t, err)
+	var fixture GenesisState
+	require.NoError(t, json.Unmarshal(genesis.AppState, &fixture))
+
+	added := make(map[string]bool)
+	for _, upgrade := range Upgrades {
+		for _, storeKey := range upgrade.StoreUpgrades.Added {
+			added[storeKey] = true
+		}
+	}
+
+	// a module missing from the fixture was added since, and so was its store
+	gapp := Setup(t)
+	for name := range gapp.ModuleManager.Modules {
+		if _, ok := fixture[name]; ok || gapp.GetKey(name) == nil {
+			continue
+		}
+		require.True(t, added[name], "store of module %s is not added by any upgrade", name)
+	}
+}
+
+func TestUpgradeKeepers(t *testing.T) {
+	gapp := Setup(t)
+	keepers := reflect.ValueOf(gapp.upgradeKeepers())
+
+	// every keeper of the app is passed to upgrade handlers by reference
+	appValue := reflect.ValueOf(gapp).Elem()
+	for i := 0; i < appValue.NumField(); i++ {
+		field := appValue.Type().Field(i)
+		if !field.IsExported() || !strings.HasSuffix(field.Name, "Keeper") {
+			continue
+		}
+		keeper := keepers.FieldByName(field.Name)
+		require.True(t, keeper.IsValid(), "AppKeepers has no %s", field.Name)
+		require.Equal(t, reflect.Ptr, keeper.Kind(), field.Name)
+		require.False(t, keeper.IsNil(), field.Name)
+
+		want := appValue.Field(i)
+		if want.Kind() != reflect.Ptr {
+			want = want.Addr()
+		}
+		require.Equal(t, want.Pointer(), keeper.Pointer(), field.Name)
+	}
+}
+
+func TestSetFeeMarketParams(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false).WithBlockHeight(10)
+
+	params, err := gapp.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.Enabled = false
+	require.NoError(t, gapp.FeeMarketKeeper.SetParams(ctx, params))
+
+	// enabling the fee market sets the enabled height and resets the state
+	params.Enabled = true
+	params.MinBaseGasPrice = sdkmath.LegacyMustNewDecFromStr("0.25")
+	require.NoError(t, upgrades.SetFeeMarketParams(ctx, gapp.FeeMarketKeeper, params))
+
+	got, err := gapp.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, got)
+	height, err := gapp.FeeMarketKeeper.GetEnabledHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), height)
+	state, err := gapp.FeeMarketKeeper.GetState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params.MinBaseGasPrice, state.BaseGasPrice)
+
+	// invalid params are rejected
+	params.MaxBlockUtilization = 0
+	require.ErrorContains(t, upgrades.SetFeeMarketParams(ctx, gapp.FeeMarketKeeper, params), "invalid feemarket params")
+}
+
+func TestAddRateLimits(t *testing.T) {
+	coordinator := newCoordinator(t, 2)
+	chainA, chainB := coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+	enoki := chainA.App.(*EnokiApp)
+	ctx := chainA.GetContext()
+
+	rateLimit := ratelimittypes.MsgAddRateLimit{
+		Denom:             sdk.DefaultBondDenom,
+		ChannelOrClientId: path.EndpointA.ChannelID,
+		MaxPercentSend:    sdkmath.NewInt(10),
+		MaxPercentRecv:    sdkmath.NewInt(20),
+		DurationHours:     24,
+	}
+	require.NoError(t, upgrades.AddRateLimits(ctx, &enoki.RatelimitKeeper, rateLimit))
+
+	got, found := enoki.RatelimitKeeper.GetRateLimit(ctx, sdk.DefaultBondDenom, path.EndpointA.ChannelID)
+	require.True(t, found)
+	require.Equal(t, rateLimit.MaxPercentSend, got.Quota.MaxPercentSend)
+	require.Equal(t, rateLimit.MaxPercentRecv, got.Quota.MaxPercentRecv)
+	require.Equal(t, enoki.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount, got.Flow.ChannelValue)
+
+	// existing rate limits, denoms without supply and invalid quotas fail
+	require.ErrorIs(t, upgrades.AddRateLimits(ctx, &enoki.RatelimitKeeper, rateLimit), ratelimittypes.ErrRateLimitAlreadyExists)
+	noSupply := rateLimit
+	noSupply.Denom = "unknown"
+	require.ErrorIs(t, upgrades.AddRateLimits(ctx, &enoki.RatelimitKeeper, noSupply), ratelimittypes.ErrZeroChannelValue)
+	invalid := rateLimit
+	invalid.MaxPercentSend = sdkmath.NewInt(101)
+	require.ErrorContains(t, upgrades.AddRateLimits(ctx, &enoki.RatelimitKeeper, invalid), "invalid rate limit")
+}
+
+func TestPinWasmCodes(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+
+	creator := sdk.AccAddress(authtypes.NewModuleAddress(govtypes.ModuleName))
+	codeID, _, err := wasmkeeper.NewGovPermissionKeeper(&gapp.WasmKeeper).Create(ctx, creator, wasmtestdata.ReflectContractWasm(), nil)
+	require.NoError(t, err)
+	require.False(t, gapp.WasmKeeper.IsPinnedCode(ctx, codeID))
+
+	require.NoError(t, upgrades.PinWasmCodes(ctx, &gapp.WasmKeeper, codeID))
+	require.True(t, gapp.WasmKeeper.IsPinnedCode(ctx, codeID))
+
+	require.ErrorContains(t, upgrades.PinWasmCodes(ctx, &gapp.WasmKeeper, codeID+1), fmt.Sprintf("pinning code %d", codeID+1))
+}
+
+func TestICAHostAllowMessagesHelpers(t *testing.T) {
+	gapp := Setup(t)
+	ctx := gapp.BaseApp.NewContext(false)
+
+	msgExec := sdk.MsgTypeURL(&authz.MsgExec{})
+	msgSend := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	require.NoError(t, upgrades.AddICAHostAllowMessages(ctx, &gapp.ICAHostKeeper, msgExec, msgSend))
+	params := gapp.ICAHostKeeper.GetParams(ctx)
+	require.Equal(t, append(upgrades.ICAHostAllowMessages(), msgExec), params.AllowMessages)
+
+	require.NoError(t, upgrades.RemoveICAHostAllowMessages(ctx, &gapp.ICAHostKeeper, msgExec, msgSend))
+	params = gapp.ICAHostKeeper.GetParams(ctx)
+	require.NotContains(t, params.AllowMessages, msgExec)
+	require.NotContains(t, params.AllowMessages, msgSend)
+	require.Len(t, params.AllowMessages, len(upgrades.ICAHostAllowMessages())-1)
+
+	require.Error(t, upgrades.AddICAHostAllowMessages(ctx, &gapp.ICAHostKeeper, " "))
+}
@@ -56,6 +56,8 @@ type CustomAppConfig struct {
 	serverconfig.Config
 
 	Wasm wasmtypes.NodeConfig `mapstructure:"wasm"`
+
+	IBCWasm app.IBCWasmConfig `mapstructure:"ibc-wasm"`
 }
 
 // initAppConfig helps to override default appConfig template and configs.
@@ -82,14 +84,17 @@ func initAppConfig() (string, interface{}) {
 	// srvCfg.BaseConfig.IAVLDisableFastNode = true // disable fastnode by default
 
 	customAppConfig := CustomAppConfig{
-		Config: *srvCfg,
-		Wasm:   wasmtypes.DefaultNodeConfig(),
+		Config:  *srvCfg,
+		Wasm:    wasmtypes.DefaultNodeConfig(),
+		IBCWasm: app.DefaultIBCWasmConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate
 
 	customAppTemplate += wasmtypes.DefaultConfigTemplate()
 
+	customAppTemplate += app.IBCWasmConfigTemplate(customAppConfig.IBCWasm)
+
 	return customAppTemplate, customAppConfig
 }
 
@@ -119,6 +124,8 @@ func initRootCmd(
 		keys.Commands(),
 		queryCommand(),
 		txCommand(),
+		upgradeCommand(),
+		inPlaceTestnetCommand(),
 	)
 }
 
@@ -180,6 +187,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		ibcRecoverCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -196,17 +204,26 @@ func newApp(
 ) servertypes.Application {
 	baseappOptions := sdkserver.DefaultBaseappOptions(appOpts)
 
+	telemetryEnabled := cast.ToBool(appOpts.Get("telemetry.enabled"))
+
 	var wasmOpts []wasmkeeper.Option
-	if cast.ToBool(appOpts.Get("telemetry.enabled")) {
+	if telemetryEnabled {
 		wasmOpts = append(wasmOpts, wasmkeeper.WithVMCacheMetrics(prometheus.DefaultRegisterer))
 	}
 
-	return app.NewEnokiApp(
+	enokiApp := app.NewEnokiApp(
 		logger, db, traceStore, true,
 		appOpts,
 		wasmOpts,
 		baseappOptions...,
 	)
+
+	// the ibc-wasm config was validated when creating the app
+	if ibcWasmConfig, err := app.ReadIBCWasmConfig(appOpts); err == nil && telemetryEnabled && ibcWasmConfig.Metrics {
+		enokiApp.RegisterIBCWasmVMMetrics(prometheus.DefaultRegisterer)
+	}
+
+	return enokiApp
 }
 
 // appExport creates a new wasm app (optionally at a given height) and exports state.
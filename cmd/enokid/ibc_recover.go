@@ -0,0 +1,426 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v10/modules/core/types"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+)
+
+const (
+	flagSubstitute   = "substitute"
+	flagGenesis      = "genesis"
+	flagProposalFile = "proposal-file"
+	flagTitle        = "title"
+	flagSummary      = "summary"
+	flagDeposit      = "deposit"
+	flagMetadata     = "metadata"
+	flagExpedited    = "expedited"
+)
+
+// recoveryClient is a 07-tendermint client considered by ibc-recover.
+type recoveryClient struct {
+	ID     string
+	State  *ibctm.ClientState
+	Status ibcexported.Status
+}
+
+// recoverySource provides the client states and statuses ibc-recover works
+// from, either queried from a node or read from an exported genesis file.
+type recoverySource struct {
+	clients             []recoveryClient
+	status              func(clientID string) (ibcexported.Status, error)
+	minDeposit          sdk.Coins
+	expeditedMinDeposit sdk.Coins
+}
+
+// ibcRecoverCmd returns the command that writes a MsgRecoverClient governance
+// proposal for an expired or frozen 07-tendermint client.
+func ibcRecoverCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-recover [subject-client-id]",
+		Short: "Write a governance proposal recovering an expired or frozen IBC client",
+		Long: `Inspect an expired or frozen 07-tendermint client, find an active substitute
+client for the same chain whose parameters match, and write a MsgRecoverClient
+governance proposal ready for "tx gov submit-proposal".
+
+The substitute is the active client with the highest latest height unless one
+is given with --substitute. With --genesis the clients are read from an exported
+genesis file instead of a node, and client expiry is evaluated against the
+current time.`,
+		Example: fmt.Sprintf(`%[1]s tx ibc-recover 07-tendermint-3
+%[1]s tx ibc-recover 07-tendermint-3 --substitute 07-tendermint-9 --proposal-file recover.json
+%[1]s tx ibc-recover 07-tendermint-3 --genesis exported.json`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var source recoverySource
+			if genesisFile, _ := cmd.Flags().GetString(flagGenesis); genesisFile != "" {
+				source, err = genesisRecoverySource(clientCtx.Codec, genesisFile, time.Now())
+			} else {
+				source, err = queryRecoverySource(clientCtx)
+			}
+			if err != nil {
+				return err
+			}
+
+			substituteID, _ := cmd.Flags().GetString(flagSubstitute)
+			subject, substitute, err := source.plan(args[0], substituteID)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := source.proposal(clientCtx.Codec, subject, substitute, cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(proposal, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			proposalFile, _ := cmd.Flags().GetString(flagProposalFile)
+			if proposalFile == "" {
+				proposalFile = fmt.Sprintf("recover-%s.json", subject.ID)
+			}
+			if err := os.WriteFile(proposalFile, append(bz, '\n'), 0o600); err != nil {
+				return err
+			}
+
+			cmd.Printf("subject:    %s (%s, chain %s, height %s)\n", subject.ID, subject.Status, subject.State.ChainId, subject.State.LatestHeight)
+			cmd.Printf("substitute: %s (%s, chain %s, height %s)\n", substitute.ID, substitute.Status, substitute.State.ChainId, substitute.State.LatestHeight)
+			cmd.Printf("wrote %s, submit it with: %s tx gov submit-proposal %s --from <key>\n", proposalFile, version.AppName, proposalFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagSubstitute, "", "Substitute client ID; by default the matching active client with the highest latest height is used")
+	cmd.Flags().String(flagGenesis, "", "Read clients from an exported genesis file instead of querying a node")
+	cmd.Flags().String(flagProposalFile, "", "Path of the proposal file to write (default \"recover-<subject-client-id>.json\")")
+	cmd.Flags().String(flagTitle, "", "Proposal title (default \"Recover IBC client <subject-client-id>\")")
+	cmd.Flags().String(flagSummary, "", "Proposal summary (default describes the subject and substitute clients)")
+	cmd.Flags().String(flagDeposit, "", "Proposal deposit (default the governance minimum deposit, or the expedited minimum deposit with --expedited)")
+	cmd.Flags().String(flagMetadata, "", "Proposal metadata")
+	cmd.Flags().Bool(flagExpedited, false, "Write an expedited proposal")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// queryRecoverySource loads the 07-tendermint clients and the governance
+// minimum deposit from a node.
+func queryRecoverySource(clientCtx client.Context) (recoverySource, error) {
+	ctx := clientCtx.CmdContext
+	queryClient := clienttypes.NewQueryClient(clientCtx)
+
+	var source recoverySource
+	pageReq := &query.PageRequest{}
+	for {
+		res, err := queryClient.ClientStates(ctx, &clienttypes.QueryClientStatesRequest{Pagination: pageReq})
+		if err != nil {
+			return recoverySource{}, err
+		}
+
+		for _, identified := range res.ClientStates {
+			clientState, err := clienttypes.UnpackClientState(identified.ClientState)
+			if err != nil {
+				return recoverySource{}, err
+			}
+			if tmClientState, ok := clientState.(*ibctm.ClientState); ok {
+				source.clients = append(source.clients, recoveryClient{ID: identified.ClientId, State: tmClientState})
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey}
+	}
+
+	source.status = func(clientID string) (ibcexported.Status, error) {
+		res, err := queryClient.ClientStatus(ctx, &clienttypes.QueryClientStatusRequest{ClientId: clientID})
+		if err != nil {
+			return ibcexported.Unknown, err
+		}
+		return ibcexported.Status(res.Status), nil
+	}
+
+	govRes, err := govv1.NewQueryClient(clientCtx).Params(ctx, &govv1.QueryParamsRequest{})
+	if err != nil {
+		return recoverySource{}, err
+	}
+	if govRes.Params != nil {
+		source.minDeposit = govRes.Params.MinDeposit
+		source.expeditedMinDeposit = govRes.Params.ExpeditedMinDeposit
+	}
+
+	return source, nil
+}
+
+// genesisRecoverySource loads the 07-tendermint clients and the governance
+// minimum deposit from an exported genesis file. Client statuses are derived
+// the same way the 07-tendermint light client does, using now as block time.
+func genesisRecoverySource(cdc codec.JSONCodec, genesisFile string, now time.Time) (recoverySource, error) {
+	appGenesis, err := genutiltypes.AppGenesisFromFile(genesisFile)
+	if err != nil {
+		return recoverySource{}, err
+	}
+
+	var appState map[string]json.RawMessage
+	if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+		return recoverySource{}, fmt.Errorf("failed to unmarshal app state: %w", err)
+	}
+
+	var ibcGenesis ibctypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[ibcexported.ModuleName], &ibcGenesis); err != nil {
+		return recoverySource{}, fmt.Errorf("failed to unmarshal %s genesis: %w", ibcexported.ModuleName, err)
+	}
+
+	consensusStates := make(map[string]map[clienttypes.Height]ibcexported.ConsensusState)
+	for _, clientConsensus := range ibcGenesis.ClientGenesis.ClientsConsensus {
+		byHeight := make(map[clienttypes.Height]ibcexported.ConsensusState)
+		for _, consensus := range clientConsensus.ConsensusStates {
+			consensusState, err := clienttypes.UnpackConsensusState(consensus.ConsensusState)
+			if err != nil {
+				return recoverySource{}, err
+			}
+			byHeight[consensus.Height] = consensusState
+		}
+		consensusStates[clientConsensus.ClientId] = byHeight
+	}
+
+	var source recoverySource
+	statuses := make(map[string]ibcexported.Status)
+	for _, identified := range ibcGenesis.ClientGenesis.Clients {
+		clientState, err := clienttypes.UnpackClientState(identified.ClientState)
+		if err != nil {
+			return recoverySource{}, err
+		}
+		tmClientState, ok := clientState.(*ibctm.ClientState)
+		if !ok {
+			continue
+		}
+
+		source.clients = append(source.clients, recoveryClient{ID: identified.ClientId, State: tmClientState})
+
+		consensusState, ok := consensusStates[identified.ClientId][tmClientState.LatestHeight].(*ibctm.ConsensusState)
+		switch {
+		case !tmClientState.FrozenHeight.IsZero():
+			statuses[identified.ClientId] = ibcexported.Frozen
+		case !ok || tmClientState.IsExpired(consensusState.Timestamp, now):
+			statuses[identified.ClientId] = ibcexported.Expired
+		default:
+			statuses[identified.ClientId] = ibcexported.Active
+		}
+	}
+	source.status = func(clientID string) (ibcexported.Status, error) {
+		if status, ok := statuses[clientID]; ok {
+			return status, nil
+		}
+		return ibcexported.Unknown, nil
+	}
+
+	if raw, ok := appState[govtypes.ModuleName]; ok {
+		var govGenesis govv1.GenesisState
+		if err := cdc.UnmarshalJSON(raw, &govGenesis); err != nil {
+			return recoverySource{}, fmt.Errorf("failed to unmarshal %s genesis: %w", govtypes.ModuleName, err)
+		}
+		if govGenesis.Params != nil {
+			source.minDeposit = govGenesis.Params.MinDeposit
+			source.expeditedMinDeposit = govGenesis.Params.ExpeditedMinDeposit
+		}
+	}
+
+	return source, nil
+}
+
+// plan resolves the subject client and its substitute, checking every
+// precondition of MsgRecoverClient. When substituteID is empty the matching
+// active client with the highest latest height is selected.
+func (s recoverySource) plan(subjectID, substituteID string) (subject, substitute recoveryClient, err error) {
+	subject, err = s.client(subjectID)
+	if err != nil {
+		return recoveryClient{}, recoveryClient{}, err
+	}
+	if subject.Status == ibcexported.Active {
+		return recoveryClient{}, recoveryClient{}, fmt.Errorf("client %s is %s and does not need to be recovered", subject.ID, subject.Status)
+	}
+
+	if substituteID != "" {
+		substitute, err = s.client(substituteID)
+		if err != nil {
+			return recoveryClient{}, recoveryClient{}, err
+		}
+		if err := checkSubstitute(subject, substitute); err != nil {
+			return recoveryClient{}, recoveryClient{}, err
+		}
+		return subject, substitute, nil
+	}
+
+	for _, candidate := range s.clients {
+		if candidate.ID == subject.ID || candidate.State.ChainId != subject.State.ChainId {
+			continue
+		}
+		if !ibctm.IsMatchingClientState(*subject.State, *candidate.State) || !subject.State.LatestHeight.LT(candidate.State.LatestHeight) {
+			continue
+		}
+		if substitute.State != nil && !substitute.State.LatestHeight.LT(candidate.State.LatestHeight) {
+			continue
+		}
+
+		candidate.Status, err = s.status(candidate.ID)
+		if err != nil {
+			return recoveryClient{}, recoveryClient{}, err
+		}
+		if candidate.Status == ibcexported.Active {
+			substitute = candidate
+		}
+	}
+
+	if substitute.State == nil {
+		return recoveryClient{}, recoveryClient{}, fmt.Errorf(
+			"no active 07-tendermint client for chain %s matches client %s at a greater height; "+
+				"create a client with the same trust level, unbonding period, max clock drift, proof specs and upgrade path, "+
+				"or pass --%s", subject.State.ChainId, subject.ID, flagSubstitute,
+		)
+	}
+
+	return subject, substitute, nil
+}
+
+// client returns the 07-tendermint client with the given ID and its status.
+func (s recoverySource) client(clientID string) (recoveryClient, error) {
+	for _, c := range s.clients {
+		if c.ID != clientID {
+			continue
+		}
+
+		status, err := s.status(clientID)
+		if err != nil {
+			return recoveryClient{}, err
+		}
+		c.Status = status
+		return c, nil
+	}
+
+	return recoveryClient{}, fmt.Errorf("client %s not found or is not a 07-tendermint client", clientID)
+}
+
+// checkSubstitute reports why substitute cannot replace subject, if it cannot.
+func checkSubstitute(subject, substitute recoveryClient) error {
+	if subject.ID == substitute.ID {
+		return errors.New("subject and substitute must be different clients")
+	}
+	if substitute.Status != ibcexported.Active {
+		return fmt.Errorf("substitute client %s is %s, it must be %s", substitute.ID, substitute.Status, ibcexported.Active)
+	}
+	if !subject.State.LatestHeight.LT(substitute.State.LatestHeight) {
+		return fmt.Errorf(
+			"substitute client %s latest height %s must be greater than subject client %s latest height %s",
+			substitute.ID, substitute.State.LatestHeight, subject.ID, subject.State.LatestHeight,
+		)
+	}
+	if mismatches := clientStateMismatches(*subject.State, *substitute.State); len(mismatches) > 0 {
+		return fmt.Errorf("substitute client %s does not match subject client %s: %v differ", substitute.ID, subject.ID, mismatches)
+	}
+
+	return nil
+}
+
+// clientStateMismatches lists the client state parameters which must be
+// equal for a recovery but differ, following ibctm.IsMatchingClientState.
+func clientStateMismatches(subject, substitute ibctm.ClientState) []string {
+	if ibctm.IsMatchingClientState(subject, substitute) {
+		return nil
+	}
+
+	var mismatches []string
+	for _, field := range []struct {
+		name string
+		a, b any
+	}{
+		{"trust_level", subject.TrustLevel, substitute.TrustLevel},
+		{"unbonding_period", subject.UnbondingPeriod, substitute.UnbondingPeriod},
+		{"max_clock_drift", subject.MaxClockDrift, substitute.MaxClockDrift},
+		{"proof_specs", subject.ProofSpecs, substitute.ProofSpecs},
+		{"upgrade_path", subject.UpgradePath, substitute.UpgradePath},
+	} {
+		if !reflect.DeepEqual(field.a, field.b) {
+			mismatches = append(mismatches, field.name)
+		}
+	}
+
+	return mismatches
+}
+
+// proposal builds the governance proposal recovering subject with substitute,
+// filling in defaults for any proposal flags left empty.
+func (s recoverySource) proposal(cdc codec.JSONCodec, subject, substitute recoveryClient, cmd *cobra.Command) (govProposal, error) {
+	msg := &clienttypes.MsgRecoverClient{
+		SubjectClientId:    subject.ID,
+		SubstituteClientId: substitute.ID,
+		Signer:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	}
+	msgJSON, err := cdc.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return govProposal{}, err
+	}
+
+	proposal := govProposal{
+		Messages: []json.RawMessage{msgJSON},
+		Title:    fmt.Sprintf("Recover IBC client %s", subject.ID),
+		Summary: fmt.Sprintf(
+			"Recover %s client %s for chain %s by replacing its state with active client %s at height %s.",
+			subject.Status, subject.ID, subject.State.ChainId, substitute.ID, substitute.State.LatestHeight,
+		),
+	}
+
+	proposal.Metadata, _ = cmd.Flags().GetString(flagMetadata)
+	proposal.Expedited, _ = cmd.Flags().GetBool(flagExpedited)
+	if proposal.Expedited {
+		proposal.Deposit = s.expeditedMinDeposit.String()
+	} else {
+		proposal.Deposit = s.minDeposit.String()
+	}
+
+	if title, _ := cmd.Flags().GetString(flagTitle); title != "" {
+		proposal.Title = title
+	}
+	if summary, _ := cmd.Flags().GetString(flagSummary); summary != "" {
+		proposal.Summary = summary
+	}
+	if deposit, _ := cmd.Flags().GetString(flagDeposit); deposit != "" {
+		coins, err := sdk.ParseCoinsNormalized(deposit)
+		if err != nil {
+			return govProposal{}, fmt.Errorf("invalid deposit: %w", err)
+		}
+		proposal.Deposit = coins.String()
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctypes "github.com/cosmos/ibc-go/v10/modules/core/types"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	"github.com/hyphacoop/cosmos-enoki/app"
+)
+
+func TestIBCRecoverGenesis(t *testing.T) {
+	cdc := app.MakeEncodingConfig(t).Codec
+	now := time.Now()
+
+	newClient := func(chainID string, height uint64, ubdPeriod time.Duration) *ibctm.ClientState {
+		return ibctm.NewClientState(
+			chainID, ibctm.DefaultTrustLevel, 14*24*time.Hour, ubdPeriod, 10*time.Second,
+			clienttypes.NewHeight(1, height), commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"},
+		)
+	}
+	frozen := newClient("testchain-1", 90, 21*24*time.Hour)
+	frozen.FrozenHeight = clienttypes.NewHeight(1, 90)
+
+	clients := []struct {
+		id          string
+		clientState *ibctm.ClientState
+		updated     time.Time
+	}{
+		{"07-tendermint-0", newClient("testchain-1", 100, 21*24*time.Hour), now.Add(-30 * 24 * time.Hour)},
+		{"07-tendermint-1", newClient("testchain-1", 200, 21*24*time.Hour), now.Add(-time.Hour)},
+		{"07-tendermint-2", newClient("testchain-1", 300, 21*24*time.Hour), now.Add(-time.Minute)},
+		{"07-tendermint-3", newClient("testchain-1", 400, 28*24*time.Hour), now.Add(-time.Minute)},
+		{"07-tendermint-4", newClient("otherchain-1", 500, 21*24*time.Hour), now.Add(-time.Minute)},
+		{"07-tendermint-5", frozen, now.Add(-time.Minute)},
+	}
+
+	ibcGenesis := ibctypes.DefaultGenesisState()
+	for _, c := range clients {
+		ibcGenesis.ClientGenesis.Clients = append(ibcGenesis.ClientGenesis.Clients, clienttypes.NewIdentifiedClientState(c.id, c.clientState))
+		consensusState := ibctm.NewConsensusState(c.updated, commitmenttypes.NewMerkleRoot([]byte("root")), []byte("01234567890123456789012345678901"))
+		ibcGenesis.ClientGenesis.ClientsConsensus = append(ibcGenesis.ClientGenesis.ClientsConsensus, clienttypes.NewClientConsensusStates(
+			c.id, []clienttypes.ConsensusStateWithHeight{clienttypes.NewConsensusStateWithHeight(c.clientState.LatestHeight, consensusState)},
+		))
+	}
+
+	genesisFile := writeRecoverGenesis(t, cdc, ibcGenesis, govv1.DefaultGenesisState())
+	source, err := genesisRecoverySource(cdc, genesisFile, now)
+	require.NoError(t, err)
+	require.Len(t, source.clients, len(clients))
+
+	// the substitute is the active matching client on the same chain with the
+	// highest height, 07-tendermint-3 has a different unbonding period
+	subject, substitute, err := source.plan("07-tendermint-0", "")
+	require.NoError(t, err)
+	require.Equal(t, ibcexported.Expired, subject.Status)
+	require.Equal(t, "07-tendermint-2", substitute.ID)
+	require.Equal(t, ibcexported.Active, substitute.Status)
+
+	// frozen clients are recovered as well
+	_, substitute, err = source.plan("07-tendermint-5", "")
+	require.NoError(t, err)
+	require.Equal(t, "07-tendermint-2", substitute.ID)
+
+	// an explicit substitute on another chain is accepted when it matches
+	_, substitute, err = source.plan("07-tendermint-0", "07-tendermint-4")
+	require.NoError(t, err)
+	require.Equal(t, "07-tendermint-4", substitute.ID)
+
+	_, _, err = source.plan("07-tendermint-0", "07-tendermint-3")
+	require.ErrorContains(t, err, "unbonding_period")
+
+	_, _, err = source.plan("07-tendermint-0", "07-tendermint-5")
+	require.ErrorContains(t, err, "is Frozen")
+
+	_, _, err = source.plan("07-tendermint-2", "")
+	require.ErrorContains(t, err, "does not need to be recovered")
+
+	_, _, err = source.plan("07-tendermint-2", "07-tendermint-1")
+	require.ErrorContains(t, err, "does not need to be recovered")
+
+	_, _, err = source.plan("07-tendermint-9", "")
+	require.ErrorContains(t, err, "not found")
+
+	// the proposal carries a MsgRecoverClient signed by the gov module account
+	cmd := ibcRecoverCmd()
+	require.NoError(t, cmd.Flags().Set(flagExpedited, "true"))
+	proposal, err := source.proposal(cdc, subject, substitute, cmd)
+	require.NoError(t, err)
+	require.True(t, proposal.Expedited)
+	require.Equal(t, govv1.DefaultParams().ExpeditedMinDeposit[0].String(), proposal.Deposit)
+	require.Len(t, proposal.Messages, 1)
+
+	var msg sdk.Msg
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(proposal.Messages[0], &msg))
+	require.Equal(t, &clienttypes.MsgRecoverClient{
+		SubjectClientId:    "07-tendermint-0",
+		SubstituteClientId: "07-tendermint-4",
+		Signer:             authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	}, msg)
+}
+
+func writeRecoverGenesis(t *testing.T, cdc codec.JSONCodec, ibcGenesis *ibctypes.GenesisState, govGenesis *govv1.GenesisState) string {
+	t.Helper()
+
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		ibcexported.ModuleName: cdc.MustMarshalJSON(ibcGenesis),
+		govtypes.ModuleName:    cdc.MustMarshalJSON(govGenesis),
+	})
+	require.NoError(t, err)
+
+	genesisFile := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, genutiltypes.NewAppGenesisWithVersion("enoki-1", appState).SaveAs(genesisFile))
+	return genesisFile
+}
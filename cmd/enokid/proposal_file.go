@@ -0,0 +1,30 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// govProposal mirrors the proposal file read by `tx gov submit-proposal`.
+type govProposal struct {
+	Messages  []json.RawMessage `json:"messages,omitempty"`
+	Metadata  string            `json:"metadata"`
+	Deposit   string            `json:"deposit"`
+	Title     string            `json:"title"`
+	Summary   string            `json:"summary"`
+	Expedited bool              `json:"expedited"`
+}
+
+// readGovProposal reads a proposal file.
+func readGovProposal(path string) (govProposal, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return govProposal{}, err
+	}
+	var proposal govProposal
+	if err := json.Unmarshal(bz, &proposal); err != nil {
+		return govProposal{}, fmt.Errorf("invalid proposal file: %w", err)
+	}
+	return proposal, nil
+}
@@ -110,6 +110,7 @@ func NewRootCmd() *cobra.Command {
 	if err := autoCliOpts.EnhanceRootCommand(rootCmd); err != nil {
 		panic(err)
 	}
+	addGovCommands(rootCmd)
 
 	return rootCmd
 }
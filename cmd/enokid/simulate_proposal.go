@@ -0,0 +1,302 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/hyphacoop/cosmos-enoki/app"
+)
+
+const flagQuery = "query"
+
+// simulatedQueryOutput is a query of the simulation decoded to JSON.
+type simulatedQueryOutput struct {
+	Path   string          `json:"path"`
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// proposalSimulationOutput is the simulation printed by simulate-proposal.
+type proposalSimulationOutput struct {
+	app.ProposalSimulation
+	Queries []simulatedQueryOutput `json:"queries,omitempty"`
+}
+
+// addGovCommands adds the commands extending the gov transaction commands
+// built by autocli.
+func addGovCommands(rootCmd *cobra.Command) {
+	govCmd, _, err := rootCmd.Find([]string{"tx", "gov"})
+	if err != nil || govCmd.Name() != "gov" {
+		panic("gov transaction commands not found")
+	}
+	govCmd.AddCommand(simulateProposalCmd())
+}
+
+// simulateProposalCmd returns the command running the messages of a
+// governance proposal on the local state of a stopped node.
+func simulateProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-proposal [proposal-file|proposal-id]",
+		Short: "Dry-run the messages of a governance proposal on the local state of the node",
+		Long: `Run the messages of a governance proposal as the governance module account on a
+branch of the last committed state of the node, without broadcasting anything or
+writing to the node's data. The proposal is read from a file in the format of
+"tx gov submit-proposal", or from the state for a submitted proposal id.
+
+The messages are checked like at submission, then executed in order like at the
+end of the voting period, stopping at the first failure. The gas, events and
+store changes of the messages are reported, along with the responses of the
+--query gRPC queries before and after the proposal. The command fails if the
+proposal would be rejected or fail. The node must be stopped.`,
+		Example: fmt.Sprintf(`%[1]s tx gov simulate-proposal proposal.json
+%[1]s tx gov simulate-proposal 42 --home ~/.enoki
+%[1]s tx gov simulate-proposal proposal.json --query /cosmos.gov.v1.Query/Params='{"params_type":"voting"}' -o json`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			queryFlags, _ := cmd.Flags().GetStringArray(flagQuery)
+			queries, err := parseSimulatedQueries(clientCtx.Codec, queryFlags)
+			if err != nil {
+				return err
+			}
+
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+			if chainID == "" {
+				genesis, err := genutiltypes.AppGenesisFromFile(serverCtx.Config.GenesisFile())
+				if err != nil {
+					return err
+				}
+				chainID = genesis.ChainID
+			}
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			enokiApp := app.NewEnokiApp(log.NewNopLogger(), db, nil, true, serverCtx.Viper, nil, baseapp.SetChainID(chainID))
+			defer enokiApp.Close()
+
+			msgs, err := readProposalMsgs(clientCtx.Codec, enokiApp, args[0])
+			if err != nil {
+				return err
+			}
+			simulation, err := enokiApp.SimulateProposal(msgs, queries)
+			if err != nil {
+				return err
+			}
+
+			output := proposalSimulationOutput{ProposalSimulation: simulation}
+			for _, query := range simulation.Queries {
+				output.Queries = append(output.Queries, decodeSimulatedQuery(clientCtx.Codec, query))
+			}
+			if outputFormat, _ := cmd.Flags().GetString(flags.FlagOutput); outputFormat == flags.OutputFormatJSON {
+				bz, err := json.MarshalIndent(output, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			} else {
+				printProposalSimulation(cmd.OutOrStdout(), output)
+			}
+
+			if simulation.Error != "" {
+				// the report explains the failure already
+				cmd.SilenceUsage = true
+				return errors.New("the proposal would fail")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArray(flagQuery, nil, "gRPC query run before and after the proposal, as a path with an optional JSON request: /cosmos.bank.v1beta1.Query/Balance='{...}'")
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+
+	return cmd
+}
+
+// readProposalMsgs reads the messages of a submitted proposal from the state
+// of the app, or of a proposal file.
+func readProposalMsgs(cdc codec.Codec, enokiApp *app.EnokiApp, arg string) ([]sdk.Msg, error) {
+	if proposalID, err := strconv.ParseUint(arg, 10, 64); err == nil {
+		ctx := enokiApp.NewUncachedContext(false, cmtproto.Header{ChainID: enokiApp.ChainID(), Height: enokiApp.LastBlockHeight()})
+		proposal, err := enokiApp.GovKeeper.Proposals.Get(ctx, proposalID)
+		if err != nil {
+			return nil, fmt.Errorf("proposal %d: %w", proposalID, err)
+		}
+		return proposal.GetMsgs()
+	}
+
+	proposal, err := readGovProposal(arg)
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]sdk.Msg, len(proposal.Messages))
+	for i, raw := range proposal.Messages {
+		if err := cdc.UnmarshalInterfaceJSON(raw, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("invalid message %d: %w", i, err)
+		}
+	}
+	return msgs, nil
+}
+
+// parseSimulatedQueries encodes the queries given as a gRPC method path with
+// an optional JSON request.
+func parseSimulatedQueries(cdc codec.Codec, queryFlags []string) ([]abci.RequestQuery, error) {
+	queries := make([]abci.RequestQuery, 0, len(queryFlags))
+	for _, query := range queryFlags {
+		path, request, _ := strings.Cut(query, "=")
+		req, _, err := queryMessages(path)
+		if err != nil {
+			return nil, err
+		}
+		if request != "" {
+			if err := cdc.UnmarshalJSON([]byte(request), req); err != nil {
+				return nil, fmt.Errorf("invalid request of query %s: %w", path, err)
+			}
+		}
+		data, err := cdc.Marshal(req)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, abci.RequestQuery{Path: path, Data: data})
+	}
+	return queries, nil
+}
+
+// queryMessages returns new request and response messages of a gRPC query
+// method path like /cosmos.bank.v1beta1.Query/Balance.
+func queryMessages(path string) (gogoproto.Message, gogoproto.Message, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(path, "/"), "/", "."))
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown query %s: %w", path, err)
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s is not a query method", path)
+	}
+	newMessage := func(name protoreflect.FullName) (gogoproto.Message, error) {
+		typ := gogoproto.MessageType(string(name))
+		if typ == nil {
+			return nil, fmt.Errorf("unknown message %s of query %s", name, path)
+		}
+		return reflect.New(typ.Elem()).Interface().(gogoproto.Message), nil
+	}
+	req, err := newMessage(method.Input().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	res, err := newMessage(method.Output().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	return req, res, nil
+}
+
+// decodeSimulatedQuery decodes the responses of the query to JSON.
+func decodeSimulatedQuery(cdc codec.Codec, query app.SimulatedQuery) simulatedQueryOutput {
+	output := simulatedQueryOutput{Path: query.Path, Error: query.Error}
+	var errs []error
+	decode := func(bz []byte) json.RawMessage {
+		if bz == nil {
+			return nil
+		}
+		_, res, err := queryMessages(query.Path)
+		if err == nil {
+			err = cdc.Unmarshal(bz, res)
+		}
+		if err == nil {
+			bz, err = cdc.MarshalJSON(res)
+		}
+		if err != nil {
+			errs = append(errs, err)
+			return nil
+		}
+		return bz
+	}
+	output.Before, output.After = decode(query.Before), decode(query.After)
+	if err := errors.Join(errs...); err != nil && output.Error == "" {
+		output.Error = err.Error()
+	}
+	return output
+}
+
+// printProposalSimulation prints the simulation as text.
+func printProposalSimulation(w io.Writer, output proposalSimulationOutput) {
+	fmt.Fprintf(w, "simulated on height %d\n", output.Height)
+	for i, msg := range output.Messages {
+		status := "ok"
+		if msg.Error != "" {
+			status = "failed: " + msg.Error
+		}
+		fmt.Fprintf(w, "message %d %s: %s, gas %d\n", i, msg.TypeURL, status, msg.GasUsed)
+		for _, event := range msg.Events {
+			attributes := make([]string, 0, len(event.Attributes))
+			for _, attribute := range event.Attributes {
+				attributes = append(attributes, attribute.Key+"="+attribute.Value)
+			}
+			fmt.Fprintf(w, "  event %s: %s\n", event.Type, strings.Join(attributes, ", "))
+		}
+	}
+	fmt.Fprintf(w, "gas used: %d\n", output.GasUsed)
+
+	if len(output.Changes) > 0 {
+		fmt.Fprintf(w, "changes:\n")
+	}
+	for _, change := range output.Changes {
+		switch {
+		case change.Before == nil:
+			fmt.Fprintf(w, "  %s %X: added (%d bytes)\n", change.Store, change.Key, len(change.After))
+		case change.After == nil:
+			fmt.Fprintf(w, "  %s %X: deleted (%d bytes)\n", change.Store, change.Key, len(change.Before))
+		default:
+			fmt.Fprintf(w, "  %s %X: %s -> %s\n", change.Store, change.Key, hex.EncodeToString(change.Before), hex.EncodeToString(change.After))
+		}
+	}
+
+	for _, query := range output.Queries {
+		fmt.Fprintf(w, "query %s\n", query.Path)
+		if query.Error != "" {
+			fmt.Fprintf(w, "  error: %s\n", query.Error)
+		}
+		if string(query.Before) == string(query.After) {
+			fmt.Fprintf(w, "  unchanged: %s\n", query.Before)
+			continue
+		}
+		fmt.Fprintf(w, "  before: %s\n  after:  %s\n", query.Before, query.After)
+	}
+
+	if output.Error != "" {
+		fmt.Fprintf(w, "error: %s\n", output.Error)
+	}
+}
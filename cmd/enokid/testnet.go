@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/hyphacoop/cosmos-enoki/app"
+)
+
+const (
+	flagAccountsToFund = "accounts-to-fund"
+	flagFundCoins      = "fund-coins"
+	flagVotingPeriod   = "voting-period"
+	flagKeepIBCClients = "keep-ibc-clients"
+)
+
+// inPlaceTestnetCommand returns the command starting a single validator
+// testnet from the state of the node.
+func inPlaceTestnetCommand() *cobra.Command {
+	cmd := server.InPlaceTestnetCreator(newTestnetApp)
+	cmd.Long += `
+The validators of the chain are jailed and unbonded, keeping their delegations,
+and the local validator key operated by the new operator address becomes the only
+validator. The operator and the accounts to fund receive minted coins, the
+governance periods are shortened, and only the localhost IBC client stays allowed
+so no packet reaches the counterparties of the chain.
+`
+	cmd.Example = fmt.Sprintf(`%[1]s in-place-testnet enoki-rehearsal-1 enoki1... --accounts-to-fund enoki1...,enoki1...
+%[1]s in-place-testnet enoki-rehearsal-1 enoki1... --trigger-testnet-upgrade v2.3.0 --voting-period 2m`, version.AppName)
+	addModuleInitFlags(cmd)
+	cmd.Flags().StringSlice(flagAccountsToFund, nil, "Comma separated addresses funded on the testnet, in addition to the operator")
+	cmd.Flags().String(flagFundCoins, "", "Coins minted to each funded account (default 1000000000000 of the bond denom)")
+	cmd.Flags().Duration(flagVotingPeriod, time.Minute, "Governance voting and deposit period of the testnet, the expedited voting period is half of it, zero keeps the periods of the chain")
+	cmd.Flags().Bool(flagKeepIBCClients, false, "Keep the IBC clients of the chain usable instead of allowing the localhost client only")
+
+	return cmd
+}
+
+// newTestnetApp creates the app of `in-place-testnet`, turning the state of
+// the node into a single validator testnet controlled by its validator key.
+func newTestnetApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+	cfg, err := readTestnetConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("invalid in-place testnet options: %s", err))
+	}
+
+	// the store upgrades of the triggered upgrade are applied when loading
+	// the stores, before the upgrade is scheduled
+	if cfg.UpgradeToTrigger != "" {
+		if err := app.WriteTestnetUpgradeInfo(db, cast.ToString(appOpts.Get(flags.FlagHome)), cfg.UpgradeToTrigger); err != nil {
+			panic(err)
+		}
+	}
+
+	enokiApp := newApp(logger, db, traceStore, appOpts).(*app.EnokiApp)
+	if err := enokiApp.InitForTestnet(cfg); err != nil {
+		panic(fmt.Sprintf("failed to create the in-place testnet: %s", err))
+	}
+	return enokiApp
+}
+
+// readTestnetConfig reads the testnet config from the keys set by
+// `in-place-testnet` and its flags.
+func readTestnetConfig(appOpts servertypes.AppOptions) (app.TestnetConfig, error) {
+	pubKey, ok := appOpts.Get(server.KeyUserPubKey).(cmtcrypto.PubKey)
+	if !ok {
+		return app.TestnetConfig{}, fmt.Errorf("missing validator public key")
+	}
+	validatorPubKey, err := cryptocodec.FromCmtPubKeyInterface(pubKey)
+	if err != nil {
+		return app.TestnetConfig{}, err
+	}
+	operator, err := sdk.AccAddressFromBech32(cast.ToString(appOpts.Get(server.KeyNewOpAddr)))
+	if err != nil {
+		return app.TestnetConfig{}, fmt.Errorf("invalid operator address: %w", err)
+	}
+
+	var accounts []sdk.AccAddress
+	for _, addr := range cast.ToStringSlice(appOpts.Get(flagAccountsToFund)) {
+		account, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			return app.TestnetConfig{}, fmt.Errorf("invalid account to fund %s: %w", addr, err)
+		}
+		accounts = append(accounts, account)
+	}
+	fundCoins, err := sdk.ParseCoinsNormalized(cast.ToString(appOpts.Get(flagFundCoins)))
+	if err != nil {
+		return app.TestnetConfig{}, fmt.Errorf("invalid fund coins: %w", err)
+	}
+
+	return app.TestnetConfig{
+		ValidatorPubKey:  validatorPubKey,
+		OperatorAddress:  operator,
+		AccountsToFund:   accounts,
+		FundCoins:        fundCoins,
+		VotingPeriod:     cast.ToDuration(appOpts.Get(flagVotingPeriod)),
+		KeepIBCClients:   cast.ToBool(appOpts.Get(flagKeepIBCClients)),
+		UpgradeToTrigger: cast.ToString(appOpts.Get(server.KeyTriggerTestnetUpgrade)),
+	}, nil
+}
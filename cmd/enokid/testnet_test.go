@@ -0,0 +1,50 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestReadTestnetConfig(t *testing.T) {
+	pubKey := cmted25519.GenPrivKey().PubKey()
+	operator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	appOpts := viper.New()
+	appOpts.Set(server.KeyUserPubKey, pubKey)
+	appOpts.Set(server.KeyNewOpAddr, operator.String())
+	appOpts.Set(server.KeyTriggerTestnetUpgrade, "v2.3.0")
+	appOpts.Set(flagAccountsToFund, []string{account.String()})
+	appOpts.Set(flagFundCoins, "100uoki")
+	appOpts.Set(flagVotingPeriod, 2*time.Minute)
+
+	cfg, err := readTestnetConfig(appOpts)
+	require.NoError(t, err)
+	require.Equal(t, pubKey.Bytes(), cfg.ValidatorPubKey.Bytes())
+	require.Equal(t, operator, cfg.OperatorAddress)
+	require.Equal(t, []sdk.AccAddress{account}, cfg.AccountsToFund)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uoki", 100)), cfg.FundCoins)
+	require.Equal(t, 2*time.Minute, cfg.VotingPeriod)
+	require.False(t, cfg.KeepIBCClients)
+	require.Equal(t, "v2.3.0", cfg.UpgradeToTrigger)
+
+	appOpts.Set(flagAccountsToFund, []string{"invalid"})
+	_, err = readTestnetConfig(appOpts)
+	require.ErrorContains(t, err, "invalid account to fund invalid")
+
+	appOpts.Set(server.KeyNewOpAddr, "invalid")
+	_, err = readTestnetConfig(appOpts)
+	require.ErrorContains(t, err, "invalid operator address")
+
+	appOpts.Set(server.KeyUserPubKey, nil)
+	_, err = readTestnetConfig(appOpts)
+	require.ErrorContains(t, err, "missing validator public key")
+}
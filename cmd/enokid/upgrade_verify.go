@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/hyphacoop/cosmos-enoki/app"
+)
+
+// upgradeCommand returns the chain upgrade subcommands.
+func upgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "upgrade",
+		Short:                      "Chain upgrade subcommands",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(upgradeVerifyCmd())
+
+	return cmd
+}
+
+// upgradeVerifyCmd returns the command comparing the stores of this binary
+// with the last committed state of the node.
+func upgradeVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [upgrade-name]",
+		Short: "Check the stores of this binary against the last committed state of the node",
+		Long: `Compare the stores mounted by this binary with the stores of the last committed
+state of the node, applying the store upgrades of the given upgrade. Without an
+upgrade name, the upgrade the node halted for is used when it is applied at the
+next height.
+
+Missing stores are mounted but neither committed nor added by the upgrade, and
+the node fails to load them. Extra stores are committed but neither mounted nor
+deleted by the upgrade. Conflicting stores are added by the upgrade while already
+committed, or deleted by it while still mounted. The command fails if any are
+found. The node must be stopped.`,
+		Example: fmt.Sprintf(`%[1]s upgrade verify
+%[1]s upgrade verify v2.3.0 --home ~/.enoki`, version.AppName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			enokiApp := app.NewEnokiApp(log.NewNopLogger(), db, nil, false, serverCtx.Viper, nil)
+			var upgradeName string
+			if len(args) > 0 {
+				upgradeName = args[0]
+			}
+			report, err := enokiApp.VerifyStoreKeys(upgradeName)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("last committed version: %d\n", report.Version)
+			if report.Upgrade != "" {
+				cmd.Printf("upgrade:                %s\n", report.Upgrade)
+			}
+			for _, rename := range report.Renamed {
+				cmd.Printf("renamed:                %s -> %s\n", rename.OldKey, rename.NewKey)
+			}
+			printStores := func(label string, stores []string) {
+				if len(stores) > 0 {
+					cmd.Printf("%-23s %s\n", label+":", strings.Join(stores, ", "))
+				}
+			}
+			printStores("missing", report.Missing)
+			printStores("extra", report.Extra)
+			printStores("conflicting", report.Conflicting)
+
+			if err := report.Err(); err != nil {
+				return err
+			}
+			cmd.Println("store keys are consistent")
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+
+	return cmd
+}
@@ -0,0 +1,240 @@
+package ica
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	bindingstypes "github.com/hyphacoop/cosmos-enoki/wasmbinding/ica/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	EventTypeSudoFailed = "ica_contract_sudo_failed"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyPortID   = "port_id"
+	AttributeKeyError    = "error"
+)
+
+// ContractSudoer is the subset of the wasm keeper used to deliver callbacks.
+type ContractSudoer interface {
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule is the authentication application underneath the interchain
+// accounts controller middleware. It forwards channel and packet callbacks of
+// contract owned interchain accounts to the owning contract as sudo messages.
+//
+// Contract errors and out of gas are caught and emitted as events so a
+// misbehaving contract cannot block acknowledgements or timeouts.
+type IBCModule struct {
+	sudoer     ContractSudoer
+	maxSudoGas uint64
+}
+
+// NewIBCModule creates an IBCModule delivering callbacks with at most maxSudoGas gas.
+func NewIBCModule(sudoer ContractSudoer, maxSudoGas uint64) IBCModule {
+	return IBCModule{
+		sudoer:     sudoer,
+		maxSudoGas: maxSudoGas,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface. The version is set by the
+// controller middleware.
+func (IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return "", errorsmod.Wrap(channeltypes.ErrInvalidChannelState, "channel handshake must be initiated by the controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	contractAddr, icaID, err := ParseControllerPortID(portID)
+	if err != nil {
+		return err
+	}
+
+	im.sudo(ctx, contractAddr, portID, bindingstypes.SudoMsg{
+		OpenAck: &bindingstypes.OpenAck{
+			PortID:                portID,
+			ChannelID:             channelID,
+			CounterpartyChannelID: counterpartyChannelID,
+			CounterpartyVersion:   counterpartyVersion,
+			InterchainAccountID:   icaID,
+		},
+	})
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The controller middleware
+// rejects packets before they reach this module.
+func (IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(channeltypes.ErrInvalidPacket, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	contractAddr, _, err := ParseControllerPortID(packet.SourcePort)
+	if err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(err, "cannot unmarshal interchain accounts packet acknowledgement")
+	}
+
+	var msg bindingstypes.SudoMsg
+	if ack.Success() {
+		msg.Response = &bindingstypes.Response{
+			Request: newRequestPacket(packet),
+			Data:    ack.GetResult(),
+		}
+	} else {
+		msg.Error = &bindingstypes.Error{
+			Request: newRequestPacket(packet),
+			Details: ack.GetError(),
+		}
+	}
+
+	im.sudo(ctx, contractAddr, packet.SourcePort, msg)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	contractAddr, _, err := ParseControllerPortID(packet.SourcePort)
+	if err != nil {
+		return err
+	}
+
+	im.sudo(ctx, contractAddr, packet.SourcePort, bindingstypes.SudoMsg{
+		Timeout: &bindingstypes.Timeout{
+			Request: newRequestPacket(packet),
+		},
+	})
+	return nil
+}
+
+// sudo calls the contract in a cached context with a limited gas meter. State
+// changes are only written when the contract call succeeds.
+func (im IBCModule) sudo(ctx sdk.Context, contractAddr sdk.AccAddress, portID string, msg bindingstypes.SudoMsg) {
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		im.emitSudoFailed(ctx, contractAddr, portID, err)
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasMeter := storetypes.NewGasMeter(im.maxSudoGas)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	err = func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+				if !ok {
+					panic(r)
+				}
+				err = fmt.Errorf("out of gas in location: %s", outOfGas.Descriptor)
+			}
+		}()
+		_, err = im.sudoer.Sudo(cacheCtx, contractAddr, bz)
+		return err
+	}()
+
+	ctx.GasMeter().ConsumeGas(min(gasMeter.GasConsumed(), im.maxSudoGas), "interchain accounts contract callback")
+	if err != nil {
+		im.emitSudoFailed(ctx, contractAddr, portID, err)
+		return
+	}
+	writeCache()
+}
+
+func (IBCModule) emitSudoFailed(ctx sdk.Context, contractAddr sdk.AccAddress, portID string, err error) {
+	ctx.Logger().Error("interchain accounts contract callback failed", "contract", contractAddr.String(), "port_id", portID, "error", err)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeSudoFailed,
+		sdk.NewAttribute(AttributeKeyContract, contractAddr.String()),
+		sdk.NewAttribute(AttributeKeyPortID, portID),
+		sdk.NewAttribute(AttributeKeyError, err.Error()),
+	))
+}
+
+func newRequestPacket(packet channeltypes.Packet) bindingstypes.RequestPacket {
+	return bindingstypes.RequestPacket{
+		Sequence:           packet.Sequence,
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+		Data:               packet.Data,
+	}
+}
@@ -0,0 +1,159 @@
+package ica_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/hyphacoop/cosmos-enoki/wasmbinding/ica"
+	bindingstypes "github.com/hyphacoop/cosmos-enoki/wasmbinding/ica/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockSudoer struct {
+	calls   []bindingstypes.SudoMsg
+	gas     uint64
+	err     error
+	storeFn func(ctx sdk.Context)
+}
+
+func (m *mockSudoer) Sudo(c context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var sudoMsg bindingstypes.SudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	m.calls = append(m.calls, sudoMsg)
+	if m.storeFn != nil {
+		m.storeFn(ctx)
+	}
+	ctx.GasMeter().ConsumeGas(m.gas, "test")
+	return nil, m.err
+}
+
+func setupContext(t *testing.T) (sdk.Context, storetypes.StoreKey) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), key
+}
+
+func TestICAOwner(t *testing.T) {
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	owner, err := ica.NewICAOwner(contract, "")
+	require.NoError(t, err)
+	require.Equal(t, contract.String(), owner)
+
+	owner, err = ica.NewICAOwner(contract, "vault-1")
+	require.NoError(t, err)
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+
+	addr, icaID, err := ica.ParseControllerPortID(portID)
+	require.NoError(t, err)
+	require.Equal(t, contract, addr)
+	require.Equal(t, "vault-1", icaID)
+
+	_, err = ica.NewICAOwner(contract, "vault.1")
+	require.Error(t, err)
+	_, _, err = ica.ParseControllerPortID("transfer")
+	require.Error(t, err)
+	_, _, err = ica.ParseControllerPortID(icatypes.ControllerPortPrefix + "not-an-address")
+	require.Error(t, err)
+}
+
+func TestIBCModuleCallbacks(t *testing.T) {
+	contract := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	owner, err := ica.NewICAOwner(contract, "1")
+	require.NoError(t, err)
+	portID, err := icatypes.NewControllerPortID(owner)
+	require.NoError(t, err)
+
+	packet := channeltypes.Packet{
+		Sequence:           7,
+		SourcePort:         portID,
+		SourceChannel:      "channel-3",
+		DestinationPort:    icatypes.HostPortID,
+		DestinationChannel: "channel-9",
+		Data:               []byte("data"),
+	}
+
+	t.Run("open ack", func(t *testing.T) {
+		ctx, _ := setupContext(t)
+		sudoer := &mockSudoer{}
+		im := ica.NewIBCModule(sudoer, 100_000)
+
+		require.NoError(t, im.OnChanOpenAck(ctx, portID, "channel-3", "channel-9", "version"))
+		require.Len(t, sudoer.calls, 1)
+		require.NotNil(t, sudoer.calls[0].OpenAck)
+		require.Equal(t, "1", sudoer.calls[0].OpenAck.InterchainAccountID)
+		require.Equal(t, "channel-9", sudoer.calls[0].OpenAck.CounterpartyChannelID)
+	})
+
+	t.Run("ack result and error", func(t *testing.T) {
+		ctx, _ := setupContext(t)
+		sudoer := &mockSudoer{}
+		im := ica.NewIBCModule(sudoer, 100_000)
+
+		ack := channeltypes.NewResultAcknowledgement([]byte("result"))
+		require.NoError(t, im.OnAcknowledgementPacket(ctx, "", packet, ack.Acknowledgement(), nil))
+		errAck := channeltypes.NewErrorAcknowledgement(errors.New("failed"))
+		require.NoError(t, im.OnAcknowledgementPacket(ctx, "", packet, errAck.Acknowledgement(), nil))
+
+		require.Len(t, sudoer.calls, 2)
+		require.NotNil(t, sudoer.calls[0].Response)
+		require.Equal(t, []byte("result"), sudoer.calls[0].Response.Data)
+		require.Equal(t, uint64(7), sudoer.calls[0].Response.Request.Sequence)
+		require.NotNil(t, sudoer.calls[1].Error)
+		require.NotEmpty(t, sudoer.calls[1].Error.Details)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx, _ := setupContext(t)
+		sudoer := &mockSudoer{}
+		im := ica.NewIBCModule(sudoer, 100_000)
+
+		require.NoError(t, im.OnTimeoutPacket(ctx, "", packet, nil))
+		require.Len(t, sudoer.calls, 1)
+		require.NotNil(t, sudoer.calls[0].Timeout)
+		require.Equal(t, "channel-3", sudoer.calls[0].Timeout.Request.SourceChannel)
+	})
+
+	t.Run("contract failures do not fail the callback", func(t *testing.T) {
+		ctx, key := setupContext(t)
+		writes := func(ctx sdk.Context) { ctx.KVStore(key).Set([]byte("k"), []byte("v")) }
+
+		sudoer := &mockSudoer{err: errors.New("contract error"), storeFn: writes}
+		im := ica.NewIBCModule(sudoer, 100_000)
+		require.NoError(t, im.OnTimeoutPacket(ctx, "", packet, nil))
+		require.False(t, ctx.KVStore(key).Has([]byte("k")), "state of a failed callback must be discarded")
+
+		sudoer = &mockSudoer{gas: 200_000, storeFn: writes}
+		im = ica.NewIBCModule(sudoer, 100_000)
+		gasBefore := ctx.GasMeter().GasConsumed()
+		require.NoError(t, im.OnTimeoutPacket(ctx, "", packet, nil))
+		require.Equal(t, uint64(100_000), ctx.GasMeter().GasConsumed()-gasBefore, "out of gas is charged up to the limit")
+		require.False(t, ctx.KVStore(key).Has([]byte("k")))
+
+		sudoer = &mockSudoer{storeFn: writes}
+		im = ica.NewIBCModule(sudoer, 100_000)
+		require.NoError(t, im.OnTimeoutPacket(ctx, "", packet, nil))
+		require.True(t, ctx.KVStore(key).Has([]byte("k")))
+	})
+
+	t.Run("unknown port", func(t *testing.T) {
+		ctx, _ := setupContext(t)
+		im := ica.NewIBCModule(&mockSudoer{}, 100_000)
+		require.Error(t, im.OnChanOpenAck(ctx, "transfer", "channel-0", "channel-1", ""))
+	})
+}
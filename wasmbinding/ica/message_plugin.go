@@ -0,0 +1,177 @@
+package ica
+
+import (
+	"encoding/json"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	bindingstypes "github.com/hyphacoop/cosmos-enoki/wasmbinding/ica/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTimeout is the upper bound for the relative timeout of a SubmitTx packet.
+const MaxTimeout = uint64(14 * 24 * time.Hour / time.Second)
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(cdc codec.Codec, icaController *icacontrollerkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:       old,
+			cdc:           cdc,
+			icaController: icaController,
+		}
+	}
+}
+
+var emptyMsgResp = [][]*codectypes.Any{}
+
+type CustomMessenger struct {
+	wrapped       wasmkeeper.Messenger
+	cdc           codec.Codec
+	icaController *icacontrollerkeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg implements keeper.Messenger.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	if msg.Custom != nil {
+		// other custom bindings share the same message space, so malformed
+		// messages and anything that is not an interchain accounts message are
+		// left for the wrapped messenger
+		var contractMsg bindingstypes.ICAMsg
+		if json.Unmarshal(msg.Custom, &contractMsg) == nil {
+			if contractMsg.RegisterInterchainAccount != nil {
+				return m.registerInterchainAccount(ctx, contractAddr, contractMsg.RegisterInterchainAccount)
+			}
+			if contractMsg.SubmitTx != nil {
+				return m.submitTx(ctx, contractAddr, contractMsg.SubmitTx)
+			}
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// registerInterchainAccount starts the channel handshake for a new interchain account
+func (m *CustomMessenger) registerInterchainAccount(ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.RegisterInterchainAccount) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if err := PerformRegisterInterchainAccount(m.icaController, ctx, contractAddr, register); err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform register interchain account")
+	}
+	return nil, nil, emptyMsgResp, nil
+}
+
+// PerformRegisterInterchainAccount is used with registerInterchainAccount to open an interchain account channel.
+func PerformRegisterInterchainAccount(k *icacontrollerkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.RegisterInterchainAccount) error {
+	if register == nil {
+		return wasmvmtypes.InvalidRequest{Err: "register interchain account null message"}
+	}
+	if register.ConnectionID == "" {
+		return wasmvmtypes.InvalidRequest{Err: "register interchain account empty connection id"}
+	}
+
+	owner, err := NewICAOwner(contractAddr, register.InterchainAccountID)
+	if err != nil {
+		return wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+
+	ordering := channeltypes.UNORDERED
+	if register.Ordering != "" {
+		value, ok := channeltypes.Order_value[register.Ordering]
+		if !ok {
+			return wasmvmtypes.InvalidRequest{Err: "register interchain account invalid ordering " + register.Ordering}
+		}
+		ordering = channeltypes.Order(value)
+	}
+
+	// the legacy keeper API enables the middleware for this port, which routes
+	// channel and packet callbacks to the contract through IBCModule
+	return k.RegisterInterchainAccount(ctx, register.ConnectionID, owner, "", ordering) //nolint:staticcheck // callbacks are routed to the contract
+}
+
+// submitTx sends messages to be executed by an interchain account
+func (m *CustomMessenger) submitTx(ctx sdk.Context, contractAddr sdk.AccAddress, submitTx *bindingstypes.SubmitTx) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	res, err := PerformSubmitTx(m.icaController, m.cdc, ctx, contractAddr, submitTx)
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform submit tx")
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "failed to marshal SubmitTxResponse")
+	}
+	return nil, [][]byte{bz}, emptyMsgResp, nil
+}
+
+// PerformSubmitTx is used with submitTx to send an interchain accounts packet; validates the SubmitTx message.
+func PerformSubmitTx(k *icacontrollerkeeper.Keeper, cdc codec.Codec, ctx sdk.Context, contractAddr sdk.AccAddress, submitTx *bindingstypes.SubmitTx) (*bindingstypes.SubmitTxResponse, error) {
+	if submitTx == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "submit tx null message"}
+	}
+	if len(submitTx.Msgs) == 0 {
+		return nil, wasmvmtypes.InvalidRequest{Err: "submit tx empty msgs"}
+	}
+	if submitTx.Timeout == 0 || submitTx.Timeout > MaxTimeout {
+		return nil, wasmvmtypes.InvalidRequest{Err: "submit tx timeout out of range"}
+	}
+
+	owner, err := NewICAOwner(contractAddr, submitTx.InterchainAccountID)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, found := k.GetOpenActiveChannel(ctx, submitTx.ConnectionID, portID)
+	if !found {
+		return nil, errorsmod.Wrapf(icatypes.ErrActiveChannelNotFound, "no open channel on connection %s for port %s", submitTx.ConnectionID, portID)
+	}
+
+	version, found := k.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidVersion, "no app version for port %s and channel %s", portID, channelID)
+	}
+	metadata, err := icatypes.MetadataFromVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.Encoding != icatypes.EncodingProtobuf {
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "contracts can only submit txs over %s encoded channels, got %s", icatypes.EncodingProtobuf, metadata.Encoding)
+	}
+
+	anys := make([]*codectypes.Any, len(submitTx.Msgs))
+	for i, msg := range submitTx.Msgs {
+		anys[i] = &codectypes.Any{TypeUrl: msg.TypeURL, Value: msg.Value}
+	}
+	data, err := cdc.Marshal(&icatypes.CosmosTx{Messages: anys})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "marshal cosmos tx")
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: submitTx.Memo,
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().UnixNano()) + submitTx.Timeout*uint64(time.Second)
+
+	sequence, err := k.SendTx(ctx, submitTx.ConnectionID, portID, packetData, timeoutTimestamp) //nolint:staticcheck // callbacks are routed to the contract
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindingstypes.SubmitTxResponse{
+		SequenceID: sequence,
+		Channel:    channelID,
+	}, nil
+}
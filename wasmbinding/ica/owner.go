@@ -0,0 +1,60 @@
+package ica
+
+import (
+	"fmt"
+	"strings"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// OwnerDelimiter separates the contract address from the interchain
+	// account id in the owner string of contract controlled accounts.
+	OwnerDelimiter = "."
+
+	// MaxInterchainAccountIDLength keeps the controller port id within the
+	// 128 character limit of ICS-24 for any contract address.
+	MaxInterchainAccountIDLength = 47
+)
+
+// NewICAOwner builds the owner string used for the controller port of a
+// contract controlled interchain account.
+func NewICAOwner(contractAddr sdk.AccAddress, interchainAccountID string) (string, error) {
+	if interchainAccountID == "" {
+		return contractAddr.String(), nil
+	}
+
+	if len(interchainAccountID) > MaxInterchainAccountIDLength {
+		return "", fmt.Errorf("interchain account id %q is longer than %d characters", interchainAccountID, MaxInterchainAccountIDLength)
+	}
+	for _, c := range interchainAccountID {
+		if !isAllowedIDChar(c) {
+			return "", fmt.Errorf("interchain account id %q contains invalid character %q", interchainAccountID, c)
+		}
+	}
+
+	return contractAddr.String() + OwnerDelimiter + interchainAccountID, nil
+}
+
+// ParseControllerPortID extracts the contract address and interchain account
+// id from a controller port id created by NewICAOwner.
+func ParseControllerPortID(portID string) (sdk.AccAddress, string, error) {
+	owner, found := strings.CutPrefix(portID, icatypes.ControllerPortPrefix)
+	if !found {
+		return nil, "", fmt.Errorf("port %s is not an interchain accounts controller port", portID)
+	}
+
+	addr, icaID, _ := strings.Cut(owner, OwnerDelimiter)
+	contractAddr, err := sdk.AccAddressFromBech32(addr)
+	if err != nil {
+		return nil, "", fmt.Errorf("port %s is not owned by a contract: %w", portID, err)
+	}
+
+	return contractAddr, icaID, nil
+}
+
+func isAllowedIDChar(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '-' || c == '_'
+}
@@ -0,0 +1,65 @@
+package ica
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	bindingstypes "github.com/hyphacoop/cosmos-enoki/wasmbinding/ica/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CustomQueryDecorator returns a decorator for the wasm query handler that
+// answers interchain accounts queries and passes everything else on, so it can
+// be combined with other custom query plugins.
+func CustomQueryDecorator(icaController *icacontrollerkeeper.Keeper) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return wasmkeeper.WasmVMQueryHandlerFn(func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+			if request.Custom != nil {
+				var contractQuery bindingstypes.ICAQuery
+				// a failed unmarshal means this is not a query for us
+				if err := json.Unmarshal(request.Custom, &contractQuery); err == nil && contractQuery.InterchainAccountAddress != nil {
+					return QueryInterchainAccountAddress(icaController, ctx, contractQuery.InterchainAccountAddress)
+				}
+			}
+			return old.HandleQuery(ctx, caller, request)
+		})
+	}
+}
+
+// QueryInterchainAccountAddress returns the host chain address of a contract controlled interchain account.
+func QueryInterchainAccountAddress(k *icacontrollerkeeper.Keeper, ctx sdk.Context, query *bindingstypes.InterchainAccountAddress) ([]byte, error) {
+	ownerAddr, err := sdk.AccAddressFromBech32(query.OwnerAddress)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid owner address: " + err.Error()}
+	}
+	owner, err := NewICAOwner(ownerAddr, query.InterchainAccountID)
+	if err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	address, found := k.GetInterchainAccountAddress(ctx, query.ConnectionID, portID)
+	if !found {
+		return nil, errorsmod.Wrapf(icatypes.ErrInterchainAccountNotFound, "no interchain account found for port %s on connection %s", portID, query.ConnectionID)
+	}
+	channelID, _ := k.GetActiveChannelID(ctx, query.ConnectionID, portID)
+
+	res := bindingstypes.InterchainAccountAddressResponse{
+		InterchainAccountAddress: address,
+		ChannelID:                channelID,
+	}
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal InterchainAccountAddressResponse")
+	}
+	return bz, nil
+}
@@ -0,0 +1,48 @@
+package types
+
+// ICAMsg is the set of custom messages a contract can dispatch to control
+// interchain accounts owned by itself.
+type ICAMsg struct {
+	/// Contracts can register an interchain account on a connection. The
+	/// account is owned by the contract and, optionally, a contract chosen
+	/// interchain account id so a contract can own several accounts on the
+	/// same connection.
+	RegisterInterchainAccount *RegisterInterchainAccount `json:"register_interchain_account,omitempty"`
+	/// Contracts can submit a batch of messages to be executed by one of
+	/// their interchain accounts on the host chain.
+	SubmitTx *SubmitTx `json:"submit_tx,omitempty"`
+}
+
+// RegisterInterchainAccount opens a new ICA channel on the given connection.
+// The contract receives an `open_ack` sudo callback once the handshake completes.
+type RegisterInterchainAccount struct {
+	ConnectionID        string `json:"connection_id"`
+	InterchainAccountID string `json:"interchain_account_id,omitempty"`
+	// Ordering is either "ORDER_ORDERED" or "ORDER_UNORDERED". It defaults to
+	// "ORDER_UNORDERED" when left empty.
+	Ordering string `json:"ordering,omitempty"`
+}
+
+// SubmitTx sends protobuf encoded messages over the active channel of an
+// interchain account. The contract receives a `response`, `error` or
+// `timeout` sudo callback for the resulting packet.
+type SubmitTx struct {
+	ConnectionID        string        `json:"connection_id"`
+	InterchainAccountID string        `json:"interchain_account_id,omitempty"`
+	Msgs                []ProtobufAny `json:"msgs"`
+	Memo                string        `json:"memo,omitempty"`
+	// Timeout is the relative packet timeout in seconds.
+	Timeout uint64 `json:"timeout"`
+}
+
+// ProtobufAny is a protobuf encoded message and its type url.
+type ProtobufAny struct {
+	TypeURL string `json:"type_url"`
+	Value   []byte `json:"value"`
+}
+
+// SubmitTxResponse is returned as message data for SubmitTx.
+type SubmitTxResponse struct {
+	SequenceID uint64 `json:"sequence_id"`
+	Channel    string `json:"channel"`
+}
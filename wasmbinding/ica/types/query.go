@@ -0,0 +1,19 @@
+package types
+
+// ICAQuery contains the custom queries a contract can run against the
+// interchain accounts controller.
+type ICAQuery struct {
+	/// Returns the address of an interchain account on the host chain.
+	InterchainAccountAddress *InterchainAccountAddress `json:"interchain_account_address,omitempty"`
+}
+
+type InterchainAccountAddress struct {
+	OwnerAddress        string `json:"owner_address"`
+	InterchainAccountID string `json:"interchain_account_id,omitempty"`
+	ConnectionID        string `json:"connection_id"`
+}
+
+type InterchainAccountAddressResponse struct {
+	InterchainAccountAddress string `json:"interchain_account_address"`
+	ChannelID                string `json:"channel_id,omitempty"`
+}
@@ -0,0 +1,48 @@
+package types
+
+// SudoMsg is sent to the owning contract for every interchain account
+// channel and packet lifecycle event. Exactly one field is set.
+type SudoMsg struct {
+	OpenAck  *OpenAck  `json:"open_ack,omitempty"`
+	Response *Response `json:"response,omitempty"`
+	Error    *Error    `json:"error,omitempty"`
+	Timeout  *Timeout  `json:"timeout,omitempty"`
+}
+
+// OpenAck is sent once the channel handshake for a registered interchain
+// account has been acknowledged by the host chain.
+type OpenAck struct {
+	PortID                string `json:"port_id"`
+	ChannelID             string `json:"channel_id"`
+	CounterpartyChannelID string `json:"counterparty_channel_id"`
+	CounterpartyVersion   string `json:"counterparty_version"`
+	InterchainAccountID   string `json:"interchain_account_id,omitempty"`
+}
+
+// Response is sent when the host chain acknowledged a packet with a result.
+type Response struct {
+	Request RequestPacket `json:"request"`
+	Data    []byte        `json:"data"`
+}
+
+// Error is sent when the host chain acknowledged a packet with an error.
+type Error struct {
+	Request RequestPacket `json:"request"`
+	Details string        `json:"details"`
+}
+
+// Timeout is sent when a packet timed out. The interchain account channel
+// is closed as a result and has to be registered again.
+type Timeout struct {
+	Request RequestPacket `json:"request"`
+}
+
+// RequestPacket identifies the packet a callback refers to.
+type RequestPacket struct {
+	Sequence           uint64 `json:"sequence"`
+	SourcePort         string `json:"source_port"`
+	SourceChannel      string `json:"source_channel"`
+	DestinationPort    string `json:"destination_port"`
+	DestinationChannel string `json:"destination_channel"`
+	Data               []byte `json:"data"`
+}
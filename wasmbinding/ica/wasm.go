@@ -0,0 +1,29 @@
+package ica
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCustomPlugins returns the wasm options for the interchain accounts
+// message and query bindings. Channel and packet callbacks are delivered by
+// IBCModule, which must be set as the authentication application of the
+// controller middleware.
+func RegisterCustomPlugins(
+	cdc codec.Codec,
+	icaController *icacontrollerkeeper.Keeper,
+) []wasmkeeper.Option {
+	queryDecoratorOpt := wasmkeeper.WithQueryHandlerDecorator(
+		CustomQueryDecorator(icaController),
+	)
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(cdc, icaController),
+	)
+
+	return []wasmkeeper.Option{
+		queryDecoratorOpt,
+		messengerDecoratorOpt,
+	}
+}
@@ -0,0 +1,48 @@
+package nft
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ClassPrefix is the prefix of the classes created by contracts.
+	ClassPrefix = "wasm"
+
+	// MaxSubclassLength bounds the contract chosen part of a class id.
+	MaxSubclassLength = 64
+)
+
+// NewClassID builds the id of a class created by a contract,
+// wasm/{contract address}/{subclass}.
+func NewClassID(contractAddr sdk.AccAddress, subclass string) (string, error) {
+	if subclass == "" {
+		return "", fmt.Errorf("subclass cannot be empty")
+	}
+	if len(subclass) > MaxSubclassLength {
+		return "", fmt.Errorf("subclass %q is longer than %d characters", subclass, MaxSubclassLength)
+	}
+	for _, c := range subclass {
+		if !isAllowedSubclassChar(c) {
+			return "", fmt.Errorf("subclass %q contains invalid character %q", subclass, c)
+		}
+	}
+
+	return strings.Join([]string{ClassPrefix, contractAddr.String(), subclass}, "/"), nil
+}
+
+// ParseClassID returns the contract that created a class, or an error if
+// the class was not created by a contract.
+func ParseClassID(classID string) (sdk.AccAddress, error) {
+	parts := strings.Split(classID, "/")
+	if len(parts) != 3 || parts[0] != ClassPrefix {
+		return nil, fmt.Errorf("class %s was not created by a contract", classID)
+	}
+	return sdk.AccAddressFromBech32(parts[1])
+}
+
+func isAllowedSubclassChar(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.'
+}
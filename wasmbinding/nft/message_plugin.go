@@ -0,0 +1,219 @@
+package nft
+
+import (
+	"encoding/json"
+	"time"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	bindingstypes "github.com/hyphacoop/cosmos-enoki/wasmbinding/nft/types"
+	nfttransferkeeper "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/keeper"
+	nfttransfertypes "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTimeout is the upper bound for the relative timeout of a TransferNFT packet.
+const MaxTimeout = uint64(14 * 24 * time.Hour / time.Second)
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(nftKeeper nftkeeper.Keeper, transferKeeper nfttransferkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:        old,
+			nftKeeper:      nftKeeper,
+			transferKeeper: transferKeeper,
+		}
+	}
+}
+
+var emptyMsgResp = [][]*codectypes.Any{}
+
+type CustomMessenger struct {
+	wrapped        wasmkeeper.Messenger
+	nftKeeper      nftkeeper.Keeper
+	transferKeeper nfttransferkeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg implements keeper.Messenger.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	if msg.Custom != nil {
+		// other custom bindings share the same message space, so malformed
+		// messages and anything that is not an NFT message are left for the
+		// wrapped messenger
+		var contractMsg bindingstypes.NFTMsg
+		if json.Unmarshal(msg.Custom, &contractMsg) == nil {
+			switch {
+			case contractMsg.CreateClass != nil:
+				return m.createClass(ctx, contractAddr, contractMsg.CreateClass)
+			case contractMsg.MintNFT != nil:
+				return m.mintNFT(ctx, contractAddr, contractMsg.MintNFT)
+			case contractMsg.BurnNFT != nil:
+				return m.burnNFT(ctx, contractAddr, contractMsg.BurnNFT)
+			case contractMsg.TransferNFT != nil:
+				return m.transferNFT(ctx, contractAddr, contractMsg.TransferNFT)
+			}
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// createClass creates a class owned by the contract
+func (m *CustomMessenger) createClass(ctx sdk.Context, contractAddr sdk.AccAddress, createClass *bindingstypes.CreateClass) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	classID, err := PerformCreateClass(m.nftKeeper, ctx, contractAddr, createClass)
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform create class")
+	}
+
+	bz, err := json.Marshal(bindingstypes.CreateClassResponse{ClassID: classID})
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "failed to marshal CreateClassResponse")
+	}
+	return nil, [][]byte{bz}, emptyMsgResp, nil
+}
+
+// PerformCreateClass is used with createClass to create a class namespaced to the contract.
+func PerformCreateClass(k nftkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, createClass *bindingstypes.CreateClass) (string, error) {
+	if createClass == nil {
+		return "", wasmvmtypes.InvalidRequest{Err: "create class null message"}
+	}
+
+	classID, err := NewClassID(contractAddr, createClass.Subclass)
+	if err != nil {
+		return "", wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+
+	class := nft.Class{
+		Id:          classID,
+		Name:        createClass.Name,
+		Symbol:      createClass.Symbol,
+		Description: createClass.Description,
+		Uri:         createClass.URI,
+		UriHash:     createClass.URIHash,
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return "", err
+	}
+	return classID, nil
+}
+
+// mintNFT mints an NFT of a class created by the contract
+func (m *CustomMessenger) mintNFT(ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindingstypes.MintNFT) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if err := PerformMintNFT(m.nftKeeper, ctx, contractAddr, mint); err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform mint nft")
+	}
+	return nil, nil, emptyMsgResp, nil
+}
+
+// PerformMintNFT is used with mintNFT to mint an NFT; validates the MintNFT message.
+func PerformMintNFT(k nftkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, mint *bindingstypes.MintNFT) error {
+	if mint == nil {
+		return wasmvmtypes.InvalidRequest{Err: "mint nft null message"}
+	}
+	if err := checkClassCreator(contractAddr, mint.ClassID); err != nil {
+		return err
+	}
+	if mint.TokenID == "" {
+		return wasmvmtypes.InvalidRequest{Err: "mint nft empty token id"}
+	}
+	recipient, err := sdk.AccAddressFromBech32(mint.Recipient)
+	if err != nil {
+		return wasmvmtypes.InvalidRequest{Err: "mint nft invalid recipient: " + err.Error()}
+	}
+
+	token := nft.NFT{
+		ClassId: mint.ClassID,
+		Id:      mint.TokenID,
+		Uri:     mint.URI,
+		UriHash: mint.URIHash,
+	}
+	return k.Mint(ctx, token, recipient)
+}
+
+// burnNFT burns an NFT owned by the contract
+func (m *CustomMessenger) burnNFT(ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindingstypes.BurnNFT) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if err := PerformBurnNFT(m.nftKeeper, ctx, contractAddr, burn); err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform burn nft")
+	}
+	return nil, nil, emptyMsgResp, nil
+}
+
+// PerformBurnNFT is used with burnNFT to burn an NFT; validates the BurnNFT message.
+func PerformBurnNFT(k nftkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, burn *bindingstypes.BurnNFT) error {
+	if burn == nil {
+		return wasmvmtypes.InvalidRequest{Err: "burn nft null message"}
+	}
+	if err := checkClassCreator(contractAddr, burn.ClassID); err != nil {
+		return err
+	}
+	if owner := k.GetOwner(ctx, burn.ClassID, burn.TokenID); !owner.Equals(contractAddr) {
+		return wasmvmtypes.InvalidRequest{Err: "burn nft token " + burn.TokenID + " is not owned by the contract"}
+	}
+	return k.Burn(ctx, burn.ClassID, burn.TokenID)
+}
+
+// checkClassCreator returns an error unless classID was created by the contract.
+func checkClassCreator(contractAddr sdk.AccAddress, classID string) error {
+	creator, err := ParseClassID(classID)
+	if err != nil {
+		return wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+	if !creator.Equals(contractAddr) {
+		return wasmvmtypes.InvalidRequest{Err: "class " + classID + " was not created by the contract"}
+	}
+	return nil
+}
+
+// transferNFT sends NFTs owned by the contract to another chain
+func (m *CustomMessenger) transferNFT(ctx sdk.Context, contractAddr sdk.AccAddress, transfer *bindingstypes.TransferNFT) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	res, err := PerformTransferNFT(m.transferKeeper, ctx, contractAddr, transfer)
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "perform transfer nft")
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, nil, emptyMsgResp, errorsmod.Wrap(err, "failed to marshal TransferNFTResponse")
+	}
+	return nil, [][]byte{bz}, emptyMsgResp, nil
+}
+
+// PerformTransferNFT is used with transferNFT to send an ICS-721 packet; validates the TransferNFT message.
+func PerformTransferNFT(k nfttransferkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, transfer *bindingstypes.TransferNFT) (*bindingstypes.TransferNFTResponse, error) {
+	if transfer == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "transfer nft null message"}
+	}
+	if transfer.Timeout == 0 || transfer.Timeout > MaxTimeout {
+		return nil, wasmvmtypes.InvalidRequest{Err: "transfer nft timeout out of range"}
+	}
+
+	msg := nfttransfertypes.NewMsgTransfer(
+		nfttransfertypes.PortID, transfer.Channel, transfer.ClassID, transfer.TokenIDs,
+		contractAddr.String(), transfer.Receiver, clienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().UnixNano())+transfer.Timeout*uint64(time.Second), transfer.Memo,
+	)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: err.Error()}
+	}
+
+	sequence, err := k.SendTransfer(
+		ctx, msg.SourcePort, msg.SourceChannel, msg.ClassId, msg.TokenIds,
+		contractAddr, msg.Receiver, msg.TimeoutHeight(), msg.TimeoutTimestamp, msg.Memo,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bindingstypes.TransferNFTResponse{
+		SequenceID: sequence,
+		Channel:    transfer.Channel,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package nft
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	bindingstypes "github.com/hyphacoop/cosmos-enoki/wasmbinding/nft/types"
+	nfttransferkeeper "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/keeper"
+	nfttransfertypes "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CustomQueryDecorator returns a decorator for the wasm query handler that
+// answers NFT queries and passes everything else on, so it can be combined
+// with other custom query plugins.
+func CustomQueryDecorator(nftKeeper nftkeeper.Keeper, transferKeeper nfttransferkeeper.Keeper) func(wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+	return func(old wasmkeeper.WasmVMQueryHandler) wasmkeeper.WasmVMQueryHandler {
+		return wasmkeeper.WasmVMQueryHandlerFn(func(ctx sdk.Context, caller sdk.AccAddress, request wasmvmtypes.QueryRequest) ([]byte, error) {
+			if request.Custom != nil {
+				var contractQuery bindingstypes.NFTQuery
+				// a failed unmarshal means this is not a query for us
+				if err := json.Unmarshal(request.Custom, &contractQuery); err == nil {
+					switch {
+					case contractQuery.ClassTrace != nil:
+						return QueryClassTrace(transferKeeper, ctx, contractQuery.ClassTrace)
+					case contractQuery.Owner != nil:
+						return QueryOwner(nftKeeper, ctx, contractQuery.Owner)
+					}
+				}
+			}
+			return old.HandleQuery(ctx, caller, request)
+		})
+	}
+}
+
+// QueryClassTrace returns the trace of a class, the path is empty for
+// classes created on this chain.
+func QueryClassTrace(k nfttransferkeeper.Keeper, ctx sdk.Context, query *bindingstypes.ClassTrace) ([]byte, error) {
+	res := bindingstypes.ClassTraceResponse{BaseClassID: query.ClassID}
+	if nfttransfertypes.IsVoucherClassID(query.ClassID) {
+		hash, err := nfttransfertypes.ParseHexHash(query.ClassID)
+		if err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: err.Error()}
+		}
+		trace, found := k.GetClassTrace(ctx, hash)
+		if !found {
+			return nil, errorsmod.Wrap(nfttransfertypes.ErrTraceNotFound, query.ClassID)
+		}
+		res = bindingstypes.ClassTraceResponse{Path: trace.Path, BaseClassID: trace.BaseClassId}
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal ClassTraceResponse")
+	}
+	return bz, nil
+}
+
+// QueryOwner returns the owner of an NFT.
+func QueryOwner(k nftkeeper.Keeper, ctx sdk.Context, query *bindingstypes.Owner) ([]byte, error) {
+	owner := k.GetOwner(ctx, query.ClassID, query.TokenID)
+	if owner.Empty() {
+		return nil, errorsmod.Wrapf(nft.ErrNFTNotExists, "%s/%s", query.ClassID, query.TokenID)
+	}
+
+	bz, err := json.Marshal(bindingstypes.OwnerResponse{Owner: owner.String()})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal OwnerResponse")
+	}
+	return bz, nil
+}
@@ -0,0 +1,64 @@
+package types
+
+// NFTMsg is the set of custom messages a contract can dispatch to manage
+// x/nft classes and move NFTs between chains over ICS-721.
+type NFTMsg struct {
+	/// Contracts can create x/nft classes namespaced to themselves, the
+	/// class id is wasm/{contract address}/{subclass}.
+	CreateClass *CreateClass `json:"create_class,omitempty"`
+	/// Contracts can mint NFTs of the classes they created.
+	MintNFT *MintNFT `json:"mint_nft,omitempty"`
+	/// Contracts can burn NFTs they own of the classes they created.
+	BurnNFT *BurnNFT `json:"burn_nft,omitempty"`
+	/// Contracts can send NFTs they own to another chain over ICS-721.
+	TransferNFT *TransferNFT `json:"transfer_nft,omitempty"`
+}
+
+// CreateClass creates a new x/nft class owned by the contract.
+type CreateClass struct {
+	Subclass    string `json:"subclass"`
+	Name        string `json:"name,omitempty"`
+	Symbol      string `json:"symbol,omitempty"`
+	Description string `json:"description,omitempty"`
+	URI         string `json:"uri,omitempty"`
+	URIHash     string `json:"uri_hash,omitempty"`
+}
+
+// CreateClassResponse is returned as message data for CreateClass.
+type CreateClassResponse struct {
+	ClassID string `json:"class_id"`
+}
+
+// MintNFT mints an NFT of a class created by the contract to the recipient.
+type MintNFT struct {
+	ClassID   string `json:"class_id"`
+	TokenID   string `json:"token_id"`
+	URI       string `json:"uri,omitempty"`
+	URIHash   string `json:"uri_hash,omitempty"`
+	Recipient string `json:"recipient"`
+}
+
+// BurnNFT burns an NFT owned by the contract of a class created by the
+// contract.
+type BurnNFT struct {
+	ClassID string `json:"class_id"`
+	TokenID string `json:"token_id"`
+}
+
+// TransferNFT sends NFTs owned by the contract over an ICS-721 channel. The
+// class can be any class, including voucher classes.
+type TransferNFT struct {
+	Channel  string   `json:"channel"`
+	ClassID  string   `json:"class_id"`
+	TokenIDs []string `json:"token_ids"`
+	Receiver string   `json:"receiver"`
+	Memo     string   `json:"memo,omitempty"`
+	// Timeout is the relative packet timeout in seconds.
+	Timeout uint64 `json:"timeout"`
+}
+
+// TransferNFTResponse is returned as message data for TransferNFT.
+type TransferNFTResponse struct {
+	SequenceID uint64 `json:"sequence_id"`
+	Channel    string `json:"channel"`
+}
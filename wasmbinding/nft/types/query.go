@@ -0,0 +1,28 @@
+package types
+
+// NFTQuery contains the custom queries a contract can run against x/nft and
+// the ICS-721 application.
+type NFTQuery struct {
+	/// Returns the trace of a class, empty for classes created on this chain.
+	ClassTrace *ClassTrace `json:"class_trace,omitempty"`
+	/// Returns the owner of an NFT.
+	Owner *Owner `json:"owner,omitempty"`
+}
+
+type ClassTrace struct {
+	ClassID string `json:"class_id"`
+}
+
+type ClassTraceResponse struct {
+	Path        string `json:"path"`
+	BaseClassID string `json:"base_class_id"`
+}
+
+type Owner struct {
+	ClassID string `json:"class_id"`
+	TokenID string `json:"token_id"`
+}
+
+type OwnerResponse struct {
+	Owner string `json:"owner"`
+}
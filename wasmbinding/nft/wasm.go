@@ -0,0 +1,27 @@
+package nft
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	nfttransferkeeper "github.com/hyphacoop/cosmos-enoki/x/nfttransfer/keeper"
+
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+)
+
+// RegisterCustomPlugins returns the wasm options for the x/nft and ICS-721
+// message and query bindings.
+func RegisterCustomPlugins(
+	nftKeeper nftkeeper.Keeper,
+	transferKeeper nfttransferkeeper.Keeper,
+) []wasmkeeper.Option {
+	queryDecoratorOpt := wasmkeeper.WithQueryHandlerDecorator(
+		CustomQueryDecorator(nftKeeper, transferKeeper),
+	)
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(nftKeeper, transferKeeper),
+	)
+
+	return []wasmkeeper.Option{
+		queryDecoratorOpt,
+		messengerDecoratorOpt,
+	}
+}
@@ -0,0 +1,64 @@
+package tokenpolicy
+
+import (
+	"encoding/json"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	tokenfactorybindingstypes "github.com/cosmos/tokenfactory/x/tokenfactory/bindings/types"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	tokenpolicykeeper "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/keeper"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CustomMessageDecorator returns a decorator checking the tokenfactory
+// capabilities of a denom before the tokenfactory bindings force a transfer
+// or burn from another account, and its supply policy before they mint.
+func CustomMessageDecorator(k tokenpolicykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped: old,
+			keeper:  k,
+		}
+	}
+}
+
+var emptyMsgResp = [][]*codectypes.Any{}
+
+type CustomMessenger struct {
+	wrapped wasmkeeper.Messenger
+	keeper  tokenpolicykeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg implements keeper.Messenger.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) (events []sdk.Event, data [][]byte, msgResponses [][]*codectypes.Any, err error) {
+	if msg.Custom != nil {
+		// malformed and other custom messages are left for the wrapped
+		// messengers
+		var contractMsg tokenfactorybindingstypes.TokenFactoryMsg
+		if json.Unmarshal(msg.Custom, &contractMsg) == nil {
+			if err := m.checkPolicies(ctx, contractMsg); err != nil {
+				return nil, nil, emptyMsgResp, err
+			}
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// checkPolicies returns an error if the message needs a capability the denom
+// does not have or mints more than its supply policy allows.
+func (m *CustomMessenger) checkPolicies(ctx sdk.Context, msg tokenfactorybindingstypes.TokenFactoryMsg) error {
+	switch {
+	case msg.MintTokens != nil:
+		return m.keeper.CheckMint(ctx, msg.MintTokens.Denom, msg.MintTokens.Amount)
+	case msg.ForceTransfer != nil:
+		return m.keeper.CheckCapability(ctx, msg.ForceTransfer.Denom, tokenfactorytypes.EnableForceTransfer)
+	case msg.BurnTokens != nil && msg.BurnTokens.BurnFromAddress != "":
+		return m.keeper.CheckCapability(ctx, msg.BurnTokens.Denom, tokenfactorytypes.EnableBurnFrom)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package tokenpolicy
+
+import (
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	tokenpolicykeeper "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/keeper"
+)
+
+// RegisterCustomPlugins returns the wasm option enforcing the tokenfactory
+// capabilities and supply policies on the tokenfactory bindings. It must be
+// registered after the tokenfactory bindings so it wraps their messenger.
+func RegisterCustomPlugins(k tokenpolicykeeper.Keeper) []wasmkeeper.Option {
+	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		CustomMessageDecorator(k),
+	)
+
+	return []wasmkeeper.Option{
+		messengerDecoratorOpt,
+	}
+}
@@ -0,0 +1,186 @@
+package channelallowlist
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/hyphacoop/cosmos-enoki/x/channelallowlist/keeper"
+	denommetadatatypes "github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+var (
+	_ porttypes.IBCModule             = IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = IBCMiddleware{}
+	_ porttypes.ICS4Wrapper           = ICS4Wrapper{}
+)
+
+// IBCMiddleware rejects transfers received on channels outside the
+// allowlist with an error acknowledgement.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer stack.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. Transfers on channels
+// outside the allowlist are acknowledged with an error and never reach the
+// wrapped app.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		// leave malformed packets to the transfer app
+		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+
+	denom := denommetadatatypes.ReceivedDenom(data.Token.Denom, packet.SourcePort, packet.SourceChannel, packet.DestinationPort, packet.DestinationChannel).IBCDenom()
+	if err := im.keeper.CheckChannel(ctx, packet.DestinationPort, packet.DestinationChannel, denom); err != nil {
+		im.keeper.EmitTransferRejected(ctx, packet.DestinationChannel, denom, err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface by
+// delegating to the wrapped app.
+func (im IBCMiddleware) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (any, string, error) {
+	return im.app.(porttypes.PacketDataUnmarshaler).UnmarshalPacketData(ctx, portID, channelID, bz)
+}
+
+// ICS4Wrapper rejects outgoing transfers on channels outside the allowlist
+// before they are committed by core IBC.
+type ICS4Wrapper struct {
+	ics4Wrapper porttypes.ICS4Wrapper
+	keeper      keeper.Keeper
+}
+
+// NewICS4Wrapper creates a new ICS4Wrapper sending packets through
+// ics4Wrapper.
+func NewICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, k keeper.Keeper) ICS4Wrapper {
+	return ICS4Wrapper{
+		ics4Wrapper: ics4Wrapper,
+		keeper:      k,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface.
+func (w ICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	version, _ := w.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel)
+	packetData, err := transfertypes.UnmarshalPacketData(data, version, "")
+	if err != nil {
+		return 0, err
+	}
+
+	if err := w.keeper.CheckChannel(ctx, sourcePort, sourceChannel, packetData.Token.Denom.IBCDenom()); err != nil {
+		return 0, err
+	}
+
+	return w.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (w ICS4Wrapper) WriteAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	return w.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (w ICS4Wrapper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return w.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/channelallowlist/types"
+)
+
+// Keeper stores the transfer channel allowlist and checks packets against it.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema    collections.Schema
+	Allowlist collections.Item[types.Params]
+}
+
+// NewKeeper returns a new channelallowlist keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		channelKeeper: channelKeeper,
+		clientKeeper:  clientKeeper,
+		authority:     authority,
+
+		Allowlist: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// CheckChannel returns an error if denom may not be transferred over the IBC
+// v1 channel portID/channelID.
+func (k Keeper) CheckChannel(ctx sdk.Context, portID, channelID, denom string) error {
+	params, err := k.Allowlist.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return params.Allows(channelID, denom, func() string {
+		_, clientState, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+		if err != nil {
+			return ""
+		}
+		return types.ClientChainID(clientState)
+	})
+}
+
+// CheckClient returns an error if denom may not be transferred over the IBC
+// v2 client clientID.
+func (k Keeper) CheckClient(ctx sdk.Context, clientID, denom string) error {
+	params, err := k.Allowlist.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	return params.Allows(clientID, denom, func() string {
+		clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+		if !found {
+			return ""
+		}
+		return types.ClientChainID(clientState)
+	})
+}
+
+// EmitTransferRejected emits an event describing a rejected inbound transfer.
+func (k Keeper) EmitTransferRejected(ctx sdk.Context, channelID, denom string, err error) {
+	k.Logger(ctx).Info("rejected transfer on channel outside the allowlist", "channel", channelID, "denom", denom, "error", err)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferRejected,
+		sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+	))
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	"github.com/hyphacoop/cosmos-enoki/x/channelallowlist"
+	"github.com/hyphacoop/cosmos-enoki/x/channelallowlist/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/channelallowlist/types"
+)
+
+// mockIBCKeeper maps channel and client ids to the chain id of their client.
+type mockIBCKeeper struct {
+	chainIDs map[string]string
+}
+
+func (m *mockIBCKeeper) GetChannelClientState(_ sdk.Context, _, channelID string) (string, ibcexported.ClientState, error) {
+	chainID, ok := m.chainIDs[channelID]
+	if !ok {
+		return "", nil, channeltypes.ErrChannelNotFound
+	}
+	return "07-tendermint-0", &ibctm.ClientState{ChainId: chainID}, nil
+}
+
+func (m *mockIBCKeeper) GetClientState(_ sdk.Context, clientID string) (ibcexported.ClientState, bool) {
+	chainID, ok := m.chainIDs[clientID]
+	if !ok {
+		return nil, false
+	}
+	return &ibctm.ClientState{ChainId: chainID}, true
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(channelallowlist.AppModuleBasic{})
+
+	ibc := &mockIBCKeeper{chainIDs: map[string]string{
+		"channel-0":       "cosmoshub-4",
+		"channel-1":       "unknown-1",
+		"07-tendermint-5": "cosmoshub-4",
+	}}
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), ibc, ibc, authtypes.NewModuleAddress("gov").String())
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesis()))
+	return ctx, k
+}
+
+func TestCheckChannel(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	// disabled by default
+	require.NoError(t, k.CheckChannel(ctx, "transfer", "channel-1", "stake"))
+
+	require.NoError(t, k.Allowlist.Set(ctx, types.Params{
+		Enabled:  true,
+		Channels: []string{"channel-2"},
+		ChainIds: []string{"cosmoshub-4"},
+		DenomExceptions: []types.DenomException{
+			{Denom: "uusdc", Channels: []string{"channel-1"}},
+			{Denom: "uenoki"},
+		},
+	}))
+
+	require.NoError(t, k.CheckChannel(ctx, "transfer", "channel-2", "stake"))
+	require.NoError(t, k.CheckChannel(ctx, "transfer", "channel-0", "stake"))
+	require.ErrorIs(t, k.CheckChannel(ctx, "transfer", "channel-1", "stake"), types.ErrChannelNotAllowed)
+	require.ErrorIs(t, k.CheckChannel(ctx, "transfer", "channel-9", "stake"), types.ErrChannelNotAllowed)
+
+	// denom exceptions
+	require.NoError(t, k.CheckChannel(ctx, "transfer", "channel-1", "uusdc"))
+	require.ErrorIs(t, k.CheckChannel(ctx, "transfer", "channel-9", "uusdc"), types.ErrChannelNotAllowed)
+	require.NoError(t, k.CheckChannel(ctx, "transfer", "channel-9", "uenoki"))
+
+	// IBC v2 clients
+	require.NoError(t, k.CheckClient(ctx, "07-tendermint-5", "stake"))
+	require.ErrorIs(t, k.CheckClient(ctx, "07-tendermint-6", "stake"), types.ErrChannelNotAllowed)
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress("gov").String()
+	params := types.Params{Enabled: true, Channels: []string{"channel-0"}}
+
+	_, err := srv.UpdateParams(ctx, types.NewMsgUpdateParams(authtypes.NewModuleAddress("other").String(), params))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	invalid := types.Params{Enabled: true, Channels: []string{"channel-0", "channel-0"}}
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(authority, invalid))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+
+	genState, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, genState.Params)
+}
@@ -0,0 +1,13 @@
+package types
+
+// channelallowlist module event types and attribute keys
+const (
+	EventTypeTransferRejected = "transfer_channel_rejected"
+	EventTypeUpdateParams     = "channel_allowlist_updated"
+
+	AttributeKeyChannel = "channel"
+	AttributeKeyChainID = "chain_id"
+	AttributeKeyDenom   = "denom"
+	AttributeKeyError   = "error"
+	AttributeKeyEnabled = "enabled"
+)
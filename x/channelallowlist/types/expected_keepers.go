@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// ChannelKeeper defines the channel methods used to resolve the counterparty
+// chain of an IBC v1 channel.
+type ChannelKeeper interface {
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+}
+
+// ClientKeeper defines the client methods used to resolve the counterparty
+// chain of an IBC v2 client.
+type ClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (ibcexported.ClientState, bool)
+}
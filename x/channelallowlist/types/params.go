@@ -0,0 +1,108 @@
+package types
+
+import (
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+)
+
+// DefaultParams returns the default parameters. The allowlist is disabled so
+// existing channels keep working until governance opts in.
+func DefaultParams() Params {
+	return Params{
+		Enabled:         false,
+		Channels:        []string{},
+		ChainIds:        []string{},
+		DenomExceptions: []DenomException{},
+	}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	if err := validateChannels(p.Channels); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(p.ChainIds))
+	for _, chainID := range p.ChainIds {
+		if chainID == "" || strings.TrimSpace(chainID) != chainID {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid chain id %q", chainID)
+		}
+		if _, ok := seen[chainID]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate chain id %s", chainID)
+		}
+		seen[chainID] = struct{}{}
+	}
+
+	denoms := make(map[string]struct{}, len(p.DenomExceptions))
+	for _, e := range p.DenomExceptions {
+		if err := sdk.ValidateDenom(e.Denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "denom exception: %s", err)
+		}
+		if _, ok := denoms[e.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate denom exception for %s", e.Denom)
+		}
+		denoms[e.Denom] = struct{}{}
+		if err := validateChannels(e.Channels); err != nil {
+			return errorsmod.Wrapf(err, "denom exception for %s", e.Denom)
+		}
+	}
+
+	return nil
+}
+
+// Allows returns whether denom may be transferred over channelID. chainID is
+// only called when the channel itself is not allowlisted.
+func (p Params) Allows(channelID, denom string, chainID func() string) error {
+	if !p.Enabled || slices.Contains(p.Channels, channelID) {
+		return nil
+	}
+
+	for _, e := range p.DenomExceptions {
+		if e.Denom == denom && (len(e.Channels) == 0 || slices.Contains(e.Channels, channelID)) {
+			return nil
+		}
+	}
+
+	counterparty := ""
+	if len(p.ChainIds) > 0 {
+		counterparty = chainID()
+		if counterparty != "" && slices.Contains(p.ChainIds, counterparty) {
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(ErrChannelNotAllowed, "%s over %s (counterparty chain %q)", denom, channelID, counterparty)
+}
+
+// ClientChainID returns the chain id tracked by a light client or an empty
+// string for client types without a chain id.
+func ClientChainID(clientState ibcexported.ClientState) string {
+	if cs, ok := clientState.(*ibctm.ClientState); ok {
+		return cs.ChainId
+	}
+	return ""
+}
+
+// validateChannels checks that every entry is a unique channel or client
+// identifier.
+func validateChannels(channels []string) error {
+	seen := make(map[string]struct{}, len(channels))
+	for _, id := range channels {
+		if host.ChannelIdentifierValidator(id) != nil && host.ClientIdentifierValidator(id) != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "%q is neither a channel nor a client identifier", id)
+		}
+		if _, ok := seen[id]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate channel %s", id)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyphacoop/cosmos-enoki/x/channelallowlist/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		valid  bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"channels and clients", types.Params{Channels: []string{"channel-0", "07-tendermint-1"}}, true},
+		{"invalid channel", types.Params{Channels: []string{"not a channel"}}, false},
+		{"duplicate channel", types.Params{Channels: []string{"channel-0", "channel-0"}}, false},
+		{"blank chain id", types.Params{ChainIds: []string{" "}}, false},
+		{"duplicate chain id", types.Params{ChainIds: []string{"cosmoshub-4", "cosmoshub-4"}}, false},
+		{"ibc denom exception", types.Params{DenomExceptions: []types.DenomException{{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}}}, true},
+		{"invalid denom exception", types.Params{DenomExceptions: []types.DenomException{{Denom: "1"}}}, false},
+		{"duplicate denom exception", types.Params{DenomExceptions: []types.DenomException{{Denom: "stake"}, {Denom: "stake"}}}, false},
+		{"invalid exception channel", types.Params{DenomExceptions: []types.DenomException{{Denom: "stake", Channels: []string{"?"}}}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	"github.com/hyphacoop/cosmos-enoki/x/channelallowlist/keeper"
+	denommetadatatypes "github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+var (
+	_ api.IBCModule             = IBCMiddleware{}
+	_ api.PacketDataUnmarshaler = IBCMiddleware{}
+)
+
+// IBCMiddleware enforces the transfer channel allowlist on the IBC v2
+// transfer application it wraps. IBC v2 packets are identified by the client
+// on this chain.
+type IBCMiddleware struct {
+	app    api.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer stack.
+func NewIBCMiddleware(app api.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. Transfers over clients
+// outside the allowlist fail the send.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+
+	if err := im.keeper.CheckClient(ctx, sourceClient, data.Token.Denom.IBCDenom()); err != nil {
+		return err
+	}
+
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket implements the IBCModule interface. Transfers over clients
+// outside the allowlist fail without reaching the wrapped app.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		// leave malformed packets to the transfer app
+		return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	}
+
+	denom := denommetadatatypes.ReceivedDenom(data.Token.Denom, payload.SourcePort, sourceClient, payload.DestinationPort, destinationClient).IBCDenom()
+	if err := im.keeper.CheckClient(ctx, destinationClient, denom); err != nil {
+		im.keeper.EmitTransferRejected(ctx, destinationClient, denom, err)
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+
+	return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface by
+// delegating to the wrapped app.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return im.app.(api.PacketDataUnmarshaler).UnmarshalPacketData(payload)
+}
@@ -0,0 +1,48 @@
+package denommetadata
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SymbolOverrides",
+					Use:       "symbol-overrides",
+					Short:     "Query the governance set symbols of IBC vouchers",
+				},
+				{
+					RpcMethod: "DisplayUnitOverrides",
+					Use:       "display-unit-overrides",
+					Short:     "Query the governance set display units of IBC vouchers",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SetSymbol",
+					Use:            "set-symbol [denom] [symbol]",
+					Short:          "Submit a proposal to set the symbol of an IBC voucher, an empty symbol removes the override",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "symbol"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "SetDisplayUnit",
+					Use:            "set-display-unit [denom] [display] [exponent]",
+					Short:          "Submit a proposal to set the display unit of an IBC voucher, an empty display removes the override",
+					Example:        `set-display-unit ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 atom 6`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "display"}, {ProtoField: "exponent"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, o := range genState.SymbolOverrides {
+		if err := k.Symbols.Set(ctx, o.Denom, o.Symbol); err != nil {
+			return err
+		}
+	}
+	for _, o := range genState.DisplayUnitOverrides {
+		if err := k.DisplayUnits.Set(ctx, o.Denom, o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	genState := types.DefaultGenesis()
+
+	err := k.Symbols.Walk(ctx, nil, func(denom, symbol string) (bool, error) {
+		genState.SymbolOverrides = append(genState.SymbolOverrides, types.SymbolOverride{Denom: denom, Symbol: symbol})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.DisplayUnits.Walk(ctx, nil, func(_ string, o types.DisplayUnitOverride) (bool, error) {
+		genState.DisplayUnitOverrides = append(genState.DisplayUnitOverrides, o)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genState, nil
+}
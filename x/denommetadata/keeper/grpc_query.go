@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// SymbolOverrides returns all governance set symbols.
+func (k Keeper) SymbolOverrides(ctx context.Context, req *types.QuerySymbolOverridesRequest) (*types.QuerySymbolOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	overrides, pageRes, err := query.CollectionPaginate(ctx, k.Symbols, req.Pagination,
+		func(denom, symbol string) (types.SymbolOverride, error) {
+			return types.SymbolOverride{Denom: denom, Symbol: symbol}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySymbolOverridesResponse{SymbolOverrides: overrides, Pagination: pageRes}, nil
+}
+
+// DisplayUnitOverrides returns all governance set display units.
+func (k Keeper) DisplayUnitOverrides(ctx context.Context, req *types.QueryDisplayUnitOverridesRequest) (*types.QueryDisplayUnitOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	overrides, pageRes, err := query.CollectionPaginate(ctx, k.DisplayUnits, req.Pagination,
+		func(_ string, o types.DisplayUnitOverride) (types.DisplayUnitOverride, error) {
+			return o, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDisplayUnitOverridesResponse{DisplayUnitOverrides: overrides, Pagination: pageRes}, nil
+}
@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+// Keeper registers bank metadata for IBC vouchers.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	bankKeeper     types.BankKeeper
+	transferKeeper types.TransferKeeper
+
+	// the address capable of executing the MsgSetSymbol and
+	// MsgSetDisplayUnit messages. Typically, this should be the x/gov module
+	// account.
+	authority string
+
+	Schema       collections.Schema
+	Symbols      collections.Map[string, string]
+	DisplayUnits collections.Map[string, types.DisplayUnitOverride]
+}
+
+// NewKeeper returns a new denommetadata keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:            cdc,
+		storeService:   storeService,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		authority:      authority,
+
+		Symbols:      collections.NewMap(sb, types.SymbolOverridesKey, "symbol_overrides", collections.StringKey, collections.StringValue),
+		DisplayUnits: collections.NewMap(sb, types.DisplayUnitOverridesKey, "display_unit_overrides", collections.StringKey, codec.CollValue[types.DisplayUnitOverride](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// RegisterVoucher writes bank metadata for the voucher of denom unless the
+// voucher already has metadata other than the transfer module placeholder.
+func (k Keeper) RegisterVoucher(ctx sdk.Context, denom transfertypes.Denom) error {
+	if denom.IsNative() {
+		return nil
+	}
+
+	voucher := denom.IBCDenom()
+	if md, found := k.bankKeeper.GetDenomMetaData(ctx, voucher); found && !types.IsTransferDefaultMetadata(md, denom) {
+		return nil
+	}
+
+	md := types.NewVoucherMetadata(denom)
+	symbol, err := k.Symbols.Get(ctx, voucher)
+	if err == nil {
+		md.Symbol = symbol
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	unit, err := k.DisplayUnits.Get(ctx, voucher)
+	if err == nil {
+		types.SetDisplayUnit(&md, unit.Display, unit.Exponent)
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := md.Validate(); err != nil {
+		return fmt.Errorf("invalid metadata for %s: %w", denom.Path(), err)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, md)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterMetadata,
+		sdk.NewAttribute(types.AttributeKeyDenom, voucher),
+		sdk.NewAttribute(types.AttributeKeyPath, denom.Path()),
+		sdk.NewAttribute(types.AttributeKeySymbol, md.Symbol),
+	))
+
+	return nil
+}
+
+// setSymbol stores or removes the symbol override of voucher and applies it
+// to the bank metadata.
+func (k Keeper) setSymbol(ctx sdk.Context, voucher, symbol string) error {
+	if symbol == "" {
+		if err := k.Symbols.Remove(ctx, voucher); err != nil {
+			return err
+		}
+	} else if err := k.Symbols.Set(ctx, voucher, symbol); err != nil {
+		return err
+	}
+
+	denom, hasTrace, err := k.voucherDenom(ctx, voucher)
+	if err != nil {
+		return err
+	}
+
+	md, found := k.bankKeeper.GetDenomMetaData(ctx, voucher)
+	switch {
+	case hasTrace && (!found || types.IsTransferDefaultMetadata(md, denom)):
+		return k.RegisterVoucher(ctx, denom)
+	case !found:
+		// the override is applied on the first receive
+		return nil
+	case symbol != "":
+		md.Symbol = symbol
+	case hasTrace:
+		md.Symbol = types.NewVoucherMetadata(denom).Symbol
+	default:
+		return nil
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, md)
+	return nil
+}
+
+// setDisplayUnit stores or removes the display unit override of voucher and
+// applies it to the bank metadata.
+func (k Keeper) setDisplayUnit(ctx sdk.Context, voucher, display string, exponent uint32) error {
+	if display == "" {
+		if err := k.DisplayUnits.Remove(ctx, voucher); err != nil {
+			return err
+		}
+	} else if err := k.DisplayUnits.Set(ctx, voucher, types.DisplayUnitOverride{Denom: voucher, Display: display, Exponent: exponent}); err != nil {
+		return err
+	}
+
+	denom, hasTrace, err := k.voucherDenom(ctx, voucher)
+	if err != nil {
+		return err
+	}
+
+	md, found := k.bankKeeper.GetDenomMetaData(ctx, voucher)
+	switch {
+	case hasTrace && (!found || types.IsTransferDefaultMetadata(md, denom)):
+		return k.RegisterVoucher(ctx, denom)
+	case !found:
+		// the override is applied on the first receive
+		return nil
+	}
+
+	types.SetDisplayUnit(&md, display, exponent)
+	if err := md.Validate(); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidDisplayUnit, "invalid metadata for %s: %s", voucher, err)
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, md)
+	return nil
+}
+
+// voucherDenom returns the denom trace of voucher, if the transfer module
+// knows it.
+func (k Keeper) voucherDenom(ctx sdk.Context, voucher string) (transfertypes.Denom, bool, error) {
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(voucher, transfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return transfertypes.Denom{}, false, err
+	}
+	denom, found := k.transferKeeper.GetDenom(ctx, hash)
+	return denom, found, nil
+}
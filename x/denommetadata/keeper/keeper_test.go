@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata"
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+type mockBankKeeper struct {
+	metadata map[string]banktypes.Metadata
+}
+
+func (m *mockBankKeeper) GetDenomMetaData(_ context.Context, denom string) (banktypes.Metadata, bool) {
+	md, ok := m.metadata[denom]
+	return md, ok
+}
+
+func (m *mockBankKeeper) SetDenomMetaData(_ context.Context, md banktypes.Metadata) {
+	m.metadata[md.Base] = md
+}
+
+type mockTransferKeeper struct {
+	denoms map[string]transfertypes.Denom
+}
+
+func (m *mockTransferKeeper) GetDenom(_ sdk.Context, hash cmtbytes.HexBytes) (transfertypes.Denom, bool) {
+	d, ok := m.denoms[hash.String()]
+	return d, ok
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *mockBankKeeper, *mockTransferKeeper) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(denommetadata.AppModuleBasic{})
+
+	bk := &mockBankKeeper{metadata: map[string]banktypes.Metadata{}}
+	tk := &mockTransferKeeper{denoms: map[string]transfertypes.Denom{}}
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), bk, tk, authtypes.NewModuleAddress("gov").String())
+	return ctx, k, bk, tk
+}
+
+// transferDefaultMetadata mirrors the metadata written by the transfer keeper.
+func transferDefaultMetadata(denom transfertypes.Denom) banktypes.Metadata {
+	return banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom.Base, Exponent: 0}},
+		Base:       denom.IBCDenom(),
+		Display:    denom.Path(),
+		Name:       denom.Path() + " IBC token",
+		Symbol:     "UATOM",
+	}
+}
+
+func TestRegisterVoucher(t *testing.T) {
+	ctx, k, bk, _ := setupKeeper(t)
+	atom := transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-0"))
+
+	require.NoError(t, k.RegisterVoucher(ctx, atom))
+	md, found := bk.GetDenomMetaData(ctx, atom.IBCDenom())
+	require.True(t, found)
+	require.NoError(t, md.Validate())
+	require.Equal(t, atom.IBCDenom(), md.Display)
+	require.Equal(t, "UATOM", md.Symbol)
+	require.Equal(t, []string{"uatom"}, md.DenomUnits[0].Aliases)
+	require.Len(t, md.DenomUnits, 1)
+
+	// native denoms are left alone
+	require.NoError(t, k.RegisterVoucher(ctx, transfertypes.NewDenom("stake")))
+	require.Len(t, bk.metadata, 1)
+
+	// the transfer module placeholder is replaced
+	osmo := transfertypes.NewDenom("uosmo", transfertypes.NewHop("transfer", "channel-1"))
+	bk.SetDenomMetaData(ctx, transferDefaultMetadata(osmo))
+	require.NoError(t, k.RegisterVoucher(ctx, osmo))
+	md, _ = bk.GetDenomMetaData(ctx, osmo.IBCDenom())
+	require.Equal(t, types.NewVoucherMetadata(osmo), md)
+
+	// other metadata is kept
+	custom := banktypes.Metadata{Base: osmo.IBCDenom(), Display: "custom", Symbol: "CUSTOM"}
+	bk.SetDenomMetaData(ctx, custom)
+	require.NoError(t, k.RegisterVoucher(ctx, osmo))
+	md, _ = bk.GetDenomMetaData(ctx, osmo.IBCDenom())
+	require.Equal(t, custom, md)
+
+	// the last path segment of the base denom gives the symbol
+	factory := transfertypes.NewDenom("factory/cosmos1abc/mytoken", transfertypes.NewHop("transfer", "channel-2"))
+	require.NoError(t, k.RegisterVoucher(ctx, factory))
+	md, _ = bk.GetDenomMetaData(ctx, factory.IBCDenom())
+	require.NoError(t, md.Validate())
+	require.Equal(t, factory.IBCDenom(), md.Display)
+	require.Equal(t, "MYTOKEN", md.Symbol)
+	require.Len(t, md.DenomUnits, 1)
+}
+
+func TestSetSymbol(t *testing.T) {
+	ctx, k, bk, tk := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	atom := transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-0"))
+	voucher := atom.IBCDenom()
+
+	_, err := srv.SetSymbol(ctx, types.NewMsgSetSymbol(authtypes.NewModuleAddress("other").String(), voucher, "ATOM.hub"))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = srv.SetSymbol(ctx, types.NewMsgSetSymbol(k.GetAuthority(), "uatom", "ATOM"))
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	// an override set before the first receive is used on registration
+	_, err = srv.SetSymbol(ctx, types.NewMsgSetSymbol(k.GetAuthority(), voucher, "ATOM.hub"))
+	require.NoError(t, err)
+	require.Empty(t, bk.metadata)
+	require.NoError(t, k.RegisterVoucher(ctx, atom))
+	md, _ := bk.GetDenomMetaData(ctx, voucher)
+	require.Equal(t, "ATOM.hub", md.Symbol)
+
+	// existing metadata is updated
+	tk.denoms[atom.Hash().String()] = atom
+	_, err = srv.SetSymbol(ctx, types.NewMsgSetSymbol(k.GetAuthority(), voucher, "hATOM"))
+	require.NoError(t, err)
+	md, _ = bk.GetDenomMetaData(ctx, voucher)
+	require.Equal(t, "hATOM", md.Symbol)
+
+	// clearing the override restores the generated symbol
+	_, err = srv.SetSymbol(ctx, types.NewMsgSetSymbol(k.GetAuthority(), voucher, ""))
+	require.NoError(t, err)
+	md, _ = bk.GetDenomMetaData(ctx, voucher)
+	require.Equal(t, "UATOM", md.Symbol)
+
+	res, err := k.SymbolOverrides(ctx, &types.QuerySymbolOverridesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.SymbolOverrides)
+}
+
+func TestSetDisplayUnit(t *testing.T) {
+	ctx, k, bk, tk := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	atom := transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-0"))
+	voucher := atom.IBCDenom()
+
+	_, err := srv.SetDisplayUnit(ctx, types.NewMsgSetDisplayUnit(authtypes.NewModuleAddress("other").String(), voucher, "atom", 6))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	_, err = srv.SetDisplayUnit(ctx, types.NewMsgSetDisplayUnit(k.GetAuthority(), voucher, "atom", 0))
+	require.ErrorIs(t, err, types.ErrInvalidDisplayUnit)
+	_, err = srv.SetDisplayUnit(ctx, types.NewMsgSetDisplayUnit(k.GetAuthority(), voucher, voucher, 6))
+	require.ErrorIs(t, err, types.ErrInvalidDisplayUnit)
+	_, err = srv.SetDisplayUnit(ctx, types.NewMsgSetDisplayUnit(k.GetAuthority(), voucher, "", 6))
+	require.ErrorIs(t, err, types.ErrInvalidDisplayUnit)
+
+	// an override set before the first receive is used on registration
+	_, err = srv.SetDisplayUnit(ctx, types.NewMsgSetDisplayUnit(k.GetAuthority(), voucher, "atom", 6))
+	require.NoError(t, err)
+	require.Empty(t, bk.metadata)
+	require.NoError(t, k.RegisterVoucher(ctx, atom))
+	md, _ := bk.GetDenomMetaData(ctx, voucher)
+	require.NoError(t, md.Validate())
+	require.Equal(t, "atom", md.Display)
+	require.Equal(t, []*banktypes.DenomUnit{
+		{Denom: voucher, Exponent: 0, Aliases: []string{"uatom"}},
+		{Denom: "atom", Exponent: 6},
+	}, md.DenomUnits)
+
+	// existing metadata is updated
+	tk.denoms[atom.Hash().String()] = atom
+	_, err = srv.SetDisplayUnit(ctx, types.NewMsgSetDisplayUnit(k.GetAuthority(), voucher, "matom", 3))
+	require.NoError(t, err)
+	md, _ = bk.GetDenomMetaData(ctx, voucher)
+	require.Equal(t, "matom", md.Display)
+	require.Len(t, md.DenomUnits, 2)
+	require.Equal(t, uint32(3), md.DenomUnits[1].Exponent)
+
+	res, err := k.DisplayUnitOverrides(ctx, &types.QueryDisplayUnitOverridesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DisplayUnitOverride{{Denom: voucher, Display: "matom", Exponent: 3}}, res.DisplayUnitOverrides)
+
+	// clearing the override displays the voucher in its base denom again
+	_, err = srv.SetDisplayUnit(ctx, types.NewMsgSetDisplayUnit(k.GetAuthority(), voucher, "", 0))
+	require.NoError(t, err)
+	md, _ = bk.GetDenomMetaData(ctx, voucher)
+	require.Equal(t, types.NewVoucherMetadata(atom), md)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	ctx, k, _, _ := setupKeeper(t)
+	voucher := transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-0")).IBCDenom()
+
+	genState := &types.GenesisState{
+		SymbolOverrides:      []types.SymbolOverride{{Denom: voucher, Symbol: "ATOM"}},
+		DisplayUnitOverrides: []types.DisplayUnitOverride{{Denom: voucher, Display: "atom", Exponent: 6}},
+	}
+	require.NoError(t, genState.Validate())
+	require.NoError(t, k.InitGenesis(ctx, *genState))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genState, exported)
+}
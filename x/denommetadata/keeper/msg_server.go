@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// SetSymbol sets or clears the symbol override of an IBC voucher.
+func (k msgServer) SetSymbol(goCtx context.Context, msg *types.MsgSetSymbol) (*types.MsgSetSymbolResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.setSymbol(ctx, msg.Denom, msg.Symbol); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetSymbol,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol),
+	))
+
+	return &types.MsgSetSymbolResponse{}, nil
+}
+
+// SetDisplayUnit sets or clears the display unit override of an IBC voucher.
+func (k msgServer) SetDisplayUnit(goCtx context.Context, msg *types.MsgSetDisplayUnit) (*types.MsgSetDisplayUnitResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.setDisplayUnit(ctx, msg.Denom, msg.Display, msg.Exponent); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetDisplayUnit,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyDisplay, msg.Display),
+		sdk.NewAttribute(types.AttributeKeyExponent, strconv.FormatUint(uint64(msg.Exponent), 10)),
+	))
+
+	return &types.MsgSetDisplayUnitResponse{}, nil
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidAuthority   = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrInvalidDenom       = errorsmod.Register(ModuleName, 3, "invalid ibc denom")
+	ErrInvalidSymbol      = errorsmod.Register(ModuleName, 4, "invalid symbol")
+	ErrInvalidDisplayUnit = errorsmod.Register(ModuleName, 5, "invalid display unit")
+)
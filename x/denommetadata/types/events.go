@@ -0,0 +1,14 @@
+package types
+
+// denommetadata module event types and attribute keys
+const (
+	EventTypeRegisterMetadata = "denom_metadata_registered"
+	EventTypeSetSymbol        = "denom_symbol_set"
+	EventTypeSetDisplayUnit   = "denom_display_unit_set"
+
+	AttributeKeyDenom    = "denom"
+	AttributeKeyPath     = "path"
+	AttributeKeySymbol   = "symbol"
+	AttributeKeyDisplay  = "display"
+	AttributeKeyExponent = "exponent"
+)
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"context"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// BankKeeper defines the bank methods used by the module.
+type BankKeeper interface {
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
+}
+
+// TransferKeeper defines the transfer methods used by the module.
+type TransferKeeper interface {
+	GetDenom(ctx sdk.Context, denomHash cmtbytes.HexBytes) (transfertypes.Denom, bool)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default denommetadata genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		SymbolOverrides:      []SymbolOverride{},
+		DisplayUnitOverrides: []DisplayUnitOverride{},
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.SymbolOverrides))
+	for _, o := range gs.SymbolOverrides {
+		if err := ValidateVoucherDenom(o.Denom); err != nil {
+			return err
+		}
+		if err := ValidateSymbol(o.Symbol); err != nil {
+			return err
+		}
+		if _, ok := seen[o.Denom]; ok {
+			return fmt.Errorf("duplicate symbol override for %s", o.Denom)
+		}
+		seen[o.Denom] = struct{}{}
+	}
+
+	seen = make(map[string]struct{}, len(gs.DisplayUnitOverrides))
+	for _, o := range gs.DisplayUnitOverrides {
+		if err := ValidateVoucherDenom(o.Denom); err != nil {
+			return err
+		}
+		if err := ValidateDisplayUnit(o.Denom, o.Display, o.Exponent); err != nil {
+			return err
+		}
+		if _, ok := seen[o.Denom]; ok {
+			return fmt.Errorf("duplicate display unit override for %s", o.Denom)
+		}
+		seen[o.Denom] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "denommetadata"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// SymbolOverridesKey indexes governance set symbols by denom.
+	SymbolOverridesKey = collections.NewPrefix(0)
+	// DisplayUnitOverridesKey indexes governance set display units by denom.
+	DisplayUnitOverridesKey = collections.NewPrefix(1)
+)
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// NewVoucherMetadata returns bank metadata for the voucher of denom. The
+// base denom comes from the counterparty chain and cannot be trusted to
+// follow a unit convention, so the voucher is displayed in its base denom
+// until governance sets a display unit.
+func NewVoucherMetadata(denom transfertypes.Denom) banktypes.Metadata {
+	voucher := denom.IBCDenom()
+	name := denom.Base[strings.LastIndex(denom.Base, "/")+1:]
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", denom.Path()),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: voucher, Exponent: 0, Aliases: []string{denom.Base}},
+		},
+		Base:    voucher,
+		Display: voucher,
+		Name:    fmt.Sprintf("%s IBC token", denom.Path()),
+		Symbol:  strings.ToUpper(name),
+	}
+}
+
+// SetDisplayUnit replaces the display unit of md with display, worth
+// 10^exponent base units. An empty display shows the base denom.
+func SetDisplayUnit(md *banktypes.Metadata, display string, exponent uint32) {
+	if len(md.DenomUnits) > 1 {
+		md.DenomUnits = md.DenomUnits[:1]
+	}
+	md.Display = md.Base
+	if display == "" {
+		return
+	}
+	md.DenomUnits = append(md.DenomUnits, &banktypes.DenomUnit{Denom: display, Exponent: exponent})
+	md.Display = display
+}
+
+// IsTransferDefaultMetadata reports whether md is the placeholder metadata
+// written by the transfer module on the first receive of denom.
+func IsTransferDefaultMetadata(md banktypes.Metadata, denom transfertypes.Denom) bool {
+	return md.Display == denom.Path() &&
+		len(md.DenomUnits) == 1 &&
+		md.DenomUnits[0].Denom == denom.Base
+}
+
+// ReceivedDenom returns the denom credited on this chain when a token with
+// the given denom is received on destPort/destChannel from
+// sourcePort/sourceChannel. It mirrors the trace handling of the transfer
+// keeper.
+func ReceivedDenom(denom transfertypes.Denom, sourcePort, sourceChannel, destPort, destChannel string) transfertypes.Denom {
+	if denom.HasPrefix(sourcePort, sourceChannel) {
+		// the token is returning, remove the hop added by the sender chain
+		return transfertypes.NewDenom(denom.Base, denom.Trace[1:]...)
+	}
+
+	trace := make([]transfertypes.Hop, 0, len(denom.Trace)+1)
+	trace = append(trace, transfertypes.NewHop(destPort, destChannel))
+	trace = append(trace, denom.Trace...)
+	return transfertypes.NewDenom(denom.Base, trace...)
+}
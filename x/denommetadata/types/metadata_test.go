@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+func TestReceivedDenom(t *testing.T) {
+	hop := transfertypes.NewHop("transfer", "channel-5")
+
+	// a native token of the sender chain gets the receiving hop
+	denom := types.ReceivedDenom(transfertypes.NewDenom("uatom"), "transfer", "channel-9", "transfer", "channel-0")
+	require.Equal(t, "transfer/channel-0/uatom", denom.Path())
+
+	// a token returning through the channel it left on loses a hop
+	denom = types.ReceivedDenom(transfertypes.NewDenom("uenoki", hop), "transfer", "channel-5", "transfer", "channel-0")
+	require.True(t, denom.IsNative())
+
+	// multi hop tokens keep their trace
+	denom = types.ReceivedDenom(transfertypes.NewDenom("uosmo", hop), "transfer", "channel-9", "transfer", "channel-0")
+	require.Equal(t, "transfer/channel-0/transfer/channel-5/uosmo", denom.Path())
+
+	// the input denom is not modified
+	in := transfertypes.NewDenom("uosmo", hop, transfertypes.NewHop("transfer", "channel-6"))
+	types.ReceivedDenom(in, "transfer", "channel-5", "transfer", "channel-0")
+	require.Equal(t, "transfer/channel-5/transfer/channel-6/uosmo", in.Path())
+}
+
+func TestNewVoucherMetadata(t *testing.T) {
+	for _, tc := range []struct {
+		base   string
+		symbol string
+	}{
+		{"uatom", "UATOM"},
+		{"aevmos", "AEVMOS"},
+		{"gamm/pool/1", "1"},
+	} {
+		t.Run(tc.base, func(t *testing.T) {
+			// the base denom is not trusted to give the display unit
+			denom := transfertypes.NewDenom(tc.base, transfertypes.NewHop("transfer", "channel-0"))
+			md := types.NewVoucherMetadata(denom)
+			require.NoError(t, md.Validate())
+			require.Equal(t, tc.symbol, md.Symbol)
+			require.Equal(t, denom.IBCDenom(), md.Display)
+			require.Len(t, md.DenomUnits, 1)
+			require.False(t, types.IsTransferDefaultMetadata(md, denom))
+		})
+	}
+}
+
+func TestSetDisplayUnit(t *testing.T) {
+	denom := transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-0"))
+	md := types.NewVoucherMetadata(denom)
+
+	types.SetDisplayUnit(&md, "atom", 6)
+	require.NoError(t, md.Validate())
+	require.Equal(t, "atom", md.Display)
+	require.Len(t, md.DenomUnits, 2)
+
+	// the display unit is replaced, not added
+	types.SetDisplayUnit(&md, "matom", 3)
+	require.NoError(t, md.Validate())
+	require.Equal(t, "matom", md.Display)
+	require.Len(t, md.DenomUnits, 2)
+
+	types.SetDisplayUnit(&md, "", 0)
+	require.Equal(t, types.NewVoucherMetadata(denom), md)
+}
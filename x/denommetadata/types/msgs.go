@@ -0,0 +1,113 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// MaxSymbolLength bounds the length of a symbol override.
+const MaxSymbolLength = 32
+
+var (
+	_ sdk.Msg = &MsgSetSymbol{}
+	_ sdk.Msg = &MsgSetDisplayUnit{}
+)
+
+// NewMsgSetSymbol creates a new MsgSetSymbol instance.
+func NewMsgSetSymbol(authority, denom, symbol string) *MsgSetSymbol {
+	return &MsgSetSymbol{
+		Authority: authority,
+		Denom:     denom,
+		Symbol:    symbol,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgSetSymbol.
+func (msg *MsgSetSymbol) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := ValidateVoucherDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.Symbol != "" {
+		return ValidateSymbol(msg.Symbol)
+	}
+	return nil
+}
+
+// NewMsgSetDisplayUnit creates a new MsgSetDisplayUnit instance.
+func NewMsgSetDisplayUnit(authority, denom, display string, exponent uint32) *MsgSetDisplayUnit {
+	return &MsgSetDisplayUnit{
+		Authority: authority,
+		Denom:     denom,
+		Display:   display,
+		Exponent:  exponent,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgSetDisplayUnit.
+func (msg *MsgSetDisplayUnit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := ValidateVoucherDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.Display != "" {
+		return ValidateDisplayUnit(msg.Denom, msg.Display, msg.Exponent)
+	}
+	if msg.Exponent != 0 {
+		return errorsmod.Wrap(ErrInvalidDisplayUnit, "exponent set without a display unit")
+	}
+	return nil
+}
+
+// ValidateVoucherDenom checks that denom has the ibc/{hash} format.
+func ValidateVoucherDenom(denom string) error {
+	hash, found := strings.CutPrefix(denom, transfertypes.DenomPrefix+"/")
+	if !found {
+		return errorsmod.Wrapf(ErrInvalidDenom, "%s does not start with %s/", denom, transfertypes.DenomPrefix)
+	}
+	if _, err := transfertypes.ParseHexHash(hash); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "%s: %s", denom, err)
+	}
+	return nil
+}
+
+// ValidateSymbol checks that symbol is non blank, printable and not too long.
+func ValidateSymbol(symbol string) error {
+	if strings.TrimSpace(symbol) != symbol || symbol == "" {
+		return errorsmod.Wrapf(ErrInvalidSymbol, "symbol %q must be non blank without surrounding spaces", symbol)
+	}
+	if len(symbol) > MaxSymbolLength {
+		return errorsmod.Wrapf(ErrInvalidSymbol, "symbol %q is longer than %d bytes", symbol, MaxSymbolLength)
+	}
+	for _, r := range symbol {
+		if r < 0x20 || r == 0x7f {
+			return errorsmod.Wrapf(ErrInvalidSymbol, "symbol %q contains control characters", symbol)
+		}
+	}
+	return nil
+}
+
+// ValidateDisplayUnit checks that display is a valid denom other than the
+// voucher denom and that it is worth more than one base unit.
+func ValidateDisplayUnit(voucher, display string, exponent uint32) error {
+	if err := sdk.ValidateDenom(display); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDisplayUnit, "%s", err)
+	}
+	if display == voucher {
+		return errorsmod.Wrapf(ErrInvalidDisplayUnit, "display unit %s is the base denom", display)
+	}
+	if exponent == 0 {
+		return errorsmod.Wrapf(ErrInvalidDisplayUnit, "display unit %s must have a positive exponent", display)
+	}
+	return nil
+}
@@ -0,0 +1,1147 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: enoki/denommetadata/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QuerySymbolOverridesRequest is the request type for the
+// Query/SymbolOverrides RPC method.
+type QuerySymbolOverridesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySymbolOverridesRequest) Reset()         { *m = QuerySymbolOverridesRequest{} }
+func (m *QuerySymbolOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbolOverridesRequest) ProtoMessage()    {}
+func (*QuerySymbolOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f98295d97482415e, []int{0}
+}
+func (m *QuerySymbolOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySymbolOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbolOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySymbolOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbolOverridesRequest.Merge(m, src)
+}
+func (m *QuerySymbolOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySymbolOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbolOverridesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySymbolOverridesRequest proto.InternalMessageInfo
+
+func (m *QuerySymbolOverridesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySymbolOverridesResponse is the response type for the
+// Query/SymbolOverrides RPC method.
+type QuerySymbolOverridesResponse struct {
+	SymbolOverrides []SymbolOverride `protobuf:"bytes,1,rep,name=symbol_overrides,json=symbolOverrides,proto3" json:"symbol_overrides"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySymbolOverridesResponse) Reset()         { *m = QuerySymbolOverridesResponse{} }
+func (m *QuerySymbolOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySymbolOverridesResponse) ProtoMessage()    {}
+func (*QuerySymbolOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f98295d97482415e, []int{1}
+}
+func (m *QuerySymbolOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySymbolOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySymbolOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySymbolOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySymbolOverridesResponse.Merge(m, src)
+}
+func (m *QuerySymbolOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySymbolOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySymbolOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySymbolOverridesResponse proto.InternalMessageInfo
+
+func (m *QuerySymbolOverridesResponse) GetSymbolOverrides() []SymbolOverride {
+	if m != nil {
+		return m.SymbolOverrides
+	}
+	return nil
+}
+
+func (m *QuerySymbolOverridesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDisplayUnitOverridesRequest is the request type for the
+// Query/DisplayUnitOverrides RPC method.
+type QueryDisplayUnitOverridesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDisplayUnitOverridesRequest) Reset()         { *m = QueryDisplayUnitOverridesRequest{} }
+func (m *QueryDisplayUnitOverridesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisplayUnitOverridesRequest) ProtoMessage()    {}
+func (*QueryDisplayUnitOverridesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f98295d97482415e, []int{2}
+}
+func (m *QueryDisplayUnitOverridesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisplayUnitOverridesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisplayUnitOverridesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisplayUnitOverridesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisplayUnitOverridesRequest.Merge(m, src)
+}
+func (m *QueryDisplayUnitOverridesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisplayUnitOverridesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisplayUnitOverridesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisplayUnitOverridesRequest proto.InternalMessageInfo
+
+func (m *QueryDisplayUnitOverridesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDisplayUnitOverridesResponse is the response type for the
+// Query/DisplayUnitOverrides RPC method.
+type QueryDisplayUnitOverridesResponse struct {
+	DisplayUnitOverrides []DisplayUnitOverride `protobuf:"bytes,1,rep,name=display_unit_overrides,json=displayUnitOverrides,proto3" json:"display_unit_overrides"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDisplayUnitOverridesResponse) Reset()         { *m = QueryDisplayUnitOverridesResponse{} }
+func (m *QueryDisplayUnitOverridesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisplayUnitOverridesResponse) ProtoMessage()    {}
+func (*QueryDisplayUnitOverridesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f98295d97482415e, []int{3}
+}
+func (m *QueryDisplayUnitOverridesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDisplayUnitOverridesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDisplayUnitOverridesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDisplayUnitOverridesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisplayUnitOverridesResponse.Merge(m, src)
+}
+func (m *QueryDisplayUnitOverridesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDisplayUnitOverridesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisplayUnitOverridesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDisplayUnitOverridesResponse proto.InternalMessageInfo
+
+func (m *QueryDisplayUnitOverridesResponse) GetDisplayUnitOverrides() []DisplayUnitOverride {
+	if m != nil {
+		return m.DisplayUnitOverrides
+	}
+	return nil
+}
+
+func (m *QueryDisplayUnitOverridesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QuerySymbolOverridesRequest)(nil), "enoki.denommetadata.v1.QuerySymbolOverridesRequest")
+	proto.RegisterType((*QuerySymbolOverridesResponse)(nil), "enoki.denommetadata.v1.QuerySymbolOverridesResponse")
+	proto.RegisterType((*QueryDisplayUnitOverridesRequest)(nil), "enoki.denommetadata.v1.QueryDisplayUnitOverridesRequest")
+	proto.RegisterType((*QueryDisplayUnitOverridesResponse)(nil), "enoki.denommetadata.v1.QueryDisplayUnitOverridesResponse")
+}
+
+func init() {
+	proto.RegisterFile("enoki/denommetadata/v1/query.proto", fileDescriptor_f98295d97482415e)
+}
+
+var fileDescriptor_f98295d97482415e = []byte{
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4f, 0x8b, 0x13, 0x31,
+	0x18, 0xc6, 0x9b, 0xfa, 0xe7, 0x10, 0x0f, 0x2b, 0xa1, 0x2c, 0x4b, 0x5d, 0xc6, 0xda, 0x83, 0x2e,
+	0x15, 0x93, 0xed, 0xae, 0xf8, 0xe7, 0xba, 0x88, 0x1e, 0x75, 0x2b, 0x22, 0x78, 0x59, 0x32, 0x9d,
+	0x90, 0x46, 0x3b, 0x79, 0xb3, 0x4d, 0x5a, 0x9c, 0xab, 0x9f, 0x40, 0xf0, 0x7b, 0xf8, 0x19, 0x04,
+	0x2f, 0x7b, 0x2c, 0x88, 0xe0, 0x49, 0xa4, 0xf5, 0x73, 0x88, 0x34, 0x19, 0xd1, 0xa9, 0x33, 0x23,
+	0x8a, 0x7b, 0x0b, 0x7d, 0xf3, 0xbe, 0xcf, 0xef, 0x79, 0xf2, 0x76, 0x70, 0x57, 0x68, 0x78, 0xa1,
+	0x58, 0x22, 0x34, 0xa4, 0xa9, 0x70, 0x3c, 0xe1, 0x8e, 0xb3, 0x59, 0x9f, 0x1d, 0x4f, 0xc5, 0x24,
+	0xa3, 0x66, 0x02, 0x0e, 0xc8, 0xa6, 0xbf, 0x43, 0x0b, 0x77, 0xe8, 0xac, 0xdf, 0xee, 0x0d, 0xc1,
+	0xa6, 0x60, 0x59, 0xcc, 0xad, 0x08, 0x0d, 0x6c, 0xd6, 0x8f, 0x85, 0xe3, 0x7d, 0x66, 0xb8, 0x54,
+	0x9a, 0x3b, 0x05, 0x3a, 0xcc, 0x68, 0xf7, 0x2a, 0x74, 0x8a, 0x43, 0xc3, 0xdd, 0x96, 0x04, 0x09,
+	0xfe, 0xc8, 0x56, 0xa7, 0xfc, 0xd7, 0x6d, 0x09, 0x20, 0xc7, 0x82, 0x71, 0xa3, 0x18, 0xd7, 0x1a,
+	0x9c, 0x1f, 0x6f, 0x43, 0xb5, 0x2b, 0xf0, 0xa5, 0xc3, 0x15, 0xc1, 0xe3, 0x2c, 0x8d, 0x61, 0xfc,
+	0x70, 0x26, 0x26, 0x13, 0x95, 0x08, 0x3b, 0x10, 0xc7, 0x53, 0x61, 0x1d, 0xb9, 0x8f, 0xf1, 0x4f,
+	0xa4, 0x2d, 0xd4, 0x41, 0x3b, 0x17, 0xf6, 0xae, 0xd2, 0xc0, 0x4f, 0x57, 0xfc, 0x34, 0x18, 0xce,
+	0xf9, 0xe9, 0x23, 0x2e, 0x45, 0xde, 0x3b, 0xf8, 0xa5, 0xb3, 0xfb, 0x0e, 0xe1, 0xed, 0x72, 0x1d,
+	0x6b, 0x40, 0x5b, 0x41, 0x9e, 0xe2, 0x8b, 0xd6, 0x97, 0x8e, 0xe0, 0x47, 0x6d, 0x0b, 0x75, 0xce,
+	0x78, 0xb9, 0xf2, 0x18, 0x69, 0x71, 0xd4, 0xc1, 0xd9, 0x93, 0xcf, 0x97, 0x1b, 0x83, 0x0d, 0x5b,
+	0x14, 0x20, 0x0f, 0x0a, 0x0e, 0x9a, 0xde, 0xc1, 0xb5, 0x3f, 0x3a, 0x08, 0x54, 0x05, 0x0b, 0xcf,
+	0x71, 0xc7, 0x3b, 0xb8, 0xa7, 0xac, 0x19, 0xf3, 0xec, 0x89, 0x56, 0xee, 0xd4, 0xe2, 0xfa, 0x88,
+	0xf0, 0x95, 0x1a, 0xb1, 0x3c, 0x33, 0x89, 0x37, 0x93, 0x50, 0x3f, 0x9a, 0x6a, 0xe5, 0x7e, 0x4b,
+	0xee, 0x7a, 0x55, 0x72, 0x25, 0x53, 0xf3, 0xf8, 0x5a, 0x49, 0x89, 0xe0, 0x7f, 0xcb, 0x70, 0xef,
+	0x5b, 0x13, 0x9f, 0xf3, 0xbe, 0xc8, 0x5b, 0x84, 0x37, 0xd6, 0x76, 0x81, 0xec, 0x57, 0xf1, 0xd6,
+	0x6c, 0x68, 0xfb, 0xe6, 0xdf, 0x35, 0x05, 0xa8, 0xee, 0xee, 0xab, 0x0f, 0x5f, 0xdf, 0x34, 0x7b,
+	0x64, 0x87, 0x55, 0xfc, 0xbf, 0xd6, 0x97, 0x91, 0xbc, 0x47, 0xb8, 0x55, 0xf6, 0x1a, 0xe4, 0x4e,
+	0x2d, 0x40, 0xcd, 0xb6, 0xb4, 0xef, 0xfe, 0x43, 0x67, 0xce, 0x7f, 0xcb, 0xf3, 0xef, 0x12, 0x5a,
+	0xc5, 0x5f, 0xbe, 0x18, 0x07, 0x87, 0x27, 0x8b, 0x08, 0xcd, 0x17, 0x11, 0xfa, 0xb2, 0x88, 0xd0,
+	0xeb, 0x65, 0xd4, 0x98, 0x2f, 0xa3, 0xc6, 0xa7, 0x65, 0xd4, 0x78, 0x76, 0x5b, 0x2a, 0x37, 0x9a,
+	0xc6, 0x74, 0x08, 0x29, 0x1b, 0x65, 0x66, 0xc4, 0x87, 0x00, 0x86, 0x85, 0x37, 0xbe, 0x11, 0x44,
+	0x5e, 0xae, 0xc9, 0xb8, 0xcc, 0x08, 0x1b, 0x9f, 0xf7, 0x1f, 0x92, 0xfd, 0xef, 0x03, 0x00, 0xfc,
+	0x6c, 0x88, 0x72, 0x12, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// SymbolOverrides returns all governance set symbols.
+	SymbolOverrides(ctx context.Context, in *QuerySymbolOverridesRequest, opts ...grpc.CallOption) (*QuerySymbolOverridesResponse, error)
+	// DisplayUnitOverrides returns all governance set display units.
+	DisplayUnitOverrides(ctx context.Context, in *QueryDisplayUnitOverridesRequest, opts ...grpc.CallOption) (*QueryDisplayUnitOverridesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) SymbolOverrides(ctx context.Context, in *QuerySymbolOverridesRequest, opts ...grpc.CallOption) (*QuerySymbolOverridesResponse, error) {
+	out := new(QuerySymbolOverridesResponse)
+	err := c.cc.Invoke(ctx, "/enoki.denommetadata.v1.Query/SymbolOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DisplayUnitOverrides(ctx context.Context, in *QueryDisplayUnitOverridesRequest, opts ...grpc.CallOption) (*QueryDisplayUnitOverridesResponse, error) {
+	out := new(QueryDisplayUnitOverridesResponse)
+	err := c.cc.Invoke(ctx, "/enoki.denommetadata.v1.Query/DisplayUnitOverrides", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// SymbolOverrides returns all governance set symbols.
+	SymbolOverrides(context.Context, *QuerySymbolOverridesRequest) (*QuerySymbolOverridesResponse, error)
+	// DisplayUnitOverrides returns all governance set display units.
+	DisplayUnitOverrides(context.Context, *QueryDisplayUnitOverridesRequest) (*QueryDisplayUnitOverridesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) SymbolOverrides(ctx context.Context, req *QuerySymbolOverridesRequest) (*QuerySymbolOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SymbolOverrides not implemented")
+}
+func (*UnimplementedQueryServer) DisplayUnitOverrides(ctx context.Context, req *QueryDisplayUnitOverridesRequest) (*QueryDisplayUnitOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisplayUnitOverrides not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_SymbolOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySymbolOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SymbolOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enoki.denommetadata.v1.Query/SymbolOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SymbolOverrides(ctx, req.(*QuerySymbolOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DisplayUnitOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisplayUnitOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DisplayUnitOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enoki.denommetadata.v1.Query/DisplayUnitOverrides",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DisplayUnitOverrides(ctx, req.(*QueryDisplayUnitOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "enoki.denommetadata.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SymbolOverrides",
+			Handler:    _Query_SymbolOverrides_Handler,
+		},
+		{
+			MethodName: "DisplayUnitOverrides",
+			Handler:    _Query_DisplayUnitOverrides_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "enoki/denommetadata/v1/query.proto",
+}
+
+func (m *QuerySymbolOverridesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbolOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbolOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySymbolOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySymbolOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySymbolOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SymbolOverrides) > 0 {
+		for iNdEx := len(m.SymbolOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SymbolOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisplayUnitOverridesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisplayUnitOverridesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisplayUnitOverridesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisplayUnitOverridesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisplayUnitOverridesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisplayUnitOverridesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DisplayUnitOverrides) > 0 {
+		for iNdEx := len(m.DisplayUnitOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisplayUnitOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySymbolOverridesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySymbolOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SymbolOverrides) > 0 {
+		for _, e := range m.SymbolOverrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisplayUnitOverridesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisplayUnitOverridesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DisplayUnitOverrides) > 0 {
+		for _, e := range m.DisplayUnitOverrides {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySymbolOverridesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySymbolOverridesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySymbolOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySymbolOverridesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySymbolOverridesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySymbolOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SymbolOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SymbolOverrides = append(m.SymbolOverrides, SymbolOverride{})
+			if err := m.SymbolOverrides[len(m.SymbolOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisplayUnitOverridesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisplayUnitOverridesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisplayUnitOverridesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisplayUnitOverridesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisplayUnitOverridesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisplayUnitOverridesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayUnitOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayUnitOverrides = append(m.DisplayUnitOverrides, DisplayUnitOverride{})
+			if err := m.DisplayUnitOverrides[len(m.DisplayUnitOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
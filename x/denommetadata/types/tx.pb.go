@@ -0,0 +1,1124 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: enoki/denommetadata/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgSetSymbol is the Msg/SetSymbol request type.
+type MsgSetSymbol struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the IBC voucher denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// symbol is the new symbol, an empty symbol removes the override.
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (m *MsgSetSymbol) Reset()         { *m = MsgSetSymbol{} }
+func (m *MsgSetSymbol) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSymbol) ProtoMessage()    {}
+func (*MsgSetSymbol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88fa50971d6296cd, []int{0}
+}
+func (m *MsgSetSymbol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSymbol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSymbol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSymbol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSymbol.Merge(m, src)
+}
+func (m *MsgSetSymbol) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSymbol) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSymbol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSymbol proto.InternalMessageInfo
+
+func (m *MsgSetSymbol) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSymbol) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetSymbol) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+// MsgSetSymbolResponse defines the response structure for executing a
+// MsgSetSymbol message.
+type MsgSetSymbolResponse struct {
+}
+
+func (m *MsgSetSymbolResponse) Reset()         { *m = MsgSetSymbolResponse{} }
+func (m *MsgSetSymbolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSymbolResponse) ProtoMessage()    {}
+func (*MsgSetSymbolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88fa50971d6296cd, []int{1}
+}
+func (m *MsgSetSymbolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSymbolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSymbolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSymbolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSymbolResponse.Merge(m, src)
+}
+func (m *MsgSetSymbolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSymbolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSymbolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSymbolResponse proto.InternalMessageInfo
+
+// MsgSetDisplayUnit is the Msg/SetDisplayUnit request type.
+type MsgSetDisplayUnit struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the IBC voucher denom.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// display is the name of the display unit, an empty display removes the
+	// override.
+	Display string `protobuf:"bytes,3,opt,name=display,proto3" json:"display,omitempty"`
+	// exponent is the power of 10 converting the display unit to the base
+	// denom.
+	Exponent uint32 `protobuf:"varint,4,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *MsgSetDisplayUnit) Reset()         { *m = MsgSetDisplayUnit{} }
+func (m *MsgSetDisplayUnit) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDisplayUnit) ProtoMessage()    {}
+func (*MsgSetDisplayUnit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88fa50971d6296cd, []int{2}
+}
+func (m *MsgSetDisplayUnit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDisplayUnit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDisplayUnit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDisplayUnit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDisplayUnit.Merge(m, src)
+}
+func (m *MsgSetDisplayUnit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDisplayUnit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDisplayUnit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDisplayUnit proto.InternalMessageInfo
+
+func (m *MsgSetDisplayUnit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetDisplayUnit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetDisplayUnit) GetDisplay() string {
+	if m != nil {
+		return m.Display
+	}
+	return ""
+}
+
+func (m *MsgSetDisplayUnit) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
+	}
+	return 0
+}
+
+// MsgSetDisplayUnitResponse defines the response structure for executing a
+// MsgSetDisplayUnit message.
+type MsgSetDisplayUnitResponse struct {
+}
+
+func (m *MsgSetDisplayUnitResponse) Reset()         { *m = MsgSetDisplayUnitResponse{} }
+func (m *MsgSetDisplayUnitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDisplayUnitResponse) ProtoMessage()    {}
+func (*MsgSetDisplayUnitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88fa50971d6296cd, []int{3}
+}
+func (m *MsgSetDisplayUnitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDisplayUnitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDisplayUnitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDisplayUnitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDisplayUnitResponse.Merge(m, src)
+}
+func (m *MsgSetDisplayUnitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDisplayUnitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDisplayUnitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDisplayUnitResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetSymbol)(nil), "enoki.denommetadata.v1.MsgSetSymbol")
+	proto.RegisterType((*MsgSetSymbolResponse)(nil), "enoki.denommetadata.v1.MsgSetSymbolResponse")
+	proto.RegisterType((*MsgSetDisplayUnit)(nil), "enoki.denommetadata.v1.MsgSetDisplayUnit")
+	proto.RegisterType((*MsgSetDisplayUnitResponse)(nil), "enoki.denommetadata.v1.MsgSetDisplayUnitResponse")
+}
+
+func init() { proto.RegisterFile("enoki/denommetadata/v1/tx.proto", fileDescriptor_88fa50971d6296cd) }
+
+var fileDescriptor_88fa50971d6296cd = []byte{
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x31, 0x6f, 0xda, 0x40,
+	0x14, 0xe6, 0x4a, 0xa1, 0xe5, 0xd4, 0x56, 0xc2, 0x42, 0xd4, 0xb8, 0x92, 0x8b, 0xac, 0x56, 0xa2,
+	0xa8, 0xd8, 0xa2, 0x48, 0x6d, 0xc5, 0x56, 0xd4, 0x95, 0xa1, 0x46, 0x5d, 0xba, 0x20, 0x83, 0x4f,
+	0xb6, 0x55, 0xee, 0xce, 0xf2, 0x1d, 0x08, 0x6f, 0x51, 0xc6, 0x4c, 0xf9, 0x1b, 0xd9, 0x18, 0xf2,
+	0x23, 0x32, 0x92, 0x4c, 0x59, 0x22, 0x45, 0x30, 0xf0, 0x37, 0x22, 0x7c, 0x76, 0x70, 0x80, 0x44,
+	0x0c, 0x59, 0x2c, 0x7d, 0xf7, 0xbe, 0xef, 0xbd, 0xf7, 0x7d, 0x7e, 0xf0, 0x23, 0x22, 0xf4, 0xbf,
+	0x67, 0xd8, 0x88, 0x50, 0x8c, 0x11, 0xb7, 0x6c, 0x8b, 0x5b, 0xc6, 0xa4, 0x69, 0xf0, 0xa9, 0xee,
+	0x07, 0x94, 0x53, 0xa9, 0x1c, 0x11, 0xf4, 0x07, 0x04, 0x7d, 0xd2, 0x54, 0x8a, 0x16, 0xf6, 0x08,
+	0x35, 0xa2, 0xaf, 0xa0, 0x2a, 0xef, 0x87, 0x94, 0x61, 0xca, 0x0c, 0xcc, 0x9c, 0x75, 0x0b, 0xcc,
+	0x9c, 0xb8, 0x50, 0x11, 0x85, 0x7e, 0x84, 0x0c, 0x01, 0x44, 0x49, 0x3b, 0x03, 0xf0, 0x4d, 0x97,
+	0x39, 0x3d, 0xc4, 0x7b, 0x21, 0x1e, 0xd0, 0x91, 0xf4, 0x1d, 0x16, 0xac, 0x31, 0x77, 0x69, 0xe0,
+	0xf1, 0x50, 0x06, 0x55, 0x50, 0x2b, 0x74, 0xe4, 0xab, 0xf3, 0x46, 0x29, 0x56, 0xfd, 0xb2, 0xed,
+	0x00, 0x31, 0xd6, 0xe3, 0x81, 0x47, 0x1c, 0x73, 0x43, 0x95, 0x4a, 0x30, 0x17, 0xed, 0x28, 0xbf,
+	0x58, 0x6b, 0x4c, 0x01, 0xa4, 0x32, 0xcc, 0xb3, 0xa8, 0xaf, 0x9c, 0x8d, 0x9e, 0x63, 0xd4, 0x6e,
+	0x1d, 0xaf, 0x66, 0xf5, 0x8d, 0xfa, 0x64, 0x35, 0xab, 0x57, 0xf7, 0x25, 0x91, 0x5e, 0x4d, 0x2b,
+	0xc3, 0x52, 0x1a, 0x9b, 0x88, 0xf9, 0x94, 0x30, 0xa4, 0x5d, 0x02, 0x58, 0x14, 0x85, 0xdf, 0x1e,
+	0xf3, 0x47, 0x56, 0xf8, 0x97, 0x78, 0xfc, 0x99, 0x8d, 0xc8, 0xf0, 0x95, 0x2d, 0x9a, 0xc7, 0x4e,
+	0x12, 0x28, 0x29, 0xf0, 0x35, 0x9a, 0xfa, 0x94, 0x20, 0xc2, 0xe5, 0x97, 0x55, 0x50, 0x7b, 0x6b,
+	0xde, 0xe3, 0xf6, 0xcf, 0x5d, 0x9b, 0x9f, 0x1f, 0xb7, 0x99, 0xda, 0x5e, 0xfb, 0x00, 0x2b, 0x3b,
+	0x8f, 0x89, 0xe1, 0x6f, 0x37, 0x00, 0x66, 0xbb, 0xcc, 0x91, 0xfa, 0xb0, 0xb0, 0xf9, 0x71, 0x9f,
+	0xf4, 0xfd, 0x97, 0xa2, 0xa7, 0x33, 0x53, 0xbe, 0x1e, 0xc2, 0x4a, 0x06, 0x49, 0x04, 0xbe, 0xdb,
+	0x4a, 0xf5, 0xcb, 0xd3, 0xfa, 0x14, 0x55, 0x69, 0x1e, 0x4c, 0x4d, 0xe6, 0x29, 0xb9, 0xa3, 0xd5,
+	0xac, 0x0e, 0x3a, 0x7f, 0x2e, 0x16, 0x2a, 0x98, 0x2f, 0x54, 0x70, 0xbb, 0x50, 0xc1, 0xe9, 0x52,
+	0xcd, 0xcc, 0x97, 0x6a, 0xe6, 0x7a, 0xa9, 0x66, 0xfe, 0xfd, 0x70, 0x3c, 0xee, 0x8e, 0x07, 0xfa,
+	0x90, 0x62, 0xc3, 0x0d, 0x7d, 0xd7, 0x1a, 0x52, 0xea, 0xc7, 0x17, 0xdd, 0x10, 0xc9, 0x4e, 0xb7,
+	0xb2, 0xe5, 0xa1, 0x8f, 0xd8, 0x20, 0x1f, 0x9d, 0x7b, 0xeb, 0x6e, 0x00, 0x5b, 0x8a, 0x2d, 0xcd,
+	0x70, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetSymbol sets or clears the symbol override of an IBC voucher denom.
+	SetSymbol(ctx context.Context, in *MsgSetSymbol, opts ...grpc.CallOption) (*MsgSetSymbolResponse, error)
+	// SetDisplayUnit sets or clears the display unit override of an IBC
+	// voucher denom.
+	SetDisplayUnit(ctx context.Context, in *MsgSetDisplayUnit, opts ...grpc.CallOption) (*MsgSetDisplayUnitResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetSymbol(ctx context.Context, in *MsgSetSymbol, opts ...grpc.CallOption) (*MsgSetSymbolResponse, error) {
+	out := new(MsgSetSymbolResponse)
+	err := c.cc.Invoke(ctx, "/enoki.denommetadata.v1.Msg/SetSymbol", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDisplayUnit(ctx context.Context, in *MsgSetDisplayUnit, opts ...grpc.CallOption) (*MsgSetDisplayUnitResponse, error) {
+	out := new(MsgSetDisplayUnitResponse)
+	err := c.cc.Invoke(ctx, "/enoki.denommetadata.v1.Msg/SetDisplayUnit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetSymbol sets or clears the symbol override of an IBC voucher denom.
+	SetSymbol(context.Context, *MsgSetSymbol) (*MsgSetSymbolResponse, error)
+	// SetDisplayUnit sets or clears the display unit override of an IBC
+	// voucher denom.
+	SetDisplayUnit(context.Context, *MsgSetDisplayUnit) (*MsgSetDisplayUnitResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetSymbol(ctx context.Context, req *MsgSetSymbol) (*MsgSetSymbolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSymbol not implemented")
+}
+func (*UnimplementedMsgServer) SetDisplayUnit(ctx context.Context, req *MsgSetDisplayUnit) (*MsgSetDisplayUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDisplayUnit not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetSymbol_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSymbol)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSymbol(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enoki.denommetadata.v1.Msg/SetSymbol",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSymbol(ctx, req.(*MsgSetSymbol))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDisplayUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDisplayUnit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDisplayUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enoki.denommetadata.v1.Msg/SetDisplayUnit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDisplayUnit(ctx, req.(*MsgSetDisplayUnit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "enoki.denommetadata.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetSymbol",
+			Handler:    _Msg_SetSymbol_Handler,
+		},
+		{
+			MethodName: "SetDisplayUnit",
+			Handler:    _Msg_SetDisplayUnit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "enoki/denommetadata/v1/tx.proto",
+}
+
+func (m *MsgSetSymbol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSymbol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSymbol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSymbolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSymbolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSymbolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDisplayUnit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDisplayUnit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDisplayUnit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Display) > 0 {
+		i -= len(m.Display)
+		copy(dAtA[i:], m.Display)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Display)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDisplayUnitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDisplayUnitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDisplayUnitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetSymbol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSymbolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDisplayUnit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovTx(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *MsgSetDisplayUnitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetSymbol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSymbol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSymbol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSymbolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSymbolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSymbolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDisplayUnit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDisplayUnit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDisplayUnit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDisplayUnitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDisplayUnitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDisplayUnitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
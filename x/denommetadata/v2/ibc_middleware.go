@@ -0,0 +1,104 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+var (
+	_ api.IBCModule             = IBCMiddleware{}
+	_ api.PacketDataUnmarshaler = IBCMiddleware{}
+)
+
+// IBCMiddleware registers bank metadata for the vouchers minted by the IBC v2
+// transfer application it wraps.
+type IBCMiddleware struct {
+	app    api.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer app.
+func NewIBCMiddleware(app api.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket implements the IBCModule interface. Once the transfer app
+// has credited the tokens, metadata is registered for the received denom.
+// Failing to register metadata never fails the transfer.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	res := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if res.Status != channeltypesv2.PacketStatus_Success {
+		return res
+	}
+
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return res
+	}
+
+	denom := types.ReceivedDenom(data.Token.Denom, payload.SourcePort, sourceClient, payload.DestinationPort, destinationClient)
+	if err := im.keeper.RegisterVoucher(ctx, denom); err != nil {
+		im.keeper.Logger(ctx).Error("failed to register voucher metadata", "denom", denom.Path(), "error", err)
+	}
+
+	return res
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface by
+// delegating to the wrapped app.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return im.app.(api.PacketDataUnmarshaler).UnmarshalPacketData(payload)
+}
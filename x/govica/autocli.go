@@ -0,0 +1,61 @@
+package govica
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/hyphacoop/cosmos-enoki/x/govica/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "InterchainAccount",
+					Use:            "account [connection-id]",
+					Short:          "Query the governance interchain account on a connection",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "connection_id"}},
+				},
+				{
+					RpcMethod: "InterchainAccounts",
+					Use:       "accounts",
+					Short:     "Query all governance interchain accounts",
+				},
+				{
+					RpcMethod: "TxHistory",
+					Use:       "history",
+					Short:     "Query transactions sent by governance interchain accounts",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"connection_id": {Name: "connection-id", Usage: "only show transactions sent on this connection"},
+					},
+				},
+				{
+					RpcMethod:      "Tx",
+					Use:            "tx [id]",
+					Short:          "Query a transaction sent by a governance interchain account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "RegisterInterchainAccount",
+					Use:            "register [connection-id]",
+					Short:          "Submit a proposal to register a governance interchain account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "connection_id"}},
+					GovProposal:    true,
+				},
+				{
+					// Messages for the host chain are not known to this
+					// chain's codec, use a proposal JSON file instead.
+					RpcMethod: "SubmitTx",
+					Skip:      true,
+				},
+			},
+		},
+	}
+}
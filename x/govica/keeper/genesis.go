@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/hyphacoop/cosmos-enoki/x/govica/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, acc := range genState.Accounts {
+		if err := k.Accounts.Set(ctx, acc.ConnectionId, acc); err != nil {
+			return err
+		}
+	}
+
+	for _, rec := range genState.History {
+		if err := k.TxRecords.Set(ctx, rec.Id, rec); err != nil {
+			return err
+		}
+		if rec.Status == types.TX_STATUS_PENDING {
+			if err := k.PacketIndex.Set(ctx, collections.Join(rec.ChannelId, rec.Sequence), rec.Id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return k.NextTxID.Set(ctx, genState.NextTxId)
+}
+
+// ExportGenesis returns the module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	genState := types.DefaultGenesis()
+
+	err := k.Accounts.Walk(ctx, nil, func(_ string, acc types.RegisteredAccount) (bool, error) {
+		genState.Accounts = append(genState.Accounts, acc)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.TxRecords.Walk(ctx, nil, func(_ uint64, rec types.TxRecord) (bool, error) {
+		genState.History = append(genState.History, rec)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	genState.NextTxId, err = k.NextTxID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return genState, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/hyphacoop/cosmos-enoki/x/govica/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InterchainAccount returns the governance interchain account on a connection.
+func (k Keeper) InterchainAccount(ctx context.Context, req *types.QueryInterchainAccountRequest) (*types.QueryInterchainAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	acc, err := k.Accounts.Get(ctx, req.ConnectionId)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no interchain account on connection %s", req.ConnectionId)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInterchainAccountResponse{Account: k.interchainAccount(sdk.UnwrapSDKContext(ctx), acc)}, nil
+}
+
+// InterchainAccounts returns all governance interchain accounts.
+func (k Keeper) InterchainAccounts(ctx context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	accounts, pageRes, err := query.CollectionPaginate(ctx, k.Accounts, req.Pagination,
+		func(_ string, acc types.RegisteredAccount) (types.InterchainAccount, error) {
+			return k.interchainAccount(sdkCtx, acc), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInterchainAccountsResponse{Accounts: accounts, Pagination: pageRes}, nil
+}
+
+// TxHistory returns the transactions sent through governance interchain
+// accounts, optionally filtered by connection.
+func (k Keeper) TxHistory(ctx context.Context, req *types.QueryTxHistoryRequest) (*types.QueryTxHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	records, pageRes, err := query.CollectionFilteredPaginate(ctx, k.TxRecords, req.Pagination,
+		func(_ uint64, rec types.TxRecord) (bool, error) {
+			return req.ConnectionId == "" || rec.ConnectionId == req.ConnectionId, nil
+		},
+		func(_ uint64, rec types.TxRecord) (types.TxRecord, error) {
+			return rec, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTxHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// Tx returns a single transaction record.
+func (k Keeper) Tx(ctx context.Context, req *types.QueryTxRequest) (*types.QueryTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	rec, err := k.TxRecords.Get(ctx, req.Id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "tx record %d not found", req.Id)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTxResponse{Record: rec}, nil
+}
+
+func (k Keeper) interchainAccount(ctx sdk.Context, acc types.RegisteredAccount) types.InterchainAccount {
+	address, _ := k.icaControllerKeeper.GetInterchainAccountAddress(ctx, acc.ConnectionId, acc.PortId)
+	channelID, _ := k.icaControllerKeeper.GetOpenActiveChannel(ctx, acc.ConnectionId, acc.PortId)
+	return types.InterchainAccount{
+		ConnectionId:     acc.ConnectionId,
+		PortId:           acc.PortId,
+		Address:          address,
+		ChannelId:        channelID,
+		RegisteredHeight: acc.RegisteredHeight,
+	}
+}
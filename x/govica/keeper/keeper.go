@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/govica/types"
+)
+
+// Keeper manages the interchain accounts owned by the governance authority.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	icaControllerKeeper types.ICAControllerKeeper
+
+	// authority owns the interchain accounts and is the only signer allowed
+	// to use them, typically the gov module account.
+	authority string
+
+	Schema      collections.Schema
+	Accounts    collections.Map[string, types.RegisteredAccount]
+	TxRecords   collections.Map[uint64, types.TxRecord]
+	PacketIndex collections.Map[collections.Pair[string, uint64], uint64]
+	NextTxID    collections.Sequence
+}
+
+// NewKeeper returns a new govica keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	icaControllerKeeper types.ICAControllerKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:                 cdc,
+		storeService:        storeService,
+		icaControllerKeeper: icaControllerKeeper,
+		authority:           authority,
+
+		Accounts:    collections.NewMap(sb, types.AccountsKey, "accounts", collections.StringKey, codec.CollValue[types.RegisteredAccount](cdc)),
+		TxRecords:   collections.NewMap(sb, types.TxRecordsKey, "tx_records", collections.Uint64Key, codec.CollValue[types.TxRecord](cdc)),
+		PacketIndex: collections.NewMap(sb, types.PacketIndexKey, "packet_index", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Uint64Value),
+		NextTxID:    collections.NewSequence(sb, types.NextTxIDKey, "next_tx_id"),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the address owning the interchain accounts.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// PortID returns the controller port bound for the authority.
+func (k Keeper) PortID() string {
+	portID, err := icatypes.NewControllerPortID(k.authority)
+	if err != nil {
+		panic(err)
+	}
+	return portID
+}
+
+// GetTxRecord returns the transaction record with the given id.
+func (k Keeper) GetTxRecord(ctx context.Context, id uint64) (types.TxRecord, error) {
+	return k.TxRecords.Get(ctx, id)
+}
@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/govica"
+	"github.com/hyphacoop/cosmos-enoki/x/govica/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/govica/types"
+)
+
+const (
+	connectionID = "connection-0"
+	channelID    = "channel-7"
+	icaAddress   = "cosmos1hostaccount"
+)
+
+// mockICAController implements types.ICAControllerKeeper with a single
+// connection.
+type mockICAController struct {
+	registered bool
+	open       bool
+	version    string
+	sequence   uint64
+	sent       []icatypes.InterchainAccountPacketData
+}
+
+func (m *mockICAController) RegisterInterchainAccount(_ sdk.Context, _, _, _ string, _ channeltypes.Order) error {
+	m.registered = true
+	return nil
+}
+
+func (m *mockICAController) SendTx(_ sdk.Context, _, _ string, data icatypes.InterchainAccountPacketData, _ uint64) (uint64, error) {
+	m.sequence++
+	m.sent = append(m.sent, data)
+	return m.sequence, nil
+}
+
+func (m *mockICAController) GetOpenActiveChannel(_ sdk.Context, connID, _ string) (string, bool) {
+	if !m.open || connID != connectionID {
+		return "", false
+	}
+	return channelID, true
+}
+
+func (m *mockICAController) GetActiveChannelID(ctx sdk.Context, connID, portID string) (string, bool) {
+	return m.GetOpenActiveChannel(ctx, connID, portID)
+}
+
+func (m *mockICAController) GetInterchainAccountAddress(_ sdk.Context, connID, _ string) (string, bool) {
+	if !m.open || connID != connectionID {
+		return "", false
+	}
+	return icaAddress, true
+}
+
+func (m *mockICAController) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	return m.version, m.version != ""
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *mockICAController) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+		WithBlockHeight(10).
+		WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	encCfg := moduletestutil.MakeTestEncodingConfig(govica.AppModuleBasic{})
+
+	ica := &mockICAController{version: icatypes.NewDefaultMetadataString(connectionID, "connection-1")}
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), ica, authtypes.NewModuleAddress("gov").String())
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesis()))
+	return ctx, k, ica
+}
+
+func bankSendAny(t *testing.T) *codectypes.Any {
+	t.Helper()
+	a, err := codectypes.NewAnyWithValue(&banktypes.MsgSend{FromAddress: icaAddress, ToAddress: icaAddress})
+	require.NoError(t, err)
+	return a
+}
+
+func TestRegisterInterchainAccount(t *testing.T) {
+	ctx, k, ica := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	_, err := srv.RegisterInterchainAccount(ctx, &types.MsgRegisterInterchainAccount{
+		Authority:    authtypes.NewModuleAddress("other").String(),
+		ConnectionId: connectionID,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	require.False(t, ica.registered)
+
+	res, err := srv.RegisterInterchainAccount(ctx, &types.MsgRegisterInterchainAccount{
+		Authority:    k.GetAuthority(),
+		ConnectionId: connectionID,
+		Ordering:     channeltypes.ORDERED,
+	})
+	require.NoError(t, err)
+	require.True(t, ica.registered)
+	require.Equal(t, k.PortID(), res.PortId)
+
+	acc, err := k.Accounts.Get(ctx, connectionID)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), acc.RegisteredHeight)
+
+	// a second registration is rejected while the channel is open
+	ica.open = true
+	_, err = srv.RegisterInterchainAccount(ctx, &types.MsgRegisterInterchainAccount{
+		Authority:    k.GetAuthority(),
+		ConnectionId: connectionID,
+	})
+	require.ErrorIs(t, err, types.ErrAccountAlreadyExists)
+
+	queryRes, err := k.InterchainAccount(ctx, &types.QueryInterchainAccountRequest{ConnectionId: connectionID})
+	require.NoError(t, err)
+	require.Equal(t, icaAddress, queryRes.Account.Address)
+	require.Equal(t, channelID, queryRes.Account.ChannelId)
+}
+
+func TestSubmitTx(t *testing.T) {
+	ctx, k, ica := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	msg := &types.MsgSubmitTx{
+		Authority:    k.GetAuthority(),
+		ConnectionId: connectionID,
+		Msgs:         []*codectypes.Any{bankSendAny(t)},
+		Memo:         "stake the community pool",
+		Timeout:      time.Hour,
+	}
+
+	_, err := srv.SubmitTx(ctx, msg)
+	require.ErrorIs(t, err, types.ErrAccountNotRegistered)
+
+	_, err = srv.RegisterInterchainAccount(ctx, &types.MsgRegisterInterchainAccount{
+		Authority:    k.GetAuthority(),
+		ConnectionId: connectionID,
+	})
+	require.NoError(t, err)
+
+	_, err = srv.SubmitTx(ctx, msg)
+	require.ErrorIs(t, err, types.ErrChannelNotActive)
+
+	ica.open = true
+	res, err := srv.SubmitTx(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Id)
+	require.Equal(t, uint64(1), res.Sequence)
+	require.Len(t, ica.sent, 1)
+	require.Equal(t, msg.Memo, ica.sent[0].Memo)
+
+	rec, err := k.GetTxRecord(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TX_STATUS_PENDING, rec.Status)
+	require.Equal(t, channelID, rec.ChannelId)
+	require.Equal(t, uint64(ctx.BlockTime().Add(time.Hour).UnixNano()), rec.TimeoutTimestamp)
+
+	metadata := icatypes.NewDefaultMetadata(connectionID, "connection-1")
+	metadata.Encoding = icatypes.EncodingProto3JSON
+	ica.version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+	_, err = srv.SubmitTx(ctx, msg)
+	require.ErrorIs(t, err, types.ErrUnsupportedEncoding)
+}
+
+func TestPacketCallbacks(t *testing.T) {
+	ctx, k, ica := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	_, err := srv.RegisterInterchainAccount(ctx, &types.MsgRegisterInterchainAccount{
+		Authority:    k.GetAuthority(),
+		ConnectionId: connectionID,
+	})
+	require.NoError(t, err)
+	ica.open = true
+
+	submit := func() uint64 {
+		res, err := srv.SubmitTx(ctx, &types.MsgSubmitTx{
+			Authority:    k.GetAuthority(),
+			ConnectionId: connectionID,
+			Msgs:         []*codectypes.Any{bankSendAny(t)},
+			Timeout:      time.Hour,
+		})
+		require.NoError(t, err)
+		return res.Id
+	}
+	packet := func(id uint64) channeltypes.Packet {
+		rec, err := k.GetTxRecord(ctx, id)
+		require.NoError(t, err)
+		return channeltypes.Packet{SourcePort: k.PortID(), SourceChannel: rec.ChannelId, Sequence: rec.Sequence}
+	}
+
+	okID, errID, timeoutID := submit(), submit(), submit()
+	ctx = ctx.WithBlockHeight(12)
+
+	ibcModule := govica.NewIBCModule(k, nil)
+	ack := channeltypes.NewResultAcknowledgement([]byte("ok"))
+	require.NoError(t, ibcModule.OnAcknowledgementPacket(ctx, "", packet(okID), ack.Acknowledgement(), nil))
+	errAck := channeltypes.NewErrorAcknowledgement(types.ErrInvalidTimeout)
+	require.NoError(t, ibcModule.OnAcknowledgementPacket(ctx, "", packet(errID), errAck.Acknowledgement(), nil))
+	require.NoError(t, ibcModule.OnTimeoutPacket(ctx, "", packet(timeoutID), nil))
+
+	for id, status := range map[uint64]types.TxStatus{
+		okID:      types.TX_STATUS_SUCCESS,
+		errID:     types.TX_STATUS_ERROR,
+		timeoutID: types.TX_STATUS_TIMEOUT,
+	} {
+		rec, err := k.GetTxRecord(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, status, rec.Status)
+		require.Equal(t, int64(12), rec.CompletedHeight)
+	}
+
+	// acknowledgements are only recorded once
+	require.NoError(t, k.OnTimeoutPacket(ctx, packet(okID)))
+	rec, err := k.GetTxRecord(ctx, okID)
+	require.NoError(t, err)
+	require.Equal(t, types.TX_STATUS_SUCCESS, rec.Status)
+
+	history, err := k.TxHistory(ctx, &types.QueryTxHistoryRequest{ConnectionId: connectionID})
+	require.NoError(t, err)
+	require.Len(t, history.Records, 3)
+	history, err = k.TxHistory(ctx, &types.QueryTxHistoryRequest{ConnectionId: "connection-9"})
+	require.NoError(t, err)
+	require.Empty(t, history.Records)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	ctx, k, ica := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	_, err := srv.RegisterInterchainAccount(ctx, &types.MsgRegisterInterchainAccount{
+		Authority:    k.GetAuthority(),
+		ConnectionId: connectionID,
+	})
+	require.NoError(t, err)
+	ica.open = true
+	res, err := srv.SubmitTx(ctx, &types.MsgSubmitTx{
+		Authority:    k.GetAuthority(),
+		ConnectionId: connectionID,
+		Msgs:         []*codectypes.Any{bankSendAny(t)},
+		Timeout:      time.Hour,
+	})
+	require.NoError(t, err)
+
+	genState, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, genState.Validate())
+	require.Equal(t, uint64(2), genState.NextTxId)
+
+	ctx2, k2, _ := setupKeeper(t)
+	require.NoError(t, k2.InitGenesis(ctx2, *genState))
+
+	// pending packets can still be completed after an import
+	rec, err := k2.GetTxRecord(ctx2, res.Id)
+	require.NoError(t, err)
+	require.NoError(t, k2.OnTimeoutPacket(ctx2, channeltypes.Packet{SourceChannel: rec.ChannelId, Sequence: rec.Sequence}))
+	rec, err = k2.GetTxRecord(ctx2, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, types.TX_STATUS_TIMEOUT, rec.Status)
+
+	exported, err := k2.ExportGenesis(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, genState.NextTxId, exported.NextTxId)
+	require.Equal(t, genState.Accounts, exported.Accounts)
+}
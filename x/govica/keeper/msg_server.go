@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/govica/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RegisterInterchainAccount opens an interchain account owned by the
+// authority on the given connection.
+func (k msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	portID := k.PortID()
+
+	// A closed channel can be reopened by registering again, an active one
+	// cannot.
+	if _, found := k.icaControllerKeeper.GetOpenActiveChannel(ctx, msg.ConnectionId, portID); found {
+		return nil, errorsmod.Wrapf(types.ErrAccountAlreadyExists, "connection %s", msg.ConnectionId)
+	}
+
+	// The legacy API marks the middleware as enabled so that packet
+	// callbacks reach this module.
+	if err := k.icaControllerKeeper.RegisterInterchainAccount(ctx, msg.ConnectionId, k.authority, msg.Version, msg.Ordering); err != nil { //nolint:staticcheck // callbacks are routed to this module
+		return nil, err
+	}
+
+	if err := k.Accounts.Set(ctx, msg.ConnectionId, types.RegisteredAccount{
+		ConnectionId:     msg.ConnectionId,
+		PortId:           portID,
+		RegisteredHeight: ctx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterAccount,
+		sdk.NewAttribute(types.AttributeKeyConnectionID, msg.ConnectionId),
+		sdk.NewAttribute(types.AttributeKeyPortID, portID),
+	))
+
+	return &types.MsgRegisterInterchainAccountResponse{PortId: portID}, nil
+}
+
+// SubmitTx sends msgs to be executed by the authority's interchain account.
+func (k msgServer) SubmitTx(goCtx context.Context, msg *types.MsgSubmitTx) (*types.MsgSubmitTxResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	portID := k.PortID()
+
+	if has, err := k.Accounts.Has(ctx, msg.ConnectionId); err != nil {
+		return nil, err
+	} else if !has {
+		return nil, errorsmod.Wrapf(types.ErrAccountNotRegistered, "connection %s", msg.ConnectionId)
+	}
+
+	channelID, found := k.icaControllerKeeper.GetOpenActiveChannel(ctx, msg.ConnectionId, portID)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrChannelNotActive, "connection %s", msg.ConnectionId)
+	}
+
+	version, found := k.icaControllerKeeper.GetAppVersion(ctx, portID, channelID)
+	if !found {
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidVersion, "no app version for channel %s", channelID)
+	}
+	metadata, err := icatypes.MetadataFromVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.Encoding != icatypes.EncodingProtobuf {
+		return nil, errorsmod.Wrapf(types.ErrUnsupportedEncoding, "expected %s, got %s", icatypes.EncodingProtobuf, metadata.Encoding)
+	}
+
+	data, err := k.cdc.Marshal(&icatypes.CosmosTx{Messages: msg.Msgs})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "marshal cosmos tx")
+	}
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: msg.Memo,
+	}
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(msg.Timeout).UnixNano())
+
+	sequence, err := k.icaControllerKeeper.SendTx(ctx, msg.ConnectionId, portID, packetData, timeoutTimestamp) //nolint:staticcheck // callbacks are routed to this module
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.NextTxID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.TxRecords.Set(ctx, id, types.TxRecord{
+		Id:               id,
+		ConnectionId:     msg.ConnectionId,
+		ChannelId:        channelID,
+		Sequence:         sequence,
+		Msgs:             msg.Msgs,
+		Memo:             msg.Memo,
+		TimeoutTimestamp: timeoutTimestamp,
+		SubmitHeight:     ctx.BlockHeight(),
+		SubmitTime:       ctx.BlockTime(),
+		Status:           types.TX_STATUS_PENDING,
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.PacketIndex.Set(ctx, collections.Join(channelID, sequence), id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubmitTx,
+		sdk.NewAttribute(types.AttributeKeyTxID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyConnectionID, msg.ConnectionId),
+		sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+	))
+
+	return &types.MsgSubmitTxResponse{Id: id, Sequence: sequence}, nil
+}
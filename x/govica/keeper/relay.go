@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/govica/types"
+)
+
+// OnAcknowledgementPacket records the outcome of an acknowledged transaction.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return err
+	}
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		return k.completeTx(ctx, packet, types.TX_STATUS_SUCCESS, string(resp.Result))
+	case *channeltypes.Acknowledgement_Error:
+		return k.completeTx(ctx, packet, types.TX_STATUS_ERROR, resp.Error)
+	default:
+		return k.completeTx(ctx, packet, types.TX_STATUS_ERROR, "unknown acknowledgement")
+	}
+}
+
+// OnTimeoutPacket records that a transaction timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.completeTx(ctx, packet, types.TX_STATUS_TIMEOUT, "")
+}
+
+func (k Keeper) completeTx(ctx sdk.Context, packet channeltypes.Packet, status types.TxStatus, result string) error {
+	key := collections.Join(packet.SourceChannel, packet.Sequence)
+	id, err := k.PacketIndex.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		// Packets sent before the module tracked them have no record.
+		k.Logger(ctx).Info("no tx record for packet", "channel", packet.SourceChannel, "sequence", packet.Sequence)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	rec, err := k.TxRecords.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	rec.Status = status
+	rec.Result = result
+	rec.CompletedHeight = ctx.BlockHeight()
+	if err := k.TxRecords.Set(ctx, id, rec); err != nil {
+		return err
+	}
+	if err := k.PacketIndex.Remove(ctx, key); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTxCompleted,
+		sdk.NewAttribute(types.AttributeKeyTxID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+	))
+
+	return nil
+}
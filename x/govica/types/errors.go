@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidAuthority     = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrAccountNotRegistered = errorsmod.Register(ModuleName, 3, "interchain account not registered")
+	ErrAccountAlreadyExists = errorsmod.Register(ModuleName, 4, "interchain account already registered")
+	ErrChannelNotActive     = errorsmod.Register(ModuleName, 5, "interchain account channel is not active")
+	ErrInvalidTimeout       = errorsmod.Register(ModuleName, 6, "invalid timeout")
+	ErrUnsupportedEncoding  = errorsmod.Register(ModuleName, 7, "unsupported interchain account encoding")
+	ErrTxRecordNotFound     = errorsmod.Register(ModuleName, 8, "transaction record not found")
+)
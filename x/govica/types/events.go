@@ -0,0 +1,15 @@
+package types
+
+// govica module event types and attribute keys
+const (
+	EventTypeRegisterAccount = "govica_register_account"
+	EventTypeSubmitTx        = "govica_submit_tx"
+	EventTypeTxCompleted     = "govica_tx_completed"
+
+	AttributeKeyConnectionID = "connection_id"
+	AttributeKeyPortID       = "port_id"
+	AttributeKeyChannelID    = "channel_id"
+	AttributeKeySequence     = "sequence"
+	AttributeKeyTxID         = "tx_id"
+	AttributeKeyStatus       = "status"
+)
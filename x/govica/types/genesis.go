@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default govica genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Accounts: []RegisteredAccount{},
+		History:  []TxRecord{},
+		NextTxId: 1,
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if gs.NextTxId == 0 {
+		return fmt.Errorf("next tx id must be positive")
+	}
+
+	connections := make(map[string]struct{}, len(gs.Accounts))
+	for _, acc := range gs.Accounts {
+		if err := validateConnectionID(acc.ConnectionId); err != nil {
+			return err
+		}
+		if acc.PortId == "" {
+			return fmt.Errorf("account on %s has no port id", acc.ConnectionId)
+		}
+		if _, ok := connections[acc.ConnectionId]; ok {
+			return fmt.Errorf("duplicate account for connection %s", acc.ConnectionId)
+		}
+		connections[acc.ConnectionId] = struct{}{}
+	}
+
+	ids := make(map[uint64]struct{}, len(gs.History))
+	for _, rec := range gs.History {
+		if rec.Id == 0 || rec.Id >= gs.NextTxId {
+			return fmt.Errorf("tx record id %d out of range, next tx id is %d", rec.Id, gs.NextTxId)
+		}
+		if _, ok := ids[rec.Id]; ok {
+			return fmt.Errorf("duplicate tx record id %d", rec.Id)
+		}
+		ids[rec.Id] = struct{}{}
+		if _, ok := connections[rec.ConnectionId]; !ok {
+			return fmt.Errorf("tx record %d references unknown connection %s", rec.Id, rec.ConnectionId)
+		}
+		if rec.Status == TX_STATUS_UNSPECIFIED {
+			return fmt.Errorf("tx record %d has no status", rec.Id)
+		}
+	}
+
+	return nil
+}
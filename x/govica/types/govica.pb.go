@@ -0,0 +1,1138 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: enoki/govica/v1/govica.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TxStatus is the lifecycle state of a transaction sent through a
+// governance owned interchain account.
+type TxStatus int32
+
+const (
+	// TX_STATUS_UNSPECIFIED is an invalid status.
+	TX_STATUS_UNSPECIFIED TxStatus = 0
+	// TX_STATUS_PENDING means the packet has not been acknowledged yet.
+	TX_STATUS_PENDING TxStatus = 1
+	// TX_STATUS_SUCCESS means the host chain executed the messages.
+	TX_STATUS_SUCCESS TxStatus = 2
+	// TX_STATUS_ERROR means the host chain returned an error acknowledgement.
+	TX_STATUS_ERROR TxStatus = 3
+	// TX_STATUS_TIMEOUT means the packet timed out and the channel was closed.
+	TX_STATUS_TIMEOUT TxStatus = 4
+)
+
+var TxStatus_name = map[int32]string{
+	0: "TX_STATUS_UNSPECIFIED",
+	1: "TX_STATUS_PENDING",
+	2: "TX_STATUS_SUCCESS",
+	3: "TX_STATUS_ERROR",
+	4: "TX_STATUS_TIMEOUT",
+}
+
+var TxStatus_value = map[string]int32{
+	"TX_STATUS_UNSPECIFIED": 0,
+	"TX_STATUS_PENDING":     1,
+	"TX_STATUS_SUCCESS":     2,
+	"TX_STATUS_ERROR":       3,
+	"TX_STATUS_TIMEOUT":     4,
+}
+
+func (x TxStatus) String() string {
+	return proto.EnumName(TxStatus_name, int32(x))
+}
+
+func (TxStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8bdf2ec647af2460, []int{0}
+}
+
+// RegisteredAccount is an interchain account registered by governance.
+type RegisteredAccount struct {
+	// connection_id is the controller side connection of the account.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// port_id is the controller port owned by the governance authority.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// registered_height is the block height the registration was executed at.
+	RegisteredHeight int64 `protobuf:"varint,3,opt,name=registered_height,json=registeredHeight,proto3" json:"registered_height,omitempty"`
+}
+
+func (m *RegisteredAccount) Reset()         { *m = RegisteredAccount{} }
+func (m *RegisteredAccount) String() string { return proto.CompactTextString(m) }
+func (*RegisteredAccount) ProtoMessage()    {}
+func (*RegisteredAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bdf2ec647af2460, []int{0}
+}
+func (m *RegisteredAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredAccount.Merge(m, src)
+}
+func (m *RegisteredAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredAccount proto.InternalMessageInfo
+
+func (m *RegisteredAccount) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *RegisteredAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *RegisteredAccount) GetRegisteredHeight() int64 {
+	if m != nil {
+		return m.RegisteredHeight
+	}
+	return 0
+}
+
+// TxRecord is an entry in the history of transactions sent by governance.
+type TxRecord struct {
+	// id is the unique, increasing identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// connection_id is the controller side connection the tx was sent on.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// channel_id is the channel the packet was sent on.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the packet sequence.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// msgs are the messages executed by the interchain account.
+	Msgs []*types.Any `protobuf:"bytes,5,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// memo is the memo of the packet data.
+	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	// timeout_timestamp is the absolute packet timeout in unix nanoseconds.
+	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// submit_height is the block height the tx was sent at.
+	SubmitHeight int64 `protobuf:"varint,8,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// submit_time is the block time the tx was sent at.
+	SubmitTime time.Time `protobuf:"bytes,9,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time"`
+	// status is the current status of the tx.
+	Status TxStatus `protobuf:"varint,10,opt,name=status,proto3,enum=enoki.govica.v1.TxStatus" json:"status,omitempty"`
+	// result holds the acknowledgement result or error.
+	Result string `protobuf:"bytes,11,opt,name=result,proto3" json:"result,omitempty"`
+	// completed_height is the block height the ack or timeout was processed at.
+	CompletedHeight int64 `protobuf:"varint,12,opt,name=completed_height,json=completedHeight,proto3" json:"completed_height,omitempty"`
+}
+
+func (m *TxRecord) Reset()         { *m = TxRecord{} }
+func (m *TxRecord) String() string { return proto.CompactTextString(m) }
+func (*TxRecord) ProtoMessage()    {}
+func (*TxRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8bdf2ec647af2460, []int{1}
+}
+func (m *TxRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxRecord.Merge(m, src)
+}
+func (m *TxRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxRecord proto.InternalMessageInfo
+
+func (m *TxRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TxRecord) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *TxRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TxRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TxRecord) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *TxRecord) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *TxRecord) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (m *TxRecord) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *TxRecord) GetSubmitTime() time.Time {
+	if m != nil {
+		return m.SubmitTime
+	}
+	return time.Time{}
+}
+
+func (m *TxRecord) GetStatus() TxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TX_STATUS_UNSPECIFIED
+}
+
+func (m *TxRecord) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *TxRecord) GetCompletedHeight() int64 {
+	if m != nil {
+		return m.CompletedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("enoki.govica.v1.TxStatus", TxStatus_name, TxStatus_value)
+	proto.RegisterType((*RegisteredAccount)(nil), "enoki.govica.v1.RegisteredAccount")
+	proto.RegisterType((*TxRecord)(nil), "enoki.govica.v1.TxRecord")
+}
+
+func init() { proto.RegisterFile("enoki/govica/v1/govica.proto", fileDescriptor_8bdf2ec647af2460) }
+
+var fileDescriptor_8bdf2ec647af2460 = []byte{
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xb5, 0x63, 0x7f, 0x69, 0x3a, 0xe9, 0xd7, 0xba, 0x43, 0x0b, 0x6e, 0x04, 0x6e, 0x54, 0x36,
+	0x06, 0x84, 0x4d, 0xcb, 0x13, 0xf4, 0xc7, 0x80, 0x91, 0x48, 0x2b, 0xdb, 0x91, 0x10, 0x9b, 0xc8,
+	0x19, 0x0f, 0xb6, 0x45, 0xec, 0x31, 0x9e, 0x71, 0x94, 0x6c, 0xd8, 0x21, 0xb1, 0xec, 0x3b, 0xf0,
+	0x30, 0x74, 0xd9, 0x25, 0x2b, 0x40, 0xc9, 0x8b, 0x20, 0x8f, 0x9d, 0x44, 0x6d, 0xd9, 0xdd, 0x7b,
+	0xce, 0x99, 0x3b, 0x67, 0xce, 0xcc, 0x80, 0x87, 0x38, 0x25, 0x9f, 0x62, 0x33, 0x24, 0xe3, 0x18,
+	0xf9, 0xe6, 0xf8, 0xb0, 0xae, 0x8c, 0x2c, 0x27, 0x8c, 0xc0, 0x2d, 0xce, 0x1a, 0x35, 0x36, 0x3e,
+	0xec, 0xec, 0x84, 0x24, 0x24, 0x9c, 0x33, 0xcb, 0xaa, 0x92, 0x75, 0xf6, 0x42, 0x42, 0xc2, 0x11,
+	0x36, 0x79, 0x37, 0x2c, 0x3e, 0x9a, 0x7e, 0x3a, 0xad, 0xa9, 0xfd, 0xdb, 0x14, 0x8b, 0x13, 0x4c,
+	0x99, 0x9f, 0x64, 0x95, 0xe0, 0xe0, 0x0b, 0xd8, 0x76, 0x70, 0x18, 0x53, 0x86, 0x73, 0x1c, 0x1c,
+	0x23, 0x44, 0x8a, 0x94, 0xc1, 0xc7, 0xe0, 0x7f, 0x44, 0xd2, 0x14, 0x23, 0x16, 0x93, 0x74, 0x10,
+	0x07, 0xaa, 0xd8, 0x15, 0xf5, 0x75, 0x67, 0x63, 0x05, 0xda, 0x01, 0x7c, 0x00, 0xd6, 0x32, 0x92,
+	0xb3, 0x92, 0x6e, 0x70, 0xba, 0x59, 0xb6, 0x76, 0x00, 0x9f, 0x81, 0xed, 0x7c, 0x39, 0x72, 0x10,
+	0xe1, 0x38, 0x8c, 0x98, 0x2a, 0x75, 0x45, 0x5d, 0x72, 0x94, 0x15, 0xf1, 0x86, 0xe3, 0x07, 0x3f,
+	0x24, 0xd0, 0xf2, 0x26, 0x0e, 0x46, 0x24, 0x0f, 0xe0, 0x26, 0x68, 0xd4, 0x9b, 0xc9, 0x4e, 0x23,
+	0x0e, 0xee, 0xfa, 0x68, 0xfc, 0xc3, 0xc7, 0x23, 0x00, 0x50, 0xe4, 0xa7, 0x29, 0x1e, 0x95, 0x0a,
+	0x89, 0x2b, 0xd6, 0x6b, 0xc4, 0x0e, 0x60, 0x07, 0xb4, 0x28, 0xfe, 0x5c, 0xe0, 0x14, 0x61, 0x55,
+	0xe6, 0x93, 0x97, 0x3d, 0xd4, 0x81, 0x9c, 0xd0, 0x90, 0xaa, 0xff, 0x75, 0x25, 0xbd, 0x7d, 0xb4,
+	0x63, 0x54, 0x61, 0x19, 0x8b, 0xb0, 0x8c, 0xe3, 0x74, 0xea, 0x70, 0x05, 0x84, 0x40, 0x4e, 0x70,
+	0x42, 0xd4, 0x26, 0x1f, 0xcf, 0xeb, 0xf2, 0x9c, 0x65, 0x9a, 0xa4, 0x60, 0x83, 0x65, 0xaa, 0xea,
+	0x1a, 0xdf, 0x42, 0xa9, 0x09, 0x6f, 0x81, 0x97, 0x47, 0xa1, 0xc5, 0x30, 0x89, 0xd9, 0x22, 0x90,
+	0x16, 0x0f, 0x64, 0xa3, 0x02, 0xab, 0x30, 0xa0, 0x05, 0xda, 0xb5, 0xa8, 0x5c, 0xaf, 0xae, 0x77,
+	0x45, 0xbd, 0x7d, 0xd4, 0xb9, 0x63, 0x6b, 0x39, 0xf5, 0xa4, 0x75, 0xf5, 0x6b, 0x5f, 0xb8, 0xfc,
+	0xbd, 0x2f, 0x3a, 0xa0, 0x5a, 0x58, 0x52, 0xf0, 0x10, 0x34, 0x29, 0xf3, 0x59, 0x41, 0x55, 0xd0,
+	0x15, 0xf5, 0xcd, 0xa3, 0x3d, 0xe3, 0xd6, 0x3b, 0x32, 0xbc, 0x89, 0xcb, 0x05, 0x4e, 0x2d, 0x84,
+	0xf7, 0x41, 0x33, 0xc7, 0xb4, 0x18, 0x31, 0xb5, 0x5d, 0xdd, 0x65, 0xd5, 0xc1, 0x27, 0x40, 0x41,
+	0x24, 0xc9, 0x46, 0x98, 0xad, 0xae, 0x72, 0x83, 0x3b, 0xdf, 0x5a, 0xe2, 0x95, 0xf9, 0xa7, 0x5f,
+	0x45, 0xd0, 0x5a, 0xcc, 0x85, 0x7b, 0x60, 0xd7, 0x7b, 0x3f, 0x70, 0xbd, 0x63, 0xaf, 0xef, 0x0e,
+	0xfa, 0x3d, 0xf7, 0xc2, 0x3a, 0xb5, 0x5f, 0xd9, 0xd6, 0x99, 0x22, 0xc0, 0x5d, 0xb0, 0xbd, 0xa2,
+	0x2e, 0xac, 0xde, 0x99, 0xdd, 0x7b, 0xad, 0x88, 0x37, 0x61, 0xb7, 0x7f, 0x7a, 0x6a, 0xb9, 0xae,
+	0xd2, 0x80, 0xf7, 0xc0, 0xd6, 0x0a, 0xb6, 0x1c, 0xe7, 0xdc, 0x51, 0xa4, 0x9b, 0x5a, 0xcf, 0x7e,
+	0x67, 0x9d, 0xf7, 0x3d, 0x45, 0xee, 0xc8, 0xdf, 0xbe, 0x6b, 0xc2, 0xc9, 0xdb, 0xab, 0x99, 0x26,
+	0x5e, 0xcf, 0x34, 0xf1, 0xcf, 0x4c, 0x13, 0x2f, 0xe7, 0x9a, 0x70, 0x3d, 0xd7, 0x84, 0x9f, 0x73,
+	0x4d, 0xf8, 0xf0, 0x22, 0x8c, 0x59, 0x54, 0x0c, 0x0d, 0x44, 0x12, 0x33, 0x9a, 0x66, 0x91, 0x8f,
+	0x08, 0xc9, 0x4c, 0x44, 0x68, 0x42, 0xe8, 0xf3, 0xea, 0x23, 0x4e, 0x16, 0x5f, 0x91, 0x4d, 0x33,
+	0x4c, 0x87, 0x4d, 0x9e, 0xf9, 0xcb, 0xbf, 0x03, 0x00, 0xf0, 0x1f, 0x8a, 0xd3, 0xa7, 0x03, 0x00,
+	0x00,
+}
+
+func (m *RegisteredAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegisteredHeight != 0 {
+		i = encodeVarintGovica(dAtA, i, uint64(m.RegisteredHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGovica(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGovica(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedHeight != 0 {
+		i = encodeVarintGovica(dAtA, i, uint64(m.CompletedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintGovica(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Status != 0 {
+		i = encodeVarintGovica(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x50
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGovica(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.SubmitHeight != 0 {
+		i = encodeVarintGovica(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintGovica(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintGovica(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGovica(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGovica(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGovica(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGovica(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintGovica(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGovica(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGovica(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RegisteredAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGovica(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGovica(uint64(l))
+	}
+	if m.RegisteredHeight != 0 {
+		n += 1 + sovGovica(uint64(m.RegisteredHeight))
+	}
+	return n
+}
+
+func (m *TxRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGovica(uint64(m.Id))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGovica(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGovica(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGovica(uint64(m.Sequence))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovGovica(uint64(l))
+		}
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovGovica(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovGovica(uint64(m.TimeoutTimestamp))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovGovica(uint64(m.SubmitHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime)
+	n += 1 + l + sovGovica(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovGovica(uint64(m.Status))
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovGovica(uint64(l))
+	}
+	if m.CompletedHeight != 0 {
+		n += 1 + sovGovica(uint64(m.CompletedHeight))
+	}
+	return n
+}
+
+func sovGovica(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGovica(x uint64) (n int) {
+	return sovGovica(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RegisteredAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovica
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovica
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovica
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredHeight", wireType)
+			}
+			m.RegisteredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovica(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGovica
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovica
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovica
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovica
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovica
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGovica
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGovica
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedHeight", wireType)
+			}
+			m.CompletedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGovica(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGovica
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGovica(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGovica
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGovica
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGovica
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGovica
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGovica
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGovica        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGovica          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGovica = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "govica"
+
+	// StoreKey defines the primary module store key. It cannot start with
+	// the module name as store keys may not share a prefix with "gov".
+	StoreKey = "icagov"
+)
+
+var (
+	// AccountsKey indexes registered accounts by connection id.
+	AccountsKey = collections.NewPrefix(0)
+	// TxRecordsKey indexes submitted transactions by id.
+	TxRecordsKey = collections.NewPrefix(1)
+	// PacketIndexKey maps a (channel id, sequence) pair to a transaction id.
+	PacketIndexKey = collections.NewPrefix(2)
+	// NextTxIDKey holds the id assigned to the next submitted transaction.
+	NextTxIDKey = collections.NewPrefix(3)
+)
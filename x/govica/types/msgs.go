@@ -0,0 +1,107 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// MaxTimeout bounds how far in the future an interchain account packet may
+// time out.
+const MaxTimeout = 14 * 24 * time.Hour
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSubmitTx{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount instance.
+func NewMsgRegisterInterchainAccount(authority, connectionID, version string, ordering channeltypes.Order) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		Authority:    authority,
+		ConnectionId: connectionID,
+		Version:      version,
+		Ordering:     ordering,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgRegisterInterchainAccount.
+func (msg *MsgRegisterInterchainAccount) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := validateConnectionID(msg.ConnectionId); err != nil {
+		return err
+	}
+	switch msg.Ordering {
+	case channeltypes.NONE, channeltypes.ORDERED, channeltypes.UNORDERED:
+	default:
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "unknown ordering %s", msg.Ordering)
+	}
+	return nil
+}
+
+// NewMsgSubmitTx creates a new MsgSubmitTx instance.
+func NewMsgSubmitTx(authority, connectionID string, msgs []sdk.Msg, memo string, timeout time.Duration) (*MsgSubmitTx, error) {
+	anys, err := packMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitTx{
+		Authority:    authority,
+		ConnectionId: connectionID,
+		Msgs:         anys,
+		Memo:         memo,
+		Timeout:      timeout,
+	}, nil
+}
+
+// ValidateBasic performs stateless validation of MsgSubmitTx.
+func (msg *MsgSubmitTx) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := validateConnectionID(msg.ConnectionId); err != nil {
+		return err
+	}
+	if len(msg.Msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msgs cannot be empty")
+	}
+	for i, m := range msg.Msgs {
+		if m == nil || m.TypeUrl == "" {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "msg %d has no type url", i)
+		}
+	}
+	if msg.Timeout <= 0 || msg.Timeout > MaxTimeout {
+		return errorsmod.Wrapf(ErrInvalidTimeout, "timeout must be between 0 and %s, got %s", MaxTimeout, msg.Timeout)
+	}
+	return nil
+}
+
+func validateConnectionID(connectionID string) error {
+	if !connectiontypes.IsValidConnectionID(connectionID) {
+		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionIdentifier, "invalid connection id %q", connectionID)
+	}
+	return nil
+}
+
+// packMsgs wraps msgs in Any. The packed messages are never unpacked on this
+// chain since they may be types only known to the host chain.
+func packMsgs(msgs []sdk.Msg) ([]*codectypes.Any, error) {
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		a, err := codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+		anys[i] = a
+	}
+	return anys, nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	queryv1 "cosmossdk.io/api/cosmos/query/v1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/lightclientquerier/types"
+)
+
+// Keeper stores the queries 08-wasm light clients may call.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	queryRouter types.QueryRouter
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema    collections.Schema
+	Allowlist collections.Item[types.Params]
+}
+
+// NewKeeper returns a new lightclientquerier keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	queryRouter types.QueryRouter,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		queryRouter:  queryRouter,
+		authority:    authority,
+
+		Allowlist: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ValidateParams checks that every accepted query is routed by the query
+// router and deterministic.
+func (k Keeper) ValidateParams(params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	for _, path := range params.AcceptList {
+		if k.queryRouter.Route(path) == nil {
+			return errorsmod.Wrap(types.ErrUnknownQuery, path)
+		}
+		if !IsDeterministicQuery(path) {
+			return errorsmod.Wrap(types.ErrNotDeterministic, path)
+		}
+	}
+	return nil
+}
+
+// IsDeterministicQuery returns whether the query at path is annotated with
+// the module_query_safe option or one of the known deterministic queries.
+func IsDeterministicQuery(path string) bool {
+	for _, known := range types.KnownDeterministicQueries {
+		if known == path {
+			return true
+		}
+	}
+
+	fullName, err := types.MethodFullName(path)
+	if err != nil {
+		return false
+	}
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName(protoreflect.FullName(fullName))
+	if err != nil {
+		return false
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false
+	}
+
+	safe, ok := proto.GetExtension(method.Options(), queryv1.E_ModuleQuerySafe).(bool)
+	return ok && safe
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	_ "github.com/cosmos/cosmos-sdk/x/bank/types"
+	_ "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	_ "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	_ "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/lightclientquerier"
+	"github.com/hyphacoop/cosmos-enoki/x/lightclientquerier/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/lightclientquerier/types"
+)
+
+const (
+	balancePath   = "/cosmos.bank.v1beta1.Query/Balance"
+	proposalsPath = "/cosmos.gov.v1.Query/Proposals"
+)
+
+// mockQueryRouter routes every registered path to a handler echoing the path.
+type mockQueryRouter struct {
+	routes map[string]bool
+}
+
+func (m mockQueryRouter) Route(path string) baseapp.GRPCQueryHandler {
+	if !m.routes[path] {
+		return nil
+	}
+	return func(_ sdk.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+		return &abci.ResponseQuery{Value: []byte(req.Path)}, nil
+	}
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(lightclientquerier.AppModuleBasic{})
+
+	router := mockQueryRouter{routes: map[string]bool{balancePath: true, proposalsPath: true}}
+	for _, path := range types.DefaultParams().AcceptList {
+		router.routes[path] = true
+	}
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), router, authtypes.NewModuleAddress("gov").String())
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesis()))
+	return ctx, k
+}
+
+func TestIsDeterministicQuery(t *testing.T) {
+	for _, path := range types.DefaultParams().AcceptList {
+		require.True(t, keeper.IsDeterministicQuery(path), path)
+	}
+	require.True(t, keeper.IsDeterministicQuery(balancePath))
+	require.False(t, keeper.IsDeterministicQuery(proposalsPath))
+	require.False(t, keeper.IsDeterministicQuery("/cosmos.bank.v1beta1.Query/Unknown"))
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress("gov").String()
+
+	testCases := []struct {
+		name       string
+		authority  string
+		acceptList []string
+		expErr     error
+	}{
+		{"invalid authority", authtypes.NewModuleAddress("other").String(), []string{balancePath}, types.ErrInvalidAuthority},
+		{"invalid path", authority, []string{"cosmos.bank.v1beta1.Query/Balance"}, types.ErrInvalidParams},
+		{"duplicate path", authority, []string{balancePath, balancePath}, types.ErrInvalidParams},
+		{"unrouted query", authority, []string{"/cosmos.bank.v1beta1.Query/SpendableBalances"}, types.ErrUnknownQuery},
+		{"non deterministic query", authority, []string{proposalsPath}, types.ErrNotDeterministic},
+		{"success", authority, []string{balancePath}, nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.UpdateParams(ctx, types.NewMsgUpdateParams(tc.authority, types.Params{AcceptList: tc.acceptList}))
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			res, err := k.Params(ctx, &types.QueryParamsRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tc.acceptList, res.Params.AcceptList)
+		})
+	}
+}
+
+func TestStargateQuerier(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	querier := k.StargateQuerier()
+
+	res, err := querier(ctx, &wasmvmtypes.StargateQuery{Path: "/ibc.core.client.v1.Query/ClientState"})
+	require.NoError(t, err)
+	require.Equal(t, []byte("/ibc.core.client.v1.Query/ClientState"), res)
+
+	_, err = querier(ctx, &wasmvmtypes.StargateQuery{Path: balancePath})
+	require.ErrorAs(t, err, &wasmvmtypes.UnsupportedRequest{})
+
+	// governance updates apply to the next query
+	params := types.DefaultParams()
+	params.AcceptList = append(params.AcceptList, balancePath)
+	_, err = keeper.NewMsgServerImpl(k).UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.NoError(t, err)
+
+	res, err = querier(ctx, &wasmvmtypes.StargateQuery{Path: balancePath})
+	require.NoError(t, err)
+	require.Equal(t, []byte(balancePath), res)
+}
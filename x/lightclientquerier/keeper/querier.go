@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StargateQuerier returns the 08-wasm stargate query plugin. The accept list
+// is read from the store on every query so governance updates apply without
+// a restart. Responses are protobuf encoded.
+func (k Keeper) StargateQuerier() func(sdk.Context, *wasmvmtypes.StargateQuery) ([]byte, error) {
+	return func(ctx sdk.Context, request *wasmvmtypes.StargateQuery) ([]byte, error) {
+		params, err := k.Allowlist.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(params.AcceptList, request.Path) {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Path)}
+		}
+
+		route := k.queryRouter.Route(request.Path)
+		if route == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Path)}
+		}
+
+		res, err := route(ctx, &abci.RequestQuery{
+			Data: request.Data,
+			Path: request.Path,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if res == nil || res.Value == nil {
+			return nil, wasmvmtypes.InvalidResponse{Err: "Query response is empty"}
+		}
+
+		return res.Value, nil
+	}
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidAuthority = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrInvalidParams    = errorsmod.Register(ModuleName, 3, "invalid params")
+	ErrUnknownQuery     = errorsmod.Register(ModuleName, 4, "query is not registered in the gRPC query router")
+	ErrNotDeterministic = errorsmod.Register(ModuleName, 5, "query is not known to be deterministic")
+)
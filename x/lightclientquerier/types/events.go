@@ -0,0 +1,8 @@
+package types
+
+// lightclientquerier module event types and attribute keys
+const (
+	EventTypeUpdateParams = "light_client_accept_list_updated"
+
+	AttributeKeyAcceptList = "accept_list"
+)
@@ -0,0 +1,11 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// QueryRouter defines the gRPC query router used to validate and serve the
+// accepted queries.
+type QueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// KnownDeterministicQueries are queries that are deterministic and track
+// their gas usage but are not annotated with the module_query_safe option.
+var KnownDeterministicQueries = []string{
+	"/ibc.core.client.v1.Query/ClientState",
+	"/ibc.core.client.v1.Query/ConsensusState",
+	"/ibc.core.connection.v1.Query/Connection",
+}
+
+// DefaultParams returns the default parameters, the queries previously hard
+// coded for the 08-wasm querier.
+func DefaultParams() Params {
+	return Params{
+		AcceptList: []string{
+			"/ibc.core.client.v1.Query/VerifyMembership",
+			"/ibc.core.client.v1.Query/ClientState",
+			"/ibc.core.client.v1.Query/ConsensusState",
+			"/ibc.core.connection.v1.Query/Connection",
+		},
+	}
+}
+
+// Validate performs stateless validation of the parameters. Queries are
+// checked against the query router by the keeper.
+func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.AcceptList))
+	for _, path := range p.AcceptList {
+		if _, err := MethodFullName(path); err != nil {
+			return err
+		}
+		if _, ok := seen[path]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate query %s", path)
+		}
+		seen[path] = struct{}{}
+	}
+	return nil
+}
+
+// MethodFullName converts a gRPC query path like /pkg.Query/Method to the
+// protobuf full name pkg.Query.Method.
+func MethodFullName(path string) (string, error) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if !strings.HasPrefix(path, "/") || !ok || service == "" || method == "" || strings.ContainsAny(method, "/ ") || strings.Contains(service, " ") {
+		return "", errorsmod.Wrapf(ErrInvalidParams, "invalid query path %q, expected /{service}/{method}", path)
+	}
+	return service + "." + method, nil
+}
@@ -0,0 +1,28 @@
+package msgpause
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause/keeper"
+)
+
+// PauseDecorator rejects transactions with a paused message, nested messages
+// included, before their fees are charged.
+type PauseDecorator struct {
+	keeper keeper.Keeper
+}
+
+// NewPauseDecorator returns a new PauseDecorator.
+func NewPauseDecorator(keeper keeper.Keeper) PauseDecorator {
+	return PauseDecorator{
+		keeper: keeper,
+	}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d PauseDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.keeper.CheckMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
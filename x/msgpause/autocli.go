@@ -0,0 +1,55 @@
+package msgpause
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the guardians and the longest pause they may set",
+				},
+				{
+					RpcMethod: "Pauses",
+					Use:       "pauses",
+					Short:     "Query the paused message types",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Pause",
+					Use:            "pause [duration] [reason] [msg-type-url]...",
+					Short:          "Pause message types as a guardian",
+					Example:        `pause 24h "exploit in contract instantiation" /cosmwasm.wasm.v1.MsgInstantiateContract /cosmwasm.wasm.v1.MsgExecuteContract --from guardian`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "duration"}, {ProtoField: "reason"}, {ProtoField: "msg_type_urls", Varargs: true}},
+				},
+				{
+					RpcMethod:      "Unpause",
+					Use:            "unpause [msg-type-url]...",
+					Short:          "Lift the pauses of message types as a guardian",
+					Example:        "unpause /cosmwasm.wasm.v1.MsgExecuteContract --from guardian",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_urls", Varargs: true}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params [params]",
+					Short:          "Submit a proposal to replace the guardians and the longest pause they may set",
+					Example:        `update-params '{"guardians":["enoki1..."],"max_pause_duration":"604800s"}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
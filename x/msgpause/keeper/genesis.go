@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.ParamsStore.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+	for _, pause := range genState.Pauses {
+		if err := k.PausedMsgs.Set(ctx, pause.MsgTypeUrl, pause); err != nil {
+			return err
+		}
+	}
+	for _, cooldown := range genState.Cooldowns {
+		if err := k.Cooldowns.Set(ctx, cooldown.MsgTypeUrl, cooldown); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pauses := []types.Pause{}
+	err = k.PausedMsgs.Walk(ctx, nil, func(_ string, pause types.Pause) (bool, error) {
+		pauses = append(pauses, pause)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cooldowns := []types.Cooldown{}
+	err = k.Cooldowns.Walk(ctx, nil, func(_ string, cooldown types.Cooldown) (bool, error) {
+		cooldowns = append(cooldowns, cooldown)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GenesisState{Params: params, Pauses: pauses, Cooldowns: cooldowns}, nil
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the guardians and the longest pause they may set.
+func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Pauses returns the pauses that have not expired.
+func (k Keeper) Pauses(ctx context.Context, req *types.QueryPausesRequest) (*types.QueryPausesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	pauses := []types.Pause{}
+	err := k.PausedMsgs.Walk(ctx, nil, func(_ string, pause types.Pause) (bool, error) {
+		if blockTime.Before(pause.ExpiresAt) {
+			pauses = append(pauses, pause)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPausesResponse{Pauses: pauses}, nil
+}
@@ -0,0 +1,292 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause/types"
+)
+
+var _ baseapp.CircuitBreaker = Keeper{}
+
+// Keeper stores the guardians and the paused message types.
+type Keeper struct {
+	cdc          codec.Codec
+	storeService store.KVStoreService
+
+	// the address capable of executing a MsgUpdateParams message and of
+	// renewing pauses. Typically, this should be the x/gov module account.
+	authority string
+
+	Schema      collections.Schema
+	ParamsStore collections.Item[types.Params]
+	PausedMsgs  collections.Map[string, types.Pause]
+	Cooldowns   collections.Map[string, types.Cooldown]
+}
+
+// NewKeeper returns a new msgpause keeper. The codec resolves the paused
+// message types.
+func NewKeeper(
+	cdc codec.Codec,
+	storeService store.KVStoreService,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+
+		ParamsStore: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		PausedMsgs:  collections.NewMap(sb, types.PausesKey, "pauses", collections.StringKey, codec.CollValue[types.Pause](cdc)),
+		Cooldowns:   collections.NewMap(sb, types.CooldownsKey, "cooldowns", collections.StringKey, codec.CollValue[types.Cooldown](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ActivePause returns the pause of the message type if it has not expired.
+func (k Keeper) ActivePause(ctx context.Context, typeURL string) (types.Pause, bool, error) {
+	pause, err := k.PausedMsgs.Get(ctx, typeURL)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Pause{}, false, nil
+	}
+	if err != nil {
+		return types.Pause{}, false, err
+	}
+	return pause, sdk.UnwrapSDKContext(ctx).BlockTime().Before(pause.ExpiresAt), nil
+}
+
+// IsAllowed implements baseapp.CircuitBreaker, so the message router refuses
+// paused messages wherever they come from, like authz, contracts or
+// interchain accounts.
+func (k Keeper) IsAllowed(ctx context.Context, typeURL string) (bool, error) {
+	_, paused, err := k.ActivePause(ctx, typeURL)
+	return !paused, err
+}
+
+// CheckMsgs returns an error if a message, or a message nested in it like
+// the messages of an authz MsgExec, is paused.
+func (k Keeper) CheckMsgs(ctx context.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if nested, ok := msg.(interface{ GetMessages() ([]sdk.Msg, error) }); ok {
+			nestedMsgs, err := nested.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := k.CheckMsgs(ctx, nestedMsgs); err != nil {
+				return err
+			}
+		}
+
+		typeURL := sdk.MsgTypeURL(msg)
+		pause, paused, err := k.ActivePause(ctx, typeURL)
+		if err != nil {
+			return err
+		}
+		if paused {
+			return errorsmod.Wrapf(types.ErrMsgPaused, "%s until %s: %s", typeURL, pause.ExpiresAt.Format(time.RFC3339), pause.Reason)
+		}
+	}
+	return nil
+}
+
+// Pause pauses the message types for the duration. Guardians may pause up to
+// the max pause duration and may not renew a pause, nor pause the message
+// type again until the cooldown after their pause ends. Governance may do
+// all of these.
+func (k Keeper) Pause(ctx sdk.Context, sender string, typeURLs []string, duration time.Duration, reason string) error {
+	isGov, err := k.checkSender(ctx, sender)
+	if err != nil {
+		return err
+	}
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !isGov {
+		if duration > params.MaxPauseDuration {
+			return errorsmod.Wrapf(types.ErrInvalidPause, "guardians may pause for at most %s, got %s", params.MaxPauseDuration, duration)
+		}
+	}
+
+	expiresAt := ctx.BlockTime().Add(duration)
+	for _, typeURL := range typeURLs {
+		if err := k.validateMsgType(typeURL); err != nil {
+			return err
+		}
+		existing, paused, err := k.ActivePause(ctx, typeURL)
+		if err != nil {
+			return err
+		}
+		if paused && !isGov {
+			return errorsmod.Wrapf(types.ErrInvalidPause, "%s is paused until %s, only governance renews pauses", typeURL, existing.ExpiresAt.Format(time.RFC3339))
+		}
+		if !isGov {
+			cooldown, err := k.Cooldowns.Get(ctx, typeURL)
+			if err != nil && !errors.Is(err, collections.ErrNotFound) {
+				return err
+			}
+			if err == nil && ctx.BlockTime().Before(cooldown.Until) {
+				return errorsmod.Wrapf(types.ErrInvalidPause, "guardians may pause %s again from %s, only governance extends pauses", typeURL, cooldown.Until.Format(time.RFC3339))
+			}
+			// the cooldown runs from the planned end of the pause, lifting it
+			// early does not shorten it
+			cooldown = types.Cooldown{MsgTypeUrl: typeURL, Until: expiresAt.Add(params.PauseCooldown)}
+			if err := k.Cooldowns.Set(ctx, typeURL, cooldown); err != nil {
+				return err
+			}
+		}
+
+		pause := types.Pause{MsgTypeUrl: typeURL, PausedBy: sender, ExpiresAt: expiresAt, Reason: reason}
+		if err := k.PausedMsgs.Set(ctx, typeURL, pause); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("paused message type", "msg_type_url", typeURL, "paused_by", sender, "expires_at", expiresAt, "reason", reason)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePause,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, expiresAt.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		))
+	}
+	return nil
+}
+
+// Unpause lifts the pauses of the message types. Guardians may not lift the
+// pauses set by governance.
+func (k Keeper) Unpause(ctx sdk.Context, sender string, typeURLs []string) error {
+	isGov, err := k.checkSender(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	for _, typeURL := range typeURLs {
+		pause, paused, err := k.ActivePause(ctx, typeURL)
+		if err != nil {
+			return err
+		}
+		if !paused {
+			return errorsmod.Wrapf(types.ErrInvalidPause, "%s is not paused", typeURL)
+		}
+		if pause.PausedBy == k.authority && !isGov {
+			return errorsmod.Wrapf(types.ErrUnauthorized, "only governance lifts the pause of %s it set", typeURL)
+		}
+
+		if err := k.PausedMsgs.Remove(ctx, typeURL); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("unpaused message type", "msg_type_url", typeURL, "unpaused_by", sender)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeUnpause,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+		))
+	}
+	return nil
+}
+
+// ExpirePauses removes the pauses that expired and the cooldowns that ended
+// by the block time.
+func (k Keeper) ExpirePauses(ctx sdk.Context) error {
+	var expired []types.Pause
+	err := k.PausedMsgs.Walk(ctx, nil, func(_ string, pause types.Pause) (bool, error) {
+		if !ctx.BlockTime().Before(pause.ExpiresAt) {
+			expired = append(expired, pause)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pause := range expired {
+		if err := k.PausedMsgs.Remove(ctx, pause.MsgTypeUrl); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("message type pause expired", "msg_type_url", pause.MsgTypeUrl)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypePauseExpired,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, pause.MsgTypeUrl),
+			sdk.NewAttribute(types.AttributeKeySender, pause.PausedBy),
+		))
+	}
+
+	var ended []string
+	err = k.Cooldowns.Walk(ctx, nil, func(typeURL string, cooldown types.Cooldown) (bool, error) {
+		if !ctx.BlockTime().Before(cooldown.Until) {
+			ended = append(ended, typeURL)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, typeURL := range ended {
+		if err := k.Cooldowns.Remove(ctx, typeURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSender returns whether the sender is the governance account, or an
+// error if it is not a guardian either.
+func (k Keeper) checkSender(ctx context.Context, sender string) (bool, error) {
+	if sender == k.authority {
+		return true, nil
+	}
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !params.IsGuardian(sender) {
+		return false, errorsmod.Wrap(types.ErrUnauthorized, sender)
+	}
+	return false, nil
+}
+
+// validateMsgType checks that the type url names a registered message that
+// may be paused, so a typo does not leave the intended message running.
+func (k Keeper) validateMsgType(typeURL string) error {
+	if err := types.ValidatePausable(typeURL); err != nil {
+		return err
+	}
+	msg, err := k.cdc.InterfaceRegistry().Resolve(typeURL)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidPause, "%s: %s", typeURL, err)
+	}
+	if _, ok := msg.(sdk.Msg); !ok {
+		return errorsmod.Wrapf(types.ErrInvalidPause, "%s is not a message", typeURL)
+	}
+	return nil
+}
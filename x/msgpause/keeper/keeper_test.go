@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause"
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause/types"
+)
+
+var (
+	authority = authtypes.NewModuleAddress("gov").String()
+	guardian  = sdk.AccAddress("guardian____________").String()
+	stranger  = sdk.AccAddress("stranger____________").String()
+
+	msgSend      = sdk.MsgTypeURL(&banktypes.MsgSend{})
+	msgMultiSend = sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	ctx = ctx.WithBlockTime(time.Unix(1_000_000, 0))
+	encCfg := moduletestutil.MakeTestEncodingConfig(msgpause.AppModuleBasic{}, bank.AppModuleBasic{}, authzmodule.AppModuleBasic{})
+
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authority)
+	genState := types.DefaultGenesis()
+	genState.Params.Guardians = []string{guardian}
+	genState.Params.MaxPauseDuration = time.Hour
+	genState.Params.PauseCooldown = 24 * time.Hour
+	require.NoError(t, k.InitGenesis(ctx, *genState))
+	return ctx, k
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.Params{Guardians: []string{guardian, stranger}, MaxPauseDuration: 2 * time.Hour, PauseCooldown: time.Hour}
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(guardian, params))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, types.Params{Guardians: []string{guardian}}))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
+
+func TestPause(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.Pause(ctx, types.NewMsgPause(stranger, []string{msgSend}, time.Minute, "incident"))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.Pause(ctx, types.NewMsgPause(guardian, []string{msgSend}, 2*time.Hour, "incident"))
+	require.ErrorIs(t, err, types.ErrInvalidPause)
+	_, err = msgServer.Pause(ctx, types.NewMsgPause(guardian, []string{"/cosmos.bank.v1beta1.MsgUnknown"}, time.Minute, "incident"))
+	require.ErrorIs(t, err, types.ErrInvalidPause)
+
+	_, err = msgServer.Pause(ctx, types.NewMsgPause(guardian, []string{msgSend}, time.Hour, "incident"))
+	require.NoError(t, err)
+	pause, paused, err := k.ActivePause(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, paused)
+	require.Equal(t, types.Pause{MsgTypeUrl: msgSend, PausedBy: guardian, ExpiresAt: ctx.BlockTime().Add(time.Hour), Reason: "incident"}, pause)
+
+	// guardians may not renew a pause, governance may, beyond the max duration
+	_, err = msgServer.Pause(ctx, types.NewMsgPause(guardian, []string{msgSend}, time.Hour, "renewed"))
+	require.ErrorIs(t, err, types.ErrInvalidPause)
+	_, err = msgServer.Pause(ctx, types.NewMsgPause(authority, []string{msgSend}, 24*time.Hour, "renewed"))
+	require.NoError(t, err)
+	pause, paused, err = k.ActivePause(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, paused)
+	require.Equal(t, authority, pause.PausedBy)
+	require.Equal(t, ctx.BlockTime().Add(24*time.Hour), pause.ExpiresAt)
+}
+
+func TestUnpause(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.Unpause(ctx, types.NewMsgUnpause(guardian, []string{msgSend}))
+	require.ErrorIs(t, err, types.ErrInvalidPause)
+
+	require.NoError(t, k.Pause(ctx, guardian, []string{msgSend}, time.Hour, ""))
+	require.NoError(t, k.Pause(ctx, authority, []string{msgMultiSend}, time.Hour, ""))
+	_, err = msgServer.Unpause(ctx, types.NewMsgUnpause(stranger, []string{msgSend}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.Unpause(ctx, types.NewMsgUnpause(guardian, []string{msgMultiSend}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.Unpause(ctx, types.NewMsgUnpause(guardian, []string{msgSend}))
+	require.NoError(t, err)
+	_, err = msgServer.Unpause(ctx, types.NewMsgUnpause(authority, []string{msgMultiSend}))
+	require.NoError(t, err)
+	res, err := k.Pauses(ctx, &types.QueryPausesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Pauses)
+}
+
+func TestCheckMsgs(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	send := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+	exec := authz.NewMsgExec(sdk.AccAddress("grantee"), []sdk.Msg{send})
+
+	require.NoError(t, k.CheckMsgs(ctx, []sdk.Msg{send, &exec}))
+	allowed, err := k.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	require.NoError(t, k.Pause(ctx, guardian, []string{msgSend}, time.Hour, "incident"))
+	require.ErrorIs(t, k.CheckMsgs(ctx, []sdk.Msg{send}), types.ErrMsgPaused)
+	require.ErrorIs(t, k.CheckMsgs(ctx, []sdk.Msg{&exec}), types.ErrMsgPaused)
+	allowed, err = k.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	allowed, err = k.IsAllowed(ctx, msgMultiSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestExpirePauses(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	require.NoError(t, k.Pause(ctx, guardian, []string{msgSend}, time.Minute, ""))
+	require.NoError(t, k.Pause(ctx, guardian, []string{msgMultiSend}, time.Hour, ""))
+
+	// an expired pause stops applying before it is removed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	allowed, err := k.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+	res, err := k.Pauses(ctx, &types.QueryPausesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Pauses, 1)
+	require.Equal(t, msgMultiSend, res.Pauses[0].MsgTypeUrl)
+
+	require.NoError(t, k.ExpirePauses(ctx))
+	has, err := k.PausedMsgs.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = k.PausedMsgs.Has(ctx, msgMultiSend)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	genState, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Len(t, genState.Pauses, 1)
+	require.Len(t, genState.Cooldowns, 2)
+}
+
+func TestPauseCooldown(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	start := ctx.BlockTime()
+	require.NoError(t, k.Pause(ctx, guardian, []string{msgSend}, time.Hour, ""))
+
+	// guardians may not chain pauses once one expires, nor after lifting it
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	require.NoError(t, k.ExpirePauses(ctx))
+	has, err := k.PausedMsgs.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.ErrorIs(t, k.Pause(ctx, guardian, []string{msgSend}, time.Hour, ""), types.ErrInvalidPause)
+	require.NoError(t, k.Pause(ctx, guardian, []string{msgMultiSend}, time.Hour, ""))
+	require.NoError(t, k.Unpause(ctx, guardian, []string{msgMultiSend}))
+	require.ErrorIs(t, k.Pause(ctx, guardian, []string{msgMultiSend}, time.Hour, ""), types.ErrInvalidPause)
+
+	// governance may extend the pause
+	require.NoError(t, k.Pause(ctx, authority, []string{msgSend}, time.Hour, ""))
+	require.NoError(t, k.Unpause(ctx, authority, []string{msgSend}))
+
+	// the cooldown ends a day after the planned end of the guardian pause
+	ctx = ctx.WithBlockTime(start.Add(25*time.Hour - time.Second))
+	require.ErrorIs(t, k.Pause(ctx, guardian, []string{msgSend}, time.Hour, ""), types.ErrInvalidPause)
+	ctx = ctx.WithBlockTime(start.Add(25 * time.Hour))
+	require.NoError(t, k.ExpirePauses(ctx))
+	has, err = k.Cooldowns.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.NoError(t, k.Pause(ctx, guardian, []string{msgSend}, time.Hour, ""))
+}
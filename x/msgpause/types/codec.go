@@ -0,0 +1,42 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var amino = codec.NewLegacyAmino()
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register on the shared amino codec so the messages can be nested in
+	// authz and gov proposals.
+	RegisterLegacyAminoCodec(legacy.Cdc)
+
+	amino.Seal()
+}
+
+// RegisterInterfaces registers the module's interface types.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the module's concrete types on the
+// given amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgPause{}, "enoki/x/msgpause/MsgPause", nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, "enoki/x/msgpause/MsgUnpause", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "enoki/x/msgpause/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&Params{}, "enoki/x/msgpause/Params", nil)
+}
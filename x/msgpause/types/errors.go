@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidAuthority = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrInvalidParams    = errorsmod.Register(ModuleName, 3, "invalid params")
+	ErrInvalidGenesis   = errorsmod.Register(ModuleName, 4, "invalid genesis")
+	ErrUnauthorized     = errorsmod.Register(ModuleName, 5, "sender is neither a guardian nor the governance account")
+	ErrInvalidPause     = errorsmod.Register(ModuleName, 6, "invalid pause")
+	ErrMsgPaused        = errorsmod.Register(ModuleName, 7, "message type is paused")
+)
@@ -0,0 +1,15 @@
+package types
+
+// msgpause module event types and attribute keys
+const (
+	EventTypePause        = "message_paused"
+	EventTypeUnpause      = "message_unpaused"
+	EventTypePauseExpired = "message_pause_expired"
+	EventTypeUpdateParams = "message_pause_params_updated"
+
+	AttributeKeyMsgTypeURL = "msg_type_url"
+	AttributeKeySender     = "sender"
+	AttributeKeyExpiresAt  = "expires_at"
+	AttributeKeyReason     = "reason"
+	AttributeKeyGuardians  = "guardians"
+)
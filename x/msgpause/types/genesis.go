@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default msgpause genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		Pauses:    []Pause{},
+		Cooldowns: []Cooldown{},
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.Pauses))
+	for _, p := range gs.Pauses {
+		if err := ValidatePausable(p.MsgTypeUrl); err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesis, err.Error())
+		}
+		if _, err := sdk.AccAddressFromBech32(p.PausedBy); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid paused by address of %s: %s", p.MsgTypeUrl, err)
+		}
+		if _, ok := seen[p.MsgTypeUrl]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate pause of %s", p.MsgTypeUrl)
+		}
+		seen[p.MsgTypeUrl] = struct{}{}
+	}
+
+	seen = make(map[string]struct{}, len(gs.Cooldowns))
+	for _, c := range gs.Cooldowns {
+		if err := ValidatePausable(c.MsgTypeUrl); err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesis, err.Error())
+		}
+		if _, ok := seen[c.MsgTypeUrl]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate cooldown of %s", c.MsgTypeUrl)
+		}
+		seen[c.MsgTypeUrl] = struct{}{}
+	}
+	return nil
+}
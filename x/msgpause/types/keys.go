@@ -0,0 +1,22 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "msgpause"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey stores the module parameters.
+	ParamsKey = collections.NewPrefix(0)
+	// PausesKey stores the pauses by message type url.
+	PausesKey = collections.NewPrefix(1)
+	// CooldownsKey stores the cooldowns of the guardians by message type url.
+	CooldownsKey = collections.NewPrefix(2)
+)
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgPause{}
+	_ sdk.Msg = &MsgUnpause{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgPause creates a new MsgPause instance.
+func NewMsgPause(sender string, msgTypeURLs []string, duration time.Duration, reason string) *MsgPause {
+	return &MsgPause{
+		Sender:      sender,
+		MsgTypeUrls: msgTypeURLs,
+		Duration:    duration,
+		Reason:      reason,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgPause.
+func (msg *MsgPause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if msg.Duration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidPause, "duration must be positive, got %s", msg.Duration)
+	}
+	return validateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// NewMsgUnpause creates a new MsgUnpause instance.
+func NewMsgUnpause(sender string, msgTypeURLs []string) *MsgUnpause {
+	return &MsgUnpause{
+		Sender:      sender,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgUnpause.
+func (msg *MsgUnpause) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	return validateMsgTypeURLs(msg.MsgTypeUrls)
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgUpdateParams.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
+
+// validateMsgTypeURLs checks that the list holds unique pausable message
+// types.
+func validateMsgTypeURLs(msgTypeURLs []string) error {
+	if len(msgTypeURLs) == 0 {
+		return errorsmod.Wrap(ErrInvalidPause, "no message type urls")
+	}
+	seen := make(map[string]struct{}, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		if err := ValidatePausable(typeURL); err != nil {
+			return err
+		}
+		if _, ok := seen[typeURL]; ok {
+			return errorsmod.Wrapf(ErrInvalidPause, "duplicate message type url %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMaxPauseDuration leaves governance a week to renew a pause, enough
+// for an expedited proposal.
+const DefaultMaxPauseDuration = 7 * 24 * time.Hour
+
+// DefaultPauseCooldown keeps the guardians from pausing a message type again
+// for two weeks, the length of a governance vote on whether to renew it.
+const DefaultPauseCooldown = 14 * 24 * time.Hour
+
+// unpausablePrefixes are the message types that stay usable so governance
+// can always lift or renew pauses.
+var unpausablePrefixes = []string{
+	"/cosmos.gov.",
+	"/enoki." + ModuleName + ".",
+}
+
+// DefaultParams returns the default parameters. There are no guardians, so
+// only governance can pause messages until it appoints some.
+func DefaultParams() Params {
+	return Params{
+		Guardians:        []string{},
+		MaxPauseDuration: DefaultMaxPauseDuration,
+		PauseCooldown:    DefaultPauseCooldown,
+	}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.Guardians))
+	for _, guardian := range p.Guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid guardian address: %s", err)
+		}
+		if _, ok := seen[guardian]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate guardian %s", guardian)
+		}
+		seen[guardian] = struct{}{}
+	}
+	if p.MaxPauseDuration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "max pause duration must be positive, got %s", p.MaxPauseDuration)
+	}
+	if p.PauseCooldown <= 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "pause cooldown must be positive, got %s", p.PauseCooldown)
+	}
+	return nil
+}
+
+// IsGuardian returns whether addr is a guardian.
+func (p Params) IsGuardian(addr string) bool {
+	for _, guardian := range p.Guardians {
+		if guardian == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePausable returns an error if the message type url is malformed or
+// names a message that may not be paused.
+func ValidatePausable(typeURL string) error {
+	if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 || strings.TrimSpace(typeURL) != typeURL {
+		return errorsmod.Wrapf(ErrInvalidPause, "invalid message type url %q", typeURL)
+	}
+	for _, prefix := range unpausablePrefixes {
+		if strings.HasPrefix(typeURL, prefix) {
+			return errorsmod.Wrapf(ErrInvalidPause, "%s may not be paused", typeURL)
+		}
+	}
+	return nil
+}
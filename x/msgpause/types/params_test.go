@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/msgpause/types"
+)
+
+var guardian = sdk.AccAddress("guardian____________").String()
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		valid  bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"guardians", types.Params{Guardians: []string{guardian}, MaxPauseDuration: time.Hour, PauseCooldown: time.Hour}, true},
+		{"invalid guardian", types.Params{Guardians: []string{"guardian"}, MaxPauseDuration: time.Hour, PauseCooldown: time.Hour}, false},
+		{"duplicate guardian", types.Params{Guardians: []string{guardian, guardian}, MaxPauseDuration: time.Hour, PauseCooldown: time.Hour}, false},
+		{"no max pause duration", types.Params{Guardians: []string{guardian}, PauseCooldown: time.Hour}, false},
+		{"no pause cooldown", types.Params{Guardians: []string{guardian}, MaxPauseDuration: time.Hour}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidatePausable(t *testing.T) {
+	require.NoError(t, types.ValidatePausable("/cosmos.bank.v1beta1.MsgSend"))
+	require.NoError(t, types.ValidatePausable("/cosmwasm.wasm.v1.MsgExecuteContract"))
+	for _, typeURL := range []string{
+		"",
+		"/",
+		"cosmos.bank.v1beta1.MsgSend",
+		" /cosmos.bank.v1beta1.MsgSend",
+		"/cosmos.gov.v1.MsgVote",
+		"/cosmos.gov.v1beta1.MsgSubmitProposal",
+		"/enoki.msgpause.v1.MsgUnpause",
+	} {
+		require.ErrorIs(t, types.ValidatePausable(typeURL), types.ErrInvalidPause, typeURL)
+	}
+}
+
+func TestGenesisValidate(t *testing.T) {
+	pause := types.Pause{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", PausedBy: guardian, ExpiresAt: time.Unix(1, 0), Reason: "incident"}
+	require.NoError(t, types.DefaultGenesis().Validate())
+	require.NoError(t, types.GenesisState{Params: types.DefaultParams(), Pauses: []types.Pause{pause}}.Validate())
+
+	duplicate := types.GenesisState{Params: types.DefaultParams(), Pauses: []types.Pause{pause, pause}}
+	require.ErrorIs(t, duplicate.Validate(), types.ErrInvalidGenesis)
+	unpausable := pause
+	unpausable.MsgTypeUrl = "/cosmos.gov.v1.MsgVote"
+	require.ErrorIs(t, types.GenesisState{Params: types.DefaultParams(), Pauses: []types.Pause{unpausable}}.Validate(), types.ErrInvalidGenesis)
+
+	cooldown := types.Cooldown{MsgTypeUrl: pause.MsgTypeUrl, Until: time.Unix(1, 0)}
+	require.NoError(t, types.GenesisState{Params: types.DefaultParams(), Cooldowns: []types.Cooldown{cooldown}}.Validate())
+	require.ErrorIs(t, types.GenesisState{Params: types.DefaultParams(), Cooldowns: []types.Cooldown{cooldown, cooldown}}.Validate(), types.ErrInvalidGenesis)
+}
+
+func TestMsgPauseValidateBasic(t *testing.T) {
+	msgSend := "/cosmos.bank.v1beta1.MsgSend"
+	require.NoError(t, types.NewMsgPause(guardian, []string{msgSend}, time.Hour, "incident").ValidateBasic())
+	require.Error(t, types.NewMsgPause("guardian", []string{msgSend}, time.Hour, "").ValidateBasic())
+	require.ErrorIs(t, types.NewMsgPause(guardian, []string{msgSend}, 0, "").ValidateBasic(), types.ErrInvalidPause)
+	require.ErrorIs(t, types.NewMsgPause(guardian, nil, time.Hour, "").ValidateBasic(), types.ErrInvalidPause)
+	require.ErrorIs(t, types.NewMsgPause(guardian, []string{msgSend, msgSend}, time.Hour, "").ValidateBasic(), types.ErrInvalidPause)
+	require.ErrorIs(t, types.NewMsgUnpause(guardian, []string{"/cosmos.gov.v1.MsgVote"}).ValidateBasic(), types.ErrInvalidPause)
+}
@@ -0,0 +1,66 @@
+package nfttransfer
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "ClassTrace",
+					Use:            "class-trace [hash]",
+					Short:          "Query the trace of a voucher class",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "hash"}},
+				},
+				{
+					RpcMethod: "ClassTraces",
+					Use:       "class-traces",
+					Short:     "Query the traces of all voucher classes",
+				},
+				{
+					RpcMethod:      "ClassHash",
+					Use:            "class-hash [trace]",
+					Short:          "Query the hash of a class trace path",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "trace"}},
+				},
+				{
+					RpcMethod:      "EscrowAddress",
+					Use:            "escrow-address [port-id] [channel-id]",
+					Short:          "Query the address NFTs sent over a channel are escrowed to",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "port_id"}, {ProtoField: "channel_id"}},
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the nfttransfer module parameters",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Transfer",
+					Use:            "transfer [source-port] [source-channel] [receiver] [class-id] [token-id]...",
+					Short:          "Transfer NFTs to another chain over ICS-721",
+					Example:        "transfer nfttransfer channel-0 cosmos1... ibc/27394FB0... 1 2 3 --timeout-timestamp 1767225600000000000",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "source_port"}, {ProtoField: "source_channel"}, {ProtoField: "receiver"}, {ProtoField: "class_id"}, {ProtoField: "token_ids", Varargs: true}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params [params]",
+					Short:          "Submit a proposal to update the nfttransfer module parameters",
+					Example:        `update-params '{"send_enabled":true,"receive_enabled":false}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
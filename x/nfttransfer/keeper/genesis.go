@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.ParamsStore.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+	for _, trace := range genState.ClassTraces {
+		if err := k.Traces.Set(ctx, trace.Hash(), trace); err != nil {
+			return err
+		}
+	}
+	for _, d := range genState.ClassData {
+		if err := k.ClassData.Set(ctx, d.ClassId, d.Data); err != nil {
+			return err
+		}
+	}
+	for _, d := range genState.TokenData {
+		if err := k.TokenData.Set(ctx, collections.Join(d.ClassId, d.TokenId), d.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState := &types.GenesisState{
+		Params:      params,
+		ClassTraces: []types.ClassTrace{},
+		ClassData:   []types.ClassData{},
+		TokenData:   []types.TokenData{},
+	}
+
+	err = k.Traces.Walk(ctx, nil, func(_ []byte, trace types.ClassTrace) (bool, error) {
+		genState.ClassTraces = append(genState.ClassTraces, trace)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.ClassData.Walk(ctx, nil, func(classID string, data []byte) (bool, error) {
+		genState.ClassData = append(genState.ClassData, types.ClassData{ClassId: classID, Data: data})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.TokenData.Walk(ctx, nil, func(key collections.Pair[string, string], data []byte) (bool, error) {
+		genState.TokenData = append(genState.TokenData, types.TokenData{ClassId: key.K1(), TokenId: key.K2(), Data: data})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genState, nil
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// ClassTrace returns the trace of a voucher class.
+func (k Keeper) ClassTrace(ctx context.Context, req *types.QueryClassTraceRequest) (*types.QueryClassTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	hash, err := types.ParseHexHash(req.Hash)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid class trace hash %s: %s", req.Hash, err)
+	}
+
+	trace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s: %s", types.ErrTraceNotFound, req.Hash)
+	}
+
+	return &types.QueryClassTraceResponse{ClassTrace: trace}, nil
+}
+
+// ClassTraces returns the traces of all voucher classes.
+func (k Keeper) ClassTraces(ctx context.Context, req *types.QueryClassTracesRequest) (*types.QueryClassTracesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	traces, pageRes, err := query.CollectionPaginate(ctx, k.Traces, req.Pagination,
+		func(_ []byte, trace types.ClassTrace) (types.ClassTrace, error) {
+			return trace, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClassTracesResponse{ClassTraces: traces, Pagination: pageRes}, nil
+}
+
+// ClassHash returns the hash of a class trace path.
+func (k Keeper) ClassHash(ctx context.Context, req *types.QueryClassHashRequest) (*types.QueryClassHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	trace := types.ParseClassTrace(strings.TrimSpace(req.Trace))
+	if err := trace.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	has, err := k.Traces.Has(ctx, trace.Hash())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "%s: %s", types.ErrTraceNotFound, req.Trace)
+	}
+
+	return &types.QueryClassHashResponse{Hash: trace.Hash().String()}, nil
+}
+
+// EscrowAddress returns the address NFTs sent over a channel are escrowed to.
+func (k Keeper) EscrowAddress(_ context.Context, req *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEscrowAddressResponse{
+		EscrowAddress: types.GetEscrowAddress(req.PortId, req.ChannelId).String(),
+	}, nil
+}
+
+// Params returns the module parameters.
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := k.ParamsStore.Get(goCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+)
+
+// Keeper moves x/nft classes between chains over ICS-721. NFTs of native
+// classes are escrowed when they leave the chain, NFTs of voucher classes
+// are burned.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	ics4Wrapper porttypes.ICS4Wrapper
+	nftKeeper   types.NFTKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema      collections.Schema
+	ParamsStore collections.Item[types.Params]
+	Traces      collections.Map[[]byte, types.ClassTrace]
+	ClassData   collections.Map[string, []byte]
+	TokenData   collections.Map[collections.Pair[string, string], []byte]
+}
+
+// NewKeeper returns a new nfttransfer keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	nftKeeper types.NFTKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		ics4Wrapper:  ics4Wrapper,
+		nftKeeper:    nftKeeper,
+		authority:    authority,
+
+		ParamsStore: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Traces:      collections.NewMap(sb, types.ClassTracesKey, "class_traces", collections.BytesKey, codec.CollValue[types.ClassTrace](cdc)),
+		ClassData:   collections.NewMap(sb, types.ClassDataKey, "class_data", collections.StringKey, collections.BytesValue),
+		TokenData: collections.NewMap(sb, types.TokenDataKey, "token_data",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.BytesValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper packets are sent with.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetClassTrace returns the trace of the voucher class with the given hash.
+func (k Keeper) GetClassTrace(ctx context.Context, hash []byte) (types.ClassTrace, bool) {
+	trace, err := k.Traces.Get(ctx, hash)
+	if err != nil {
+		return types.ClassTrace{}, false
+	}
+	return trace, true
+}
+
+// fullClassPath returns the ICS-721 class id a class is sent with, the full
+// class path for voucher classes and the class id itself for native ones.
+func (k Keeper) fullClassPath(ctx context.Context, classID string) (string, error) {
+	if !types.IsVoucherClassID(classID) {
+		return classID, nil
+	}
+
+	hash, err := types.ParseHexHash(classID)
+	if err != nil {
+		return "", err
+	}
+	trace, found := k.GetClassTrace(ctx, hash)
+	if !found {
+		return "", fmt.Errorf("%w: %s", types.ErrTraceNotFound, classID)
+	}
+	return trace.GetFullClassPath(), nil
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer"
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(nfttransfer.AppModuleBasic{})
+
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), nil, nil, authtypes.NewModuleAddress("gov").String())
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesis()))
+	return ctx, k
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	trace := types.ParseClassTrace("nfttransfer/channel-0/kitties")
+	genState := types.GenesisState{
+		Params: types.Params{SendEnabled: true, ReceiveEnabled: false},
+		ClassTraces: []types.ClassTrace{
+			trace,
+			types.ParseClassTrace("nfttransfer/channel-1/nfttransfer/channel-4/wasm/enoki1abc/badges"),
+		},
+		ClassData: []types.ClassData{{ClassId: trace.IBCClassID(), Data: []byte(`{"name":"kitties"}`)}},
+		TokenData: []types.TokenData{{ClassId: trace.IBCClassID(), TokenId: "1", Data: []byte(`{"color":"orange"}`)}},
+	}
+	require.NoError(t, genState.Validate())
+	require.NoError(t, k.InitGenesis(ctx, genState))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genState.Params, exported.Params)
+	require.ElementsMatch(t, genState.ClassTraces, exported.ClassTraces)
+	require.Equal(t, genState.ClassData, exported.ClassData)
+	require.Equal(t, genState.TokenData, exported.TokenData)
+
+	// class and token data must belong to a traced class
+	orphan := genState
+	orphan.TokenData = []types.TokenData{{ClassId: "ibc/0000", TokenId: "1"}}
+	require.Error(t, orphan.Validate())
+
+	duplicate := genState
+	duplicate.ClassTraces = append([]types.ClassTrace{trace}, genState.ClassTraces...)
+	require.Error(t, duplicate.Validate())
+}
+
+func TestQueries(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	trace := types.ParseClassTrace("nfttransfer/channel-0/kitties")
+	require.NoError(t, k.Traces.Set(ctx, trace.Hash(), trace))
+
+	res, err := k.ClassTrace(ctx, &types.QueryClassTraceRequest{Hash: trace.Hash().String()})
+	require.NoError(t, err)
+	require.Equal(t, trace, res.ClassTrace)
+
+	hash, err := k.ClassHash(ctx, &types.QueryClassHashRequest{Trace: trace.GetFullClassPath()})
+	require.NoError(t, err)
+	require.Equal(t, trace.Hash().String(), hash.Hash)
+
+	_, err = k.ClassHash(ctx, &types.QueryClassHashRequest{Trace: "nfttransfer/channel-1/kitties"})
+	require.Error(t, err)
+
+	traces, err := k.ClassTraces(ctx, &types.QueryClassTracesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ClassTrace{trace}, traces.ClassTraces)
+
+	escrow, err := k.EscrowAddress(ctx, &types.QueryEscrowAddressRequest{PortId: types.PortID, ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, types.GetEscrowAddress(types.PortID, "channel-0").String(), escrow.EscrowAddress)
+	require.NotEqual(t, escrow.EscrowAddress, types.GetEscrowAddress(types.PortID, "channel-1").String())
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	params := types.Params{SendEnabled: false, ReceiveEnabled: true}
+
+	_, err := srv.UpdateParams(ctx, types.NewMsgUpdateParams(authtypes.NewModuleAddress("other").String(), params))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.NoError(t, err)
+
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
@@ -0,0 +1,257 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+)
+
+// SendTransfer sends NFTs of classID owned by sender to receiver on the
+// chain at the other end of the source channel. NFTs leaving their origin
+// are escrowed, NFTs returning to it are burned. It returns the sequence of
+// the sent packet.
+func (k Keeper) SendTransfer(
+	ctx sdk.Context,
+	sourcePort, sourceChannel, classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+) (uint64, error) {
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if !params.SendEnabled {
+		return 0, types.ErrSendDisabled
+	}
+
+	class, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return 0, errorsmod.Wrap(nft.ErrClassNotExists, classID)
+	}
+	fullClassPath, err := k.fullClassPath(ctx, classID)
+	if err != nil {
+		return 0, err
+	}
+	classData, err := k.getClassData(ctx, classID)
+	if err != nil {
+		return 0, err
+	}
+
+	awayFromOrigin := types.IsAwayFromOrigin(sourcePort, sourceChannel, fullClassPath)
+	escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+
+	tokenURIs := make([]string, len(tokenIDs))
+	tokenData := make([][]byte, len(tokenIDs))
+	hasTokenData := false
+	for i, tokenID := range tokenIDs {
+		token, found := k.nftKeeper.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return 0, errorsmod.Wrapf(nft.ErrNFTNotExists, "%s/%s", classID, tokenID)
+		}
+		if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !owner.Equals(sender) {
+			return 0, errorsmod.Wrapf(types.ErrNotOwner, "%s is not the owner of %s/%s", sender, classID, tokenID)
+		}
+		tokenURIs[i] = token.Uri
+
+		tokenData[i], err = k.getTokenData(ctx, classID, tokenID)
+		if err != nil {
+			return 0, err
+		}
+		hasTokenData = hasTokenData || len(tokenData[i]) > 0
+
+		if awayFromOrigin {
+			err = k.nftKeeper.Transfer(ctx, classID, tokenID, escrowAddress)
+		} else {
+			err = k.burn(ctx, classID, tokenID)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	if !hasTokenData {
+		tokenData = nil
+	}
+
+	packetData := types.NewNonFungibleTokenPacketData(
+		fullClassPath, class.Uri, classData, tokenIDs, tokenURIs, tokenData, sender.String(), receiver, memo,
+	)
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransfer,
+		sdk.NewAttribute(types.AttributeKeyClassID, fullClassPath),
+		sdk.NewAttribute(types.AttributeKeyTokenIDs, strings.Join(tokenIDs, ",")),
+		sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(types.AttributeKeyMemo, memo),
+	))
+
+	return sequence, nil
+}
+
+// OnRecvPacket releases the escrowed NFTs of a class returning to this
+// chain, or mints vouchers of a class received for the first time over the
+// destination channel.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.ReceiveEnabled {
+		return types.ErrReceiveDisabled
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "failed to decode receiver address %s: %v", data.Receiver, err)
+	}
+
+	if !types.IsAwayFromOrigin(packet.SourcePort, packet.SourceChannel, data.ClassId) {
+		// the class is returning over the channel it left through, release
+		// the NFTs from escrow
+		unprefixed := strings.TrimPrefix(data.ClassId, types.GetClassPrefix(packet.SourcePort, packet.SourceChannel))
+		classID := types.ParseClassTrace(unprefixed).IBCClassID()
+		escrowAddress := types.GetEscrowAddress(packet.DestinationPort, packet.DestinationChannel)
+
+		for _, tokenID := range data.TokenIds {
+			if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !bytes.Equal(owner, escrowAddress) {
+				return errorsmod.Wrapf(types.ErrInvalidPacket, "%s/%s is not escrowed for %s", classID, tokenID, packet.DestinationChannel)
+			}
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	trace := types.ParseClassTrace(types.GetClassPrefix(packet.DestinationPort, packet.DestinationChannel) + data.ClassId)
+	if err := trace.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidClassID, err.Error())
+	}
+	classID := trace.IBCClassID()
+
+	if !k.nftKeeper.HasClass(ctx, classID) {
+		if err := k.Traces.Set(ctx, trace.Hash(), trace); err != nil {
+			return err
+		}
+		if err := k.nftKeeper.SaveClass(ctx, nft.Class{Id: classID, Uri: data.ClassUri}); err != nil {
+			return err
+		}
+		if len(data.ClassData) > 0 {
+			if err := k.ClassData.Set(ctx, classID, data.ClassData); err != nil {
+				return err
+			}
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeClassTrace,
+			sdk.NewAttribute(types.AttributeKeyTraceHash, trace.Hash().String()),
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+		))
+	}
+
+	for i, tokenID := range data.TokenIds {
+		if err := k.mint(ctx, classID, tokenID, data.TokenURI(i), data.TokenDataAt(i), receiver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnAcknowledgementPacket refunds the sender if the counterparty failed to
+// receive the NFTs.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	if _, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		return k.refundPacketToken(ctx, packet, data)
+	}
+	return nil
+}
+
+// OnTimeoutPacket refunds the sender of a packet that timed out.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	return k.refundPacketToken(ctx, packet, data)
+}
+
+// refundPacketToken gives the NFTs of a failed transfer back to the sender,
+// releasing them from escrow or minting the burned vouchers again.
+func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	classID := types.ParseClassTrace(data.ClassId).IBCClassID()
+	if types.IsAwayFromOrigin(packet.SourcePort, packet.SourceChannel, data.ClassId) {
+		for _, tokenID := range data.TokenIds {
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, sender); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for i, tokenID := range data.TokenIds {
+		if err := k.mint(ctx, classID, tokenID, data.TokenURI(i), data.TokenDataAt(i), sender); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mint mints a voucher NFT and records its ICS-721 token data.
+func (k Keeper) mint(ctx sdk.Context, classID, tokenID, uri string, data []byte, receiver sdk.AccAddress) error {
+	if err := k.nftKeeper.Mint(ctx, nft.NFT{ClassId: classID, Id: tokenID, Uri: uri}, receiver); err != nil {
+		return err
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return k.TokenData.Set(ctx, collections.Join(classID, tokenID), data)
+}
+
+// burn burns a voucher NFT and forgets its ICS-721 token data.
+func (k Keeper) burn(ctx sdk.Context, classID, tokenID string) error {
+	if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+		return err
+	}
+	return k.TokenData.Remove(ctx, collections.Join(classID, tokenID))
+}
+
+// getClassData returns the ICS-721 class data of a voucher class, nil for
+// native classes.
+func (k Keeper) getClassData(ctx sdk.Context, classID string) ([]byte, error) {
+	data, err := k.ClassData.Get(ctx, classID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// getTokenData returns the ICS-721 token data of a voucher NFT, nil for
+// NFTs of native classes.
+func (k Keeper) getTokenData(ctx sdk.Context, classID, tokenID string) ([]byte, error) {
+	data, err := k.TokenData.Get(ctx, collections.Join(classID, tokenID))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	return data, err
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidAuthority = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrInvalidClassID   = errorsmod.Register(ModuleName, 3, "invalid class id")
+	ErrInvalidTokenID   = errorsmod.Register(ModuleName, 4, "invalid token id")
+	ErrInvalidPacket    = errorsmod.Register(ModuleName, 5, "invalid ICS-721 packet")
+	ErrInvalidVersion   = errorsmod.Register(ModuleName, 6, "invalid ICS-721 version")
+	ErrSendDisabled     = errorsmod.Register(ModuleName, 7, "NFT transfers from this chain are disabled")
+	ErrReceiveDisabled  = errorsmod.Register(ModuleName, 8, "NFT transfers to this chain are disabled")
+	ErrTraceNotFound    = errorsmod.Register(ModuleName, 9, "class trace not found")
+	ErrNotOwner         = errorsmod.Register(ModuleName, 10, "sender does not own the NFT")
+	ErrMaxNFTChannels   = errorsmod.Register(ModuleName, 11, "max NFT transfer channels")
+	ErrTooManyTokens    = errorsmod.Register(ModuleName, 12, "too many tokens in a single transfer")
+)
@@ -0,0 +1,22 @@
+package types
+
+// nfttransfer module event types and attribute keys
+const (
+	EventTypeTransfer     = "nft_transfer"
+	EventTypePacket       = "non_fungible_token_packet"
+	EventTypeTimeout      = "nft_transfer_timeout"
+	EventTypeClassTrace   = "nft_class_trace"
+	EventTypeUpdateParams = "nft_transfer_update_params"
+
+	AttributeKeyClassID        = "class_id"
+	AttributeKeyTokenIDs       = "token_ids"
+	AttributeKeySender         = "sender"
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyMemo           = "memo"
+	AttributeKeySuccess        = "success"
+	AttributeKeyAckError       = "error"
+	AttributeKeyRefundReceiver = "refund_receiver"
+	AttributeKeyTraceHash      = "trace_hash"
+	AttributeKeySendEnabled    = "send_enabled"
+	AttributeKeyReceiveEnabled = "receive_enabled"
+)
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/x/nft"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NFTKeeper defines the x/nft methods used by the module.
+type NFTKeeper interface {
+	SaveClass(ctx context.Context, class nft.Class) error
+	GetClass(ctx context.Context, classID string) (nft.Class, bool)
+	HasClass(ctx context.Context, classID string) bool
+	Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classID, nftID string) error
+	Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error
+	GetNFT(ctx context.Context, classID, nftID string) (nft.NFT, bool)
+	GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+}
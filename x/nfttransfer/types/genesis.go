@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultGenesis returns the default nfttransfer genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:      DefaultParams(),
+		ClassTraces: []ClassTrace{},
+		ClassData:   []ClassData{},
+		TokenData:   []TokenData{},
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	classIDs := make(map[string]struct{}, len(gs.ClassTraces))
+	for _, trace := range gs.ClassTraces {
+		if err := trace.Validate(); err != nil {
+			return err
+		}
+		if trace.Path == "" {
+			return fmt.Errorf("class trace of %s has no path", trace.BaseClassId)
+		}
+		classID := trace.IBCClassID()
+		if _, ok := classIDs[classID]; ok {
+			return fmt.Errorf("duplicate class trace %s", trace.GetFullClassPath())
+		}
+		classIDs[classID] = struct{}{}
+	}
+
+	seen := make(map[string]struct{}, len(gs.ClassData))
+	for _, d := range gs.ClassData {
+		if _, ok := classIDs[d.ClassId]; !ok {
+			return fmt.Errorf("class data for %s without a class trace", d.ClassId)
+		}
+		if _, ok := seen[d.ClassId]; ok {
+			return fmt.Errorf("duplicate class data for %s", d.ClassId)
+		}
+		seen[d.ClassId] = struct{}{}
+	}
+
+	seen = make(map[string]struct{}, len(gs.TokenData))
+	for _, d := range gs.TokenData {
+		if _, ok := classIDs[d.ClassId]; !ok {
+			return fmt.Errorf("token data for %s without a class trace", d.ClassId)
+		}
+		if strings.TrimSpace(d.TokenId) == "" {
+			return fmt.Errorf("token data for %s with a blank token id", d.ClassId)
+		}
+		key := d.ClassId + "/" + d.TokenId
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate token data for %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
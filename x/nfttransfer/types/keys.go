@@ -0,0 +1,56 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "nfttransfer"
+
+	// StoreKey defines the primary module store key. It differs from the
+	// module name because store keys may not prefix each other and the x/nft
+	// store key is "nft".
+	StoreKey = "ics721"
+
+	// PortID is the port the ICS-721 application binds to. IBC routes must be
+	// alphanumeric, and the port router matches unknown ports by substring,
+	// so "nft-transfer" would be routed to ICS-20.
+	PortID = ModuleName
+
+	// Version defines the current version of the ICS-721 application.
+	Version = "ics721-1"
+
+	// ClassPrefix is the prefix of voucher class ids.
+	ClassPrefix = "ibc"
+)
+
+var (
+	// ParamsKey stores the module parameters.
+	ParamsKey = collections.NewPrefix(0)
+	// ClassTracesKey indexes the voucher class traces by hash.
+	ClassTracesKey = collections.NewPrefix(1)
+	// ClassDataKey indexes the ICS-721 class data by voucher class id.
+	ClassDataKey = collections.NewPrefix(2)
+	// TokenDataKey indexes the ICS-721 token data by (voucher class id,
+	// token id).
+	TokenDataKey = collections.NewPrefix(3)
+)
+
+// GetEscrowAddress returns the address NFTs sent over the given channel are
+// escrowed to, derived like the ICS-20 escrow address with the ICS-721
+// version so the two never collide.
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
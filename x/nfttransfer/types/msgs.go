@@ -0,0 +1,86 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// MaxTokenIDs is the maximum number of NFTs in a single transfer.
+const MaxTokenIDs = 100
+
+var (
+	_ sdk.Msg = &MsgTransfer{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgTransfer creates a new MsgTransfer instance.
+func NewMsgTransfer(
+	sourcePort, sourceChannel, classID string, tokenIDs []string,
+	sender, receiver string, timeoutHeight clienttypes.Height, timeoutTimestamp uint64, memo string,
+) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:            sourcePort,
+		SourceChannel:         sourceChannel,
+		ClassId:               classID,
+		TokenIds:              tokenIDs,
+		Sender:                sender,
+		Receiver:              receiver,
+		TimeoutRevisionNumber: timeoutHeight.RevisionNumber,
+		TimeoutRevisionHeight: timeoutHeight.RevisionHeight,
+		TimeoutTimestamp:      timeoutTimestamp,
+		Memo:                  memo,
+	}
+}
+
+// TimeoutHeight returns the counterparty timeout height of the transfer.
+func (msg *MsgTransfer) TimeoutHeight() clienttypes.Height {
+	return clienttypes.NewHeight(msg.TimeoutRevisionNumber, msg.TimeoutRevisionHeight)
+}
+
+// ValidateBasic performs stateless validation of MsgTransfer.
+func (msg *MsgTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.SourcePort); err != nil {
+		return errorsmod.Wrap(err, "invalid source port ID")
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return errorsmod.Wrap(err, "invalid source channel ID")
+	}
+	if strings.TrimSpace(msg.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "class id cannot be blank")
+	}
+	if len(msg.TokenIds) > MaxTokenIDs {
+		return errorsmod.Wrapf(ErrTooManyTokens, "got %d, max %d", len(msg.TokenIds), MaxTokenIDs)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if msg.TimeoutRevisionHeight == 0 && msg.TimeoutTimestamp == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "timeout height and timeout timestamp cannot both be 0")
+	}
+
+	data := NewNonFungibleTokenPacketData(msg.ClassId, "", nil, msg.TokenIds, nil, nil, msg.Sender, msg.Receiver, msg.Memo)
+	return data.ValidateBasic()
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgUpdateParams.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
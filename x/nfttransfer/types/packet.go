@@ -0,0 +1,119 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+// NonFungibleTokenPacketData is the ICS-721 packet data. Its JSON encoding
+// follows the specification so collections can move to and from cw-ics721
+// and other ICS-721 implementations.
+type NonFungibleTokenPacketData struct {
+	// ClassId is the full class path on the sending chain.
+	ClassId   string   `json:"classId"`
+	ClassUri  string   `json:"classUri,omitempty"`
+	ClassData []byte   `json:"classData,omitempty"`
+	TokenIds  []string `json:"tokenIds"`
+	TokenUris []string `json:"tokenUris,omitempty"`
+	TokenData [][]byte `json:"tokenData,omitempty"`
+	Sender    string   `json:"sender"`
+	Receiver  string   `json:"receiver"`
+	Memo      string   `json:"memo,omitempty"`
+}
+
+// NewNonFungibleTokenPacketData constructs a new NonFungibleTokenPacketData
+// instance.
+func NewNonFungibleTokenPacketData(
+	classID, classURI string, classData []byte,
+	tokenIDs, tokenURIs []string, tokenData [][]byte,
+	sender, receiver, memo string,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:   classID,
+		ClassUri:  classURI,
+		ClassData: classData,
+		TokenIds:  tokenIDs,
+		TokenUris: tokenURIs,
+		TokenData: tokenData,
+		Sender:    sender,
+		Receiver:  receiver,
+		Memo:      memo,
+	}
+}
+
+// UnmarshalPacketData decodes ICS-721 packet data and validates it.
+func UnmarshalPacketData(bz []byte) (NonFungibleTokenPacketData, error) {
+	var data NonFungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return NonFungibleTokenPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-721 packet data: %s", err)
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return NonFungibleTokenPacketData{}, err
+	}
+	return data, nil
+}
+
+// ValidateBasic is used for validating the NFT transfer.
+func (data NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(data.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassID, "class id cannot be blank")
+	}
+	if len(data.TokenIds) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenID, "token ids cannot be empty")
+	}
+	seen := make(map[string]struct{}, len(data.TokenIds))
+	for _, id := range data.TokenIds {
+		if strings.TrimSpace(id) == "" {
+			return errorsmod.Wrap(ErrInvalidTokenID, "token id cannot be blank")
+		}
+		if _, ok := seen[id]; ok {
+			return errorsmod.Wrapf(ErrInvalidTokenID, "duplicate token id %s", id)
+		}
+		seen[id] = struct{}{}
+	}
+	if len(data.TokenUris) != 0 && len(data.TokenUris) != len(data.TokenIds) {
+		return errorsmod.Wrapf(ErrInvalidPacket, "expected %d token uris, got %d", len(data.TokenIds), len(data.TokenUris))
+	}
+	if len(data.TokenData) != 0 && len(data.TokenData) != len(data.TokenIds) {
+		return errorsmod.Wrapf(ErrInvalidPacket, "expected %d token data, got %d", len(data.TokenIds), len(data.TokenData))
+	}
+	if strings.TrimSpace(data.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+	if strings.TrimSpace(data.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+	return nil
+}
+
+// GetBytes is a helper for serialising the packet data.
+func (data NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(data)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal ICS-721 packet data: %w", err))
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// TokenURI returns the uri of the i-th token, if any.
+func (data NonFungibleTokenPacketData) TokenURI(i int) string {
+	if i < len(data.TokenUris) {
+		return data.TokenUris[i]
+	}
+	return ""
+}
+
+// TokenDataAt returns the data of the i-th token, if any.
+func (data NonFungibleTokenPacketData) TokenDataAt(i int) []byte {
+	if i < len(data.TokenData) {
+		return data.TokenData[i]
+	}
+	return nil
+}
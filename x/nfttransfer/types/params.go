@@ -0,0 +1,15 @@
+package types
+
+// DefaultParams returns the default nfttransfer parameters, with sending and
+// receiving enabled.
+func DefaultParams() Params {
+	return Params{
+		SendEnabled:    true,
+		ReceiveEnabled: true,
+	}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	return nil
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// ParseClassTrace parses a full class path, {port}/{channel}/.../{base class
+// id}, into a ClassTrace. The base class id may itself contain slashes, so
+// only leading valid port and channel identifier pairs are taken as the path.
+func ParseClassTrace(fullClassPath string) ClassTrace {
+	parts := strings.Split(fullClassPath, "/")
+
+	i := 0
+	for ; i+1 < len(parts)-1; i += 2 {
+		if host.PortIdentifierValidator(parts[i]) != nil || !isChannelID(parts[i+1]) {
+			break
+		}
+	}
+
+	return ClassTrace{
+		Path:        strings.Join(parts[:i], "/"),
+		BaseClassId: strings.Join(parts[i:], "/"),
+	}
+}
+
+// isChannelID reports whether id is a channel identifier created by core IBC.
+func isChannelID(id string) bool {
+	return strings.HasPrefix(id, "channel-") && host.ChannelIdentifierValidator(id) == nil
+}
+
+// Hash returns the SHA-256 hash of the full class path.
+func (ct ClassTrace) Hash() cmtbytes.HexBytes {
+	hash := sha256.Sum256([]byte(ct.GetFullClassPath()))
+	return hash[:]
+}
+
+// GetFullClassPath returns the full class path, or the base class id if the
+// class is native to this chain.
+func (ct ClassTrace) GetFullClassPath() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return ct.Path + "/" + ct.BaseClassId
+}
+
+// IBCClassID returns the voucher class id, ibc/{hash}, or the base class id
+// if the class is native to this chain.
+func (ct ClassTrace) IBCClassID() string {
+	if ct.Path == "" {
+		return ct.BaseClassId
+	}
+	return fmt.Sprintf("%s/%s", ClassPrefix, ct.Hash())
+}
+
+// Validate performs a basic validation of the class trace.
+func (ct ClassTrace) Validate() error {
+	if strings.TrimSpace(ct.BaseClassId) == "" {
+		return fmt.Errorf("base class id cannot be blank")
+	}
+	if ct.Path == "" {
+		return nil
+	}
+
+	parts := strings.Split(ct.Path, "/")
+	if len(parts)%2 != 0 {
+		return fmt.Errorf("class trace path %s must contain port and channel identifier pairs", ct.Path)
+	}
+	for i := 0; i < len(parts); i += 2 {
+		if err := host.PortIdentifierValidator(parts[i]); err != nil {
+			return fmt.Errorf("invalid port in class trace path %s: %w", ct.Path, err)
+		}
+		if !isChannelID(parts[i+1]) {
+			return fmt.Errorf("invalid channel %s in class trace path %s", parts[i+1], ct.Path)
+		}
+	}
+	return nil
+}
+
+// ParseHexHash parses a hex encoded class trace hash, with or without the
+// ibc/ prefix.
+func ParseHexHash(hash string) (cmtbytes.HexBytes, error) {
+	hash = strings.TrimPrefix(hash, ClassPrefix+"/")
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != sha256.Size {
+		return nil, fmt.Errorf("invalid class trace hash length %d, expected %d", len(bz), sha256.Size)
+	}
+	return bz, nil
+}
+
+// IsVoucherClassID reports whether classID is an ibc/{hash} voucher class id.
+func IsVoucherClassID(classID string) bool {
+	if !strings.HasPrefix(classID, ClassPrefix+"/") {
+		return false
+	}
+	_, err := ParseHexHash(classID)
+	return err == nil
+}
+
+// GetClassPrefix returns the prefix a class gets when it is received from
+// the given port and channel.
+func GetClassPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
+
+// IsAwayFromOrigin reports whether a class, given by its full path on the
+// sending chain, is moving further away from the chain it was created on.
+// It is not if it was received over the source port and channel, in which
+// case the NFTs are returned to the escrow on the receiving chain.
+func IsAwayFromOrigin(sourcePort, sourceChannel, fullClassPath string) bool {
+	return !strings.HasPrefix(fullClassPath, GetClassPrefix(sourcePort, sourceChannel))
+}
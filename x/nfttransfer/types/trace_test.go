@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyphacoop/cosmos-enoki/x/nfttransfer/types"
+)
+
+func TestParseClassTrace(t *testing.T) {
+	for _, tc := range []struct {
+		fullPath string
+		path     string
+		base     string
+	}{
+		{"kitties", "", "kitties"},
+		{"nfttransfer/channel-0/kitties", "nfttransfer/channel-0", "kitties"},
+		{"nfttransfer/channel-0/wasm.enoki1abc/channel-7/kitties", "nfttransfer/channel-0/wasm.enoki1abc/channel-7", "kitties"},
+		// slashes in the base class id are kept
+		{"nfttransfer/channel-0/wasm/enoki1abc/badges", "nfttransfer/channel-0", "wasm/enoki1abc/badges"},
+		{"a/b/c", "", "a/b/c"},
+		// a trailing port and channel pair is part of the base class id
+		{"nfttransfer/channel-0", "", "nfttransfer/channel-0"},
+	} {
+		trace := types.ParseClassTrace(tc.fullPath)
+		require.Equal(t, tc.path, trace.Path, tc.fullPath)
+		require.Equal(t, tc.base, trace.BaseClassId, tc.fullPath)
+		require.Equal(t, tc.fullPath, trace.GetFullClassPath())
+		require.NoError(t, trace.Validate())
+	}
+
+	native := types.ParseClassTrace("kitties")
+	require.Equal(t, "kitties", native.IBCClassID())
+
+	voucher := types.ParseClassTrace("nfttransfer/channel-0/kitties")
+	require.True(t, types.IsVoucherClassID(voucher.IBCClassID()))
+	require.False(t, types.IsVoucherClassID("ibc/kitties"))
+
+	hash, err := types.ParseHexHash(voucher.IBCClassID())
+	require.NoError(t, err)
+	require.Equal(t, voucher.Hash(), hash)
+}
+
+func TestIsAwayFromOrigin(t *testing.T) {
+	// native classes always move away from their origin
+	require.True(t, types.IsAwayFromOrigin("nfttransfer", "channel-0", "kitties"))
+	// vouchers returning over the channel they arrived on do not
+	require.False(t, types.IsAwayFromOrigin("nfttransfer", "channel-0", "nfttransfer/channel-0/kitties"))
+	require.True(t, types.IsAwayFromOrigin("nfttransfer", "channel-1", "nfttransfer/channel-0/kitties"))
+	require.True(t, types.IsAwayFromOrigin("nfttransfer", "channel-1", "nfttransfer/channel-10/kitties"))
+}
+
+func TestPacketDataValidateBasic(t *testing.T) {
+	valid := types.NewNonFungibleTokenPacketData(
+		"kitties", "ipfs://kitties", nil, []string{"1", "2"}, []string{"ipfs://1", "ipfs://2"}, nil, "enoki1sender", "cosmos1receiver", "",
+	)
+	require.NoError(t, valid.ValidateBasic())
+
+	// keys are sorted camelCase as used by cw-ics721
+	require.Equal(t,
+		`{"classId":"kitties","classUri":"ipfs://kitties","receiver":"cosmos1receiver","sender":"enoki1sender","tokenIds":["1","2"],"tokenUris":["ipfs://1","ipfs://2"]}`,
+		string(valid.GetBytes()),
+	)
+	decoded, err := types.UnmarshalPacketData(valid.GetBytes())
+	require.NoError(t, err)
+	require.Equal(t, valid, decoded)
+
+	for name, malleate := range map[string]func(*types.NonFungibleTokenPacketData){
+		"no class":        func(d *types.NonFungibleTokenPacketData) { d.ClassId = "" },
+		"no tokens":       func(d *types.NonFungibleTokenPacketData) { d.TokenIds, d.TokenUris = nil, nil },
+		"duplicate token": func(d *types.NonFungibleTokenPacketData) { d.TokenIds = []string{"1", "1"} },
+		"blank token":     func(d *types.NonFungibleTokenPacketData) { d.TokenIds = []string{"1", " "} },
+		"uri mismatch":    func(d *types.NonFungibleTokenPacketData) { d.TokenUris = []string{"ipfs://1"} },
+		"data mismatch":   func(d *types.NonFungibleTokenPacketData) { d.TokenData = [][]byte{{1}} },
+		"no sender":       func(d *types.NonFungibleTokenPacketData) { d.Sender = "" },
+		"no receiver":     func(d *types.NonFungibleTokenPacketData) { d.Receiver = "" },
+	} {
+		data := valid
+		data.TokenIds = append([]string(nil), valid.TokenIds...)
+		malleate(&data)
+		require.Error(t, data.ValidateBasic(), name)
+	}
+}
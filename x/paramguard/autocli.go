@@ -0,0 +1,38 @@
+package paramguard
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Bounds",
+					Use:            "bounds [msg-type-url]",
+					Short:          "Query the parameter bounds, optionally of a single message type",
+					Example:        "bounds /feemarket.feemarket.v1.MsgParams",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_url", Optional: true}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params [params]",
+					Short:          "Submit a proposal to replace the parameter bounds",
+					Example:        `update-params '{"bounds":[{"msg_type_url":"/feemarket.feemarket.v1.MsgParams","path":"params.min_base_gas_price","min":"0.001","max":"1"}]}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	return k.SetParams(ctx, genState.Params)
+}
+
+// ExportGenesis returns the module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.GenesisState{Params: params}, nil
+}
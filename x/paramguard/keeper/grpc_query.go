@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Bounds returns the active bounds, of a single message type if requested.
+func (k Keeper) Bounds(ctx context.Context, req *types.QueryBoundsRequest) (*types.QueryBoundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bounds := []types.Bound{}
+	for _, b := range params.Bounds {
+		if req.MsgTypeUrl == "" || b.MsgTypeUrl == req.MsgTypeUrl {
+			bounds = append(bounds, b)
+		}
+	}
+	return &types.QueryBoundsResponse{Bounds: bounds}, nil
+}
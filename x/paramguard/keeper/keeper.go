@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard/types"
+)
+
+// Keeper stores the parameter bounds and checks governance messages against
+// them.
+type Keeper struct {
+	cdc          codec.Codec
+	storeService store.KVStoreService
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+}
+
+// NewKeeper returns a new paramguard keeper. The codec encodes the checked
+// messages to JSON and resolves the message types of the bounds.
+func NewKeeper(
+	cdc codec.Codec,
+	storeService store.KVStoreService,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		authority:    authority,
+
+		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetParams validates and stores the bounds. Every bound must name a
+// registered message type and a path found in its JSON encoding.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	for _, b := range params.Bounds {
+		msg, err := k.cdc.InterfaceRegistry().Resolve(b.MsgTypeUrl)
+		if err != nil {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "bound for %s: %s", b.MsgTypeUrl, err)
+		}
+		if _, ok := msg.(sdk.Msg); !ok {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "%s is not a message", b.MsgTypeUrl)
+		}
+		bz, err := k.cdc.MarshalJSON(msg)
+		if err != nil {
+			return err
+		}
+		var doc any
+		if err := json.Unmarshal(bz, &doc); err != nil {
+			return err
+		}
+		if !b.HasPath(doc) {
+			return errorsmod.Wrapf(types.ErrInvalidParams, "%s has no field %s", b.MsgTypeUrl, b.Path)
+		}
+	}
+	return k.Params.Set(ctx, params)
+}
+
+// CheckMsg returns an error if the message, or a message nested in it like
+// the messages of an authz MsgExec, sets a field outside its bounds.
+func (k Keeper) CheckMsg(ctx context.Context, msg sdk.Msg) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if len(params.Bounds) == 0 {
+		return nil
+	}
+	return k.checkMsg(params, msg)
+}
+
+func (k Keeper) checkMsg(params types.Params, msg sdk.Msg) error {
+	if nested, ok := msg.(interface{ GetMessages() ([]sdk.Msg, error) }); ok {
+		msgs, err := nested.GetMessages()
+		if err != nil {
+			return err
+		}
+		for _, m := range msgs {
+			if err := k.checkMsg(params, m); err != nil {
+				return err
+			}
+		}
+	}
+
+	typeURL := sdk.MsgTypeURL(msg)
+	if !params.HasBounds(typeURL) {
+		return nil
+	}
+	bz, err := k.cdc.MarshalJSON(msg)
+	if err != nil {
+		return err
+	}
+	return params.Check(typeURL, bz)
+}
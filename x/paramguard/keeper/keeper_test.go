@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard"
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard/types"
+)
+
+var authority = authtypes.NewModuleAddress("gov").String()
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(paramguard.AppModuleBasic{}, bank.AppModuleBasic{}, staking.AppModuleBasic{}, authzmodule.AppModuleBasic{})
+
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authority)
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesis()))
+	return ctx, k
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	msgType := sdk.MsgTypeURL(&stakingtypes.MsgUpdateParams{})
+
+	params := types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params.max_validators", Min: "1", Max: "200"}}}
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: "invalid", Params: params})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// the message type must be registered and have the bounded field
+	for _, bound := range []types.Bound{
+		{MsgTypeUrl: "/cosmos.unknown.v1.MsgUpdateParams", Path: "params.max_validators", Min: "1"},
+		{MsgTypeUrl: msgType, Path: "params.unknown", Min: "1"},
+		{MsgTypeUrl: msgType, Path: "params.max_validators.value", Min: "1"},
+	} {
+		_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: types.Params{Bounds: []types.Bound{bound}}})
+		require.ErrorIs(t, err, types.ErrInvalidParams, bound.Path)
+	}
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	genState, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, genState.Params)
+}
+
+func TestCheckMsg(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	stakingParams := stakingtypes.DefaultParams()
+	update := func(modify func(*stakingtypes.Params)) *stakingtypes.MsgUpdateParams {
+		params := stakingParams
+		modify(&params)
+		return &stakingtypes.MsgUpdateParams{Authority: authority, Params: params}
+	}
+
+	// nothing is bounded by default
+	require.NoError(t, k.CheckMsg(ctx, update(func(p *stakingtypes.Params) { p.MaxValidators = 0 })))
+
+	msgType := sdk.MsgTypeURL(&stakingtypes.MsgUpdateParams{})
+	require.NoError(t, k.SetParams(ctx, types.Params{Bounds: []types.Bound{
+		{MsgTypeUrl: msgType, Path: "params.max_validators", Min: "1", Max: "200"},
+		{MsgTypeUrl: msgType, Path: "params.unbonding_time", Min: "24h", Max: "2160h"},
+		{MsgTypeUrl: msgType, Path: "params.min_commission_rate", Max: "0.5"},
+	}}))
+
+	require.NoError(t, k.CheckMsg(ctx, update(func(*stakingtypes.Params) {})))
+	for name, msg := range map[string]sdk.Msg{
+		"max validators": update(func(p *stakingtypes.Params) { p.MaxValidators = 0 }),
+		"unbonding time": update(func(p *stakingtypes.Params) { p.UnbondingTime = time.Hour }),
+		"commission":     update(func(p *stakingtypes.Params) { p.MinCommissionRate = sdkmath.LegacyOneDec() }),
+	} {
+		require.ErrorIs(t, k.CheckMsg(ctx, msg), types.ErrOutOfBounds, name)
+	}
+
+	// messages nested in an authz exec are checked too
+	exec := authz.NewMsgExec(authtypes.NewModuleAddress("gov"), []sdk.Msg{update(func(p *stakingtypes.Params) { p.MaxValidators = 1_000 })})
+	require.ErrorContains(t, k.CheckMsg(ctx, &exec), "params.max_validators of "+msgType+" is 1000, above the maximum 200")
+
+	// the bounds are listed by message type
+	res, err := k.Bounds(ctx, &types.QueryBoundsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Bounds, 3)
+	res, err = k.Bounds(ctx, &types.QueryBoundsRequest{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgUpdateParams"})
+	require.NoError(t, err)
+	require.Empty(t, res.Bounds)
+}
@@ -0,0 +1,49 @@
+package paramguard
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard/keeper"
+)
+
+var _ baseapp.MessageRouter = MsgRouter{}
+
+// MsgRouter wraps the message router of the governance module, so every
+// proposal message is checked against the parameter bounds before it runs.
+type MsgRouter struct {
+	router baseapp.MessageRouter
+	keeper keeper.Keeper
+}
+
+// NewMsgRouter returns a router checking the messages routed by router
+// against the bounds of the keeper.
+func NewMsgRouter(router baseapp.MessageRouter, keeper keeper.Keeper) MsgRouter {
+	return MsgRouter{
+		router: router,
+		keeper: keeper,
+	}
+}
+
+// Handler implements baseapp.MessageRouter.
+func (r MsgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.guard(r.router.Handler(msg))
+}
+
+// HandlerByTypeURL implements baseapp.MessageRouter.
+func (r MsgRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	return r.guard(r.router.HandlerByTypeURL(typeURL))
+}
+
+// guard runs the handler once the message is within its bounds.
+func (r MsgRouter) guard(handler baseapp.MsgServiceHandler) baseapp.MsgServiceHandler {
+	if handler == nil {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := r.keeper.CheckMsg(ctx, msg); err != nil {
+			return nil, err
+		}
+		return handler(ctx, msg)
+	}
+}
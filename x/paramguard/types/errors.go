@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidAuthority = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrInvalidParams    = errorsmod.Register(ModuleName, 3, "invalid params")
+	ErrOutOfBounds      = errorsmod.Register(ModuleName, 4, "parameter out of bounds")
+)
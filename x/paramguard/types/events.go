@@ -0,0 +1,8 @@
+package types
+
+// paramguard module event types and attribute keys
+const (
+	EventTypeUpdateParams = "param_bounds_updated"
+
+	AttributeKeyBounds = "bounds"
+)
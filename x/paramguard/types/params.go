@@ -0,0 +1,215 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultParams returns the default parameters. No field is bounded until
+// governance sets bounds.
+func DefaultParams() Params {
+	return Params{
+		Bounds: []Bound{},
+	}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.Bounds))
+	for _, b := range p.Bounds {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		id := b.MsgTypeUrl + " " + b.Path
+		if _, ok := seen[id]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate bound for %s of %s", b.Path, b.MsgTypeUrl)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
+// Check returns an error if a field of the JSON encoded message of type
+// typeURL is outside its bounds. Bounded fields missing from the message are
+// rejected too.
+func (p Params) Check(typeURL string, msgJSON []byte) error {
+	var msg any
+	for _, b := range p.Bounds {
+		if b.MsgTypeUrl != typeURL {
+			continue
+		}
+		if msg == nil {
+			decoder := json.NewDecoder(bytes.NewReader(msgJSON))
+			decoder.UseNumber()
+			if err := decoder.Decode(&msg); err != nil {
+				return err
+			}
+		}
+		if err := b.Check(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasBounds returns whether a field of the messages of type typeURL is
+// bounded.
+func (p Params) HasBounds(typeURL string) bool {
+	for _, b := range p.Bounds {
+		if b.MsgTypeUrl == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs basic validation of the bound.
+func (b Bound) Validate() error {
+	if !strings.HasPrefix(b.MsgTypeUrl, "/") || strings.TrimSpace(b.MsgTypeUrl) != b.MsgTypeUrl {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid message type url %q", b.MsgTypeUrl)
+	}
+	for _, segment := range strings.Split(b.Path, ".") {
+		if segment == "" {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid path %q of %s", b.Path, b.MsgTypeUrl)
+		}
+	}
+	if b.Min == "" && b.Max == "" {
+		return errorsmod.Wrapf(ErrInvalidParams, "bound for %s of %s has neither a min nor a max", b.Path, b.MsgTypeUrl)
+	}
+
+	lower, upper, err := b.limits()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "bound for %s of %s: %s", b.Path, b.MsgTypeUrl, err)
+	}
+	if lower != nil && upper != nil {
+		if lower.duration != upper.duration {
+			return errorsmod.Wrapf(ErrInvalidParams, "min and max of %s must both be decimals or durations", b.Path)
+		}
+		if lower.value.GT(upper.value) {
+			return errorsmod.Wrapf(ErrInvalidParams, "min of %s is above its max", b.Path)
+		}
+	}
+	return nil
+}
+
+// Check returns an error if the field of the decoded JSON message is
+// outside the bound.
+func (b Bound) Check(msg any) error {
+	field, ok := lookupPath(msg, b.Path)
+	if !ok {
+		return errorsmod.Wrapf(ErrOutOfBounds, "%s of %s is not set", b.Path, b.MsgTypeUrl)
+	}
+	var s string
+	switch v := field.(type) {
+	case json.Number:
+		s = v.String()
+	case string:
+		s = v
+	default:
+		return errorsmod.Wrapf(ErrOutOfBounds, "%s of %s is not a number", b.Path, b.MsgTypeUrl)
+	}
+
+	lower, upper, err := b.limits()
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "bound for %s of %s: %s", b.Path, b.MsgTypeUrl, err)
+	}
+	duration := lower != nil && lower.duration || upper != nil && upper.duration
+	value, err := parseValue(s, duration)
+	if err != nil {
+		return errorsmod.Wrapf(ErrOutOfBounds, "%s of %s: %s", b.Path, b.MsgTypeUrl, err)
+	}
+	if lower != nil && value.LT(lower.value) {
+		return errorsmod.Wrapf(ErrOutOfBounds, "%s of %s is %s, below the minimum %s", b.Path, b.MsgTypeUrl, s, b.Min)
+	}
+	if upper != nil && value.GT(upper.value) {
+		return errorsmod.Wrapf(ErrOutOfBounds, "%s of %s is %s, above the maximum %s", b.Path, b.MsgTypeUrl, s, b.Max)
+	}
+	return nil
+}
+
+// limits parses the min and max of the bound, nil when unset.
+func (b Bound) limits() (lower, upper *boundValue, err error) {
+	if b.Min != "" {
+		v, err := parseBoundValue(b.Min)
+		if err != nil {
+			return nil, nil, fmt.Errorf("min: %w", err)
+		}
+		lower = &v
+	}
+	if b.Max != "" {
+		v, err := parseBoundValue(b.Max)
+		if err != nil {
+			return nil, nil, fmt.Errorf("max: %w", err)
+		}
+		upper = &v
+	}
+	return lower, upper, nil
+}
+
+// HasPath returns whether the path of the bound exists in the decoded JSON
+// message.
+func (b Bound) HasPath(msg any) bool {
+	_, ok := lookupPath(msg, b.Path)
+	return ok
+}
+
+// boundValue is a decimal, or a duration in nanoseconds.
+type boundValue struct {
+	value    sdkmath.LegacyDec
+	duration bool
+}
+
+// parseBoundValue parses a decimal or, failing that, a duration.
+func parseBoundValue(s string) (boundValue, error) {
+	if value, err := parseValue(s, false); err == nil {
+		return boundValue{value: value}, nil
+	}
+	value, err := parseValue(s, true)
+	if err != nil {
+		return boundValue{}, fmt.Errorf("%q is neither a decimal nor a duration", s)
+	}
+	return boundValue{value: value, duration: true}, nil
+}
+
+// parseValue parses a decimal, or a duration as nanoseconds.
+func parseValue(s string, duration bool) (sdkmath.LegacyDec, error) {
+	if !duration {
+		return sdkmath.LegacyNewDecFromStr(s)
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	return sdkmath.LegacyNewDec(int64(d)), nil
+}
+
+// lookupPath returns the value at the dot separated path of a decoded JSON
+// document. Numeric segments index arrays.
+func lookupPath(doc any, path string) (any, bool) {
+	for _, segment := range strings.Split(path, ".") {
+		switch v := doc.(type) {
+		case map[string]any:
+			field, ok := v[segment]
+			if !ok {
+				return nil, false
+			}
+			doc = field
+		case []any:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, false
+			}
+			doc = v[i]
+		default:
+			return nil, false
+		}
+	}
+	return doc, doc != nil
+}
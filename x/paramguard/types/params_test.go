@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hyphacoop/cosmos-enoki/x/paramguard/types"
+)
+
+const msgType = "/cosmos.staking.v1beta1.MsgUpdateParams"
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		valid  bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"decimal bounds", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params.min_commission_rate", Min: "0", Max: "0.5"}}}, true},
+		{"duration bounds", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params.unbonding_time", Min: "24h", Max: "1814400s"}}}, true},
+		{"min only", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params.max_validators", Min: "1"}}}, true},
+		{"array index", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params.values.0", Max: "1"}}}, true},
+		{"no limits", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params.max_validators"}}}, false},
+		{"invalid type url", types.Params{Bounds: []types.Bound{{MsgTypeUrl: "cosmos.staking.v1beta1.MsgUpdateParams", Path: "params.max_validators", Min: "1"}}}, false},
+		{"empty path", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Min: "1"}}}, false},
+		{"empty path segment", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params..max_validators", Min: "1"}}}, false},
+		{"invalid min", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params.max_validators", Min: "one"}}}, false},
+		{"min above max", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params.max_validators", Min: "10", Max: "1"}}}, false},
+		{"mixed kinds", types.Params{Bounds: []types.Bound{{MsgTypeUrl: msgType, Path: "params.unbonding_time", Min: "1", Max: "1h"}}}, false},
+		{"duplicate bound", types.Params{Bounds: []types.Bound{
+			{MsgTypeUrl: msgType, Path: "params.max_validators", Min: "1"},
+			{MsgTypeUrl: msgType, Path: "params.max_validators", Max: "100"},
+		}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestParamsCheck(t *testing.T) {
+	params := types.Params{Bounds: []types.Bound{
+		{MsgTypeUrl: msgType, Path: "params.max_validators", Min: "1", Max: "200"},
+		{MsgTypeUrl: msgType, Path: "params.unbonding_time", Min: "24h"},
+		{MsgTypeUrl: msgType, Path: "params.min_commission_rate", Max: "0.5"},
+	}}
+	require.True(t, params.HasBounds(msgType))
+	require.False(t, params.HasBounds("/cosmos.bank.v1beta1.MsgUpdateParams"))
+
+	testCases := []struct {
+		name string
+		json string
+		err  string
+	}{
+		{"within bounds", `{"params":{"max_validators":100,"unbonding_time":"1814400s","min_commission_rate":"0.050000000000000000"}}`, ""},
+		{"inclusive bounds", `{"params":{"max_validators":200,"unbonding_time":"86400s","min_commission_rate":"0.5"}}`, ""},
+		{"above max", `{"params":{"max_validators":201,"unbonding_time":"1814400s","min_commission_rate":"0"}}`, "params.max_validators of " + msgType + " is 201, above the maximum 200"},
+		{"below min", `{"params":{"max_validators":0,"unbonding_time":"1814400s","min_commission_rate":"0"}}`, "is 0, below the minimum 1"},
+		{"short duration", `{"params":{"max_validators":1,"unbonding_time":"3600s","min_commission_rate":"0"}}`, "is 3600s, below the minimum 24h"},
+		{"decimal above max", `{"params":{"max_validators":1,"unbonding_time":"86400s","min_commission_rate":"0.6"}}`, "above the maximum 0.5"},
+		{"missing field", `{"params":{"max_validators":1,"unbonding_time":"86400s"}}`, "params.min_commission_rate of " + msgType + " is not set"},
+		{"not a number", `{"params":{"max_validators":true,"unbonding_time":"86400s","min_commission_rate":"0"}}`, "is not a number"},
+		{"not a duration", `{"params":{"max_validators":1,"unbonding_time":"86400","min_commission_rate":"0"}}`, "params.unbonding_time"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := params.Check(msgType, []byte(tc.json))
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrOutOfBounds)
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+
+	// other message types are not checked
+	require.NoError(t, params.Check("/cosmos.bank.v1beta1.MsgUpdateParams", []byte(`{}`)))
+}
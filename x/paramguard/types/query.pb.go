@@ -0,0 +1,601 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: enoki/paramguard/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryBoundsRequest is the request type for the Query/Bounds RPC method.
+type QueryBoundsRequest struct {
+	// msg_type_url restricts the bounds to a message type when set.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *QueryBoundsRequest) Reset()         { *m = QueryBoundsRequest{} }
+func (m *QueryBoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBoundsRequest) ProtoMessage()    {}
+func (*QueryBoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f649dee03c7ad20c, []int{0}
+}
+func (m *QueryBoundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoundsRequest.Merge(m, src)
+}
+func (m *QueryBoundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoundsRequest proto.InternalMessageInfo
+
+func (m *QueryBoundsRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// QueryBoundsResponse is the response type for the Query/Bounds RPC method.
+type QueryBoundsResponse struct {
+	Bounds []Bound `protobuf:"bytes,1,rep,name=bounds,proto3" json:"bounds"`
+}
+
+func (m *QueryBoundsResponse) Reset()         { *m = QueryBoundsResponse{} }
+func (m *QueryBoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBoundsResponse) ProtoMessage()    {}
+func (*QueryBoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f649dee03c7ad20c, []int{1}
+}
+func (m *QueryBoundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBoundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBoundsResponse.Merge(m, src)
+}
+func (m *QueryBoundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBoundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBoundsResponse proto.InternalMessageInfo
+
+func (m *QueryBoundsResponse) GetBounds() []Bound {
+	if m != nil {
+		return m.Bounds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryBoundsRequest)(nil), "enoki.paramguard.v1.QueryBoundsRequest")
+	proto.RegisterType((*QueryBoundsResponse)(nil), "enoki.paramguard.v1.QueryBoundsResponse")
+}
+
+func init() { proto.RegisterFile("enoki/paramguard/v1/query.proto", fileDescriptor_f649dee03c7ad20c) }
+
+var fileDescriptor_f649dee03c7ad20c = []byte{
+	// 339 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x31, 0x4b, 0xfb, 0x40,
+	0x18, 0xc6, 0x73, 0xff, 0x3f, 0x16, 0x7a, 0xba, 0x78, 0x75, 0x28, 0x51, 0xd3, 0x50, 0x07, 0x8b,
+	0x60, 0x8e, 0x56, 0x71, 0x73, 0xe9, 0x07, 0x10, 0x2c, 0x75, 0x71, 0x29, 0xd7, 0xf6, 0xb8, 0x06,
+	0x9b, 0x7b, 0xaf, 0xb9, 0x5c, 0x31, 0x9b, 0x38, 0x3a, 0x09, 0x7e, 0x09, 0x47, 0x3f, 0x46, 0xc7,
+	0x82, 0x8b, 0x93, 0x48, 0x2b, 0xf8, 0x35, 0xa4, 0x97, 0x80, 0x8a, 0x01, 0x97, 0xf0, 0xe6, 0xbd,
+	0xe7, 0xf9, 0xdd, 0x73, 0x0f, 0xae, 0x71, 0x09, 0x57, 0x21, 0x55, 0x2c, 0x66, 0x91, 0x30, 0x2c,
+	0x1e, 0xd2, 0x69, 0x93, 0x4e, 0x0c, 0x8f, 0xd3, 0x40, 0xc5, 0x90, 0x00, 0xa9, 0x58, 0x41, 0xf0,
+	0x25, 0x08, 0xa6, 0x4d, 0x77, 0x93, 0x45, 0xa1, 0x04, 0x6a, 0xbf, 0x99, 0xce, 0xdd, 0x12, 0x20,
+	0xc0, 0x8e, 0x74, 0x35, 0xe5, 0xdb, 0x1d, 0x01, 0x20, 0xc6, 0x9c, 0x32, 0x15, 0x52, 0x26, 0x25,
+	0x24, 0x2c, 0x09, 0x41, 0xea, 0xfc, 0xd4, 0x2f, 0xba, 0xdc, 0xfe, 0xe5, 0x8a, 0xfa, 0x09, 0x26,
+	0xe7, 0xab, 0x30, 0x6d, 0x30, 0x72, 0xa8, 0x3b, 0x7c, 0x62, 0xb8, 0x4e, 0x88, 0x8f, 0x37, 0x22,
+	0x2d, 0x7a, 0x49, 0xaa, 0x78, 0xcf, 0xc4, 0xe3, 0x2a, 0xf2, 0x51, 0xa3, 0xdc, 0xc1, 0x91, 0x16,
+	0xdd, 0x54, 0xf1, 0x8b, 0x78, 0x5c, 0xef, 0xe2, 0xca, 0x0f, 0x9f, 0x56, 0x20, 0x35, 0x27, 0xa7,
+	0xb8, 0xd4, 0xb7, 0x9b, 0x2a, 0xf2, 0xff, 0x37, 0xd6, 0x5b, 0x6e, 0x50, 0xf0, 0xba, 0xc0, 0x9a,
+	0xda, 0xe5, 0xd9, 0x6b, 0xcd, 0x79, 0xfc, 0x78, 0x3a, 0x40, 0x9d, 0xdc, 0xd4, 0xba, 0x43, 0x78,
+	0xcd, 0x62, 0xc9, 0x0d, 0xc2, 0xa5, 0x8c, 0x4d, 0xf6, 0x0b, 0x19, 0xbf, 0x53, 0xbb, 0x8d, 0xbf,
+	0x85, 0x59, 0xcc, 0xfa, 0xde, 0xed, 0xf3, 0xfb, 0xc3, 0xbf, 0x5d, 0xb2, 0x4d, 0x8b, 0x0a, 0xca,
+	0xc2, 0xb4, 0xcf, 0x66, 0x0b, 0x0f, 0xcd, 0x17, 0x1e, 0x7a, 0x5b, 0x78, 0xe8, 0x7e, 0xe9, 0x39,
+	0xf3, 0xa5, 0xe7, 0xbc, 0x2c, 0x3d, 0xe7, 0xf2, 0x58, 0x84, 0xc9, 0xc8, 0xf4, 0x83, 0x01, 0x44,
+	0x74, 0x94, 0xaa, 0x11, 0x1b, 0x00, 0x28, 0x3a, 0x00, 0x1d, 0x81, 0x3e, 0xcc, 0x88, 0xd7, 0xdf,
+	0x99, 0xab, 0x1e, 0x75, 0xbf, 0x64, 0x1b, 0x3f, 0xfa, 0x1c, 0x00, 0x31, 0x52, 0xc5, 0x51, 0x12,
+	0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Bounds returns the active bounds, optionally of a single message type.
+	Bounds(ctx context.Context, in *QueryBoundsRequest, opts ...grpc.CallOption) (*QueryBoundsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Bounds(ctx context.Context, in *QueryBoundsRequest, opts ...grpc.CallOption) (*QueryBoundsResponse, error) {
+	out := new(QueryBoundsResponse)
+	err := c.cc.Invoke(ctx, "/enoki.paramguard.v1.Query/Bounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Bounds returns the active bounds, optionally of a single message type.
+	Bounds(context.Context, *QueryBoundsRequest) (*QueryBoundsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Bounds(ctx context.Context, req *QueryBoundsRequest) (*QueryBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bounds not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Bounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Bounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enoki.paramguard.v1.Query/Bounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Bounds(ctx, req.(*QueryBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "enoki.paramguard.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Bounds",
+			Handler:    _Query_Bounds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "enoki/paramguard/v1/query.proto",
+}
+
+func (m *QueryBoundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bounds) > 0 {
+		for iNdEx := len(m.Bounds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bounds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryBoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bounds) > 0 {
+		for _, e := range m.Bounds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryBoundsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBoundsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBoundsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBoundsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bounds = append(m.Bounds, Bound{})
+			if err := m.Bounds[len(m.Bounds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
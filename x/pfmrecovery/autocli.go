@@ -0,0 +1,42 @@
+package pfmrecovery
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "InFlightPackets",
+					Use:       "in-flight-packets",
+					Short:     "Query the packets forwarded by the packet forward middleware that are still in flight",
+				},
+				{
+					RpcMethod:      "InFlightPacket",
+					Use:            "in-flight-packet [channel-id] [port-id] [sequence]",
+					Short:          "Query a forwarded packet that is still in flight",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "port_id"}, {ProtoField: "sequence"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "RefundInFlightPacket",
+					Use:            "refund-in-flight-packet [channel-id] [port-id] [sequence] [proof-unreceived] [proof-height]",
+					Short:          "Submit a proposal to refund a stuck forward to the original sender on the previous hop",
+					Long:           "Submit a proposal to refund a stuck forward to the original sender on the previous hop. The proof of the packet receipt absence, queried on the next hop, must be at a height past the forward timeout that the client of the channel has a consensus state for, which an expired client keeps. A forward whose client is frozen, or expired before the forward timed out, completes with a normal MsgTimeout once the client is recovered with ibc-recover.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "port_id"}, {ProtoField: "sequence"}, {ProtoField: "proof_unreceived"}, {ProtoField: "proof_height"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,175 @@
+package pfmrecovery
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/keeper"
+)
+
+var (
+	_ porttypes.IBCModule             = IBCMiddleware{}
+	_ porttypes.PacketDataUnmarshaler = IBCMiddleware{}
+)
+
+// IBCMiddleware sits on top of the packet forward middleware and absorbs the
+// acknowledgements and timeouts of forwards refunded by governance.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the packet forward
+// middleware.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnRecvPacket implements the IBCModule interface. The time of the forward
+// the packet forward middleware sends for the packet is recorded.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	id, forwarded := im.keeper.NextForwardID(ctx, packet)
+	ack := im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if forwarded {
+		// the forward is already sent, so a failure to record it is only
+		// logged
+		if err := im.keeper.TrackForward(ctx, id); err != nil {
+			im.keeper.Logger(ctx).Error("failed to record forward", "channel_id", id.K1(), "port_id", id.K2(), "sequence", id.K3(), "error", err)
+		}
+	}
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. The
+// acknowledgement of a refunded forward is not passed on.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	ackSuccess := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+
+	settled, err := im.keeper.SettleRefunded(ctx, packet, ackSuccess)
+	if err != nil {
+		return err
+	}
+	if settled {
+		return nil
+	}
+
+	if err := im.app.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	return im.keeper.UntrackForward(ctx, collections.Join3(packet.SourceChannel, packet.SourcePort, packet.Sequence))
+}
+
+// OnTimeoutPacket implements the IBCModule interface. The timeout of a
+// refunded forward is not passed on. The time of the retry the packet
+// forward middleware sends for a timed out forward is recorded.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	settled, err := im.keeper.SettleRefunded(ctx, packet, false)
+	if err != nil {
+		return err
+	}
+	if settled {
+		return nil
+	}
+
+	retry, found := im.keeper.NextSendID(ctx, packet.SourcePort, packet.SourceChannel)
+	if err := im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer); err != nil {
+		return err
+	}
+	if err := im.keeper.UntrackForward(ctx, collections.Join3(packet.SourceChannel, packet.SourcePort, packet.Sequence)); err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+	return im.keeper.TrackForward(ctx, retry)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface by
+// delegating to the wrapped app.
+func (im IBCMiddleware) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (any, string, error) {
+	unmarshaler, ok := im.app.(porttypes.PacketDataUnmarshaler)
+	if !ok {
+		return nil, "", fmt.Errorf("%T does not implement PacketDataUnmarshaler", im.app)
+	}
+	return unmarshaler.UnmarshalPacketData(ctx, portID, channelID, bz)
+}
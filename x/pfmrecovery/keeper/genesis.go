@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state. The
+// in-flight packets of the packet forward middleware without a record are
+// recorded at the block time.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, r := range genState.InFlight {
+		if err := k.InFlight.Set(ctx, collections.Join3(r.ChannelId, r.PortId, r.Sequence), r.ForwardedAt); err != nil {
+			return err
+		}
+	}
+
+	for _, r := range genState.Refunded {
+		if err := k.Refunded.Set(ctx, collections.Join3(r.ChannelId, r.PortId, r.Sequence)); err != nil {
+			return err
+		}
+	}
+
+	return k.trackInFlightPackets(sdk.UnwrapSDKContext(ctx))
+}
+
+// ExportGenesis returns the module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	genState := types.DefaultGenesis()
+
+	err := k.InFlight.Walk(ctx, nil, func(id PacketID, forwardedAt time.Time) (bool, error) {
+		genState.InFlight = append(genState.InFlight, types.InFlightRecord{
+			ChannelId:   id.K1(),
+			PortId:      id.K2(),
+			Sequence:    id.K3(),
+			ForwardedAt: forwardedAt,
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Refunded.Walk(ctx, nil, func(id PacketID) (bool, error) {
+		genState.Refunded = append(genState.Refunded, types.RefundedPacket{
+			ChannelId: id.K1(),
+			PortId:    id.K2(),
+			Sequence:  id.K3(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genState, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// InFlightPackets returns the packets forwarded by the packet forward
+// middleware that are still in flight, paginating over its store.
+func (k Keeper) InFlightPackets(goCtx context.Context, req *types.QueryInFlightPacketsRequest) (*types.QueryInFlightPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := runtime.KVStoreAdapter(k.pfmStoreService.OpenKVStore(ctx))
+
+	var packets []types.InFlightForward
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		channelID, portID, sequence, err := types.ParseInFlightPacketKey(string(key))
+		if err != nil {
+			// not an in-flight packet
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+
+		var inFlightPacket pfmtypes.InFlightPacket
+		if err := k.cdc.Unmarshal(value, &inFlightPacket); err != nil {
+			return false, err
+		}
+		forward, err := k.InFlightForward(ctx, collections.Join3(channelID, portID, sequence), inFlightPacket)
+		if err != nil {
+			return false, err
+		}
+		packets = append(packets, forward)
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInFlightPacketsResponse{Packets: packets, Pagination: pageRes}, nil
+}
+
+// InFlightPacket returns a single in-flight forwarded packet.
+func (k Keeper) InFlightPacket(goCtx context.Context, req *types.QueryInFlightPacketRequest) (*types.QueryInFlightPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidatePacketID(req.ChannelId, req.PortId, req.Sequence); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	id := collections.Join3(req.ChannelId, req.PortId, req.Sequence)
+	inFlightPacket, found, err := k.getInFlightPacket(ctx, id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no in-flight packet forwarded on %s/%s with sequence %d", req.PortId, req.ChannelId, req.Sequence)
+	}
+
+	forward, err := k.InFlightForward(ctx, id, inFlightPacket)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryInFlightPacketResponse{Packet: forward}, nil
+}
@@ -0,0 +1,440 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/types"
+)
+
+// PacketID identifies a forwarded packet by (channel id, port id, sequence).
+type PacketID = collections.Triple[string, string, uint64]
+
+// Keeper tracks the packets in flight in the packet forward middleware and
+// lets governance refund the stuck ones.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	// the store of the packet forward middleware, only read to look up its
+	// in-flight packets
+	pfmStoreService store.KVStoreService
+
+	packetForwardKeeper types.PacketForwardKeeper
+	channelKeeper       types.ChannelKeeper
+	connectionKeeper    types.ConnectionKeeper
+	clientKeeper        types.ClientKeeper
+
+	// the address capable of executing a MsgRefundInFlightPacket message.
+	// Typically, this should be the x/gov module account.
+	authority string
+
+	Schema   collections.Schema
+	InFlight collections.Map[PacketID, time.Time]
+	Refunded collections.KeySet[PacketID]
+}
+
+// NewKeeper returns a new pfmrecovery keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	pfmStoreService store.KVStoreService,
+	packetForwardKeeper types.PacketForwardKeeper,
+	channelKeeper types.ChannelKeeper,
+	connectionKeeper types.ConnectionKeeper,
+	clientKeeper types.ClientKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	packetIDCodec := collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint64Key)
+
+	k := Keeper{
+		cdc:                 cdc,
+		storeService:        storeService,
+		pfmStoreService:     pfmStoreService,
+		packetForwardKeeper: packetForwardKeeper,
+		channelKeeper:       channelKeeper,
+		connectionKeeper:    connectionKeeper,
+		clientKeeper:        clientKeeper,
+		authority:           authority,
+
+		InFlight: collections.NewMap(sb, types.InFlightKey, "in_flight", packetIDCodec, collcodec.KeyToValueCodec(sdk.TimeKey)),
+		Refunded: collections.NewKeySet(sb, types.RefundedKey, "refunded", packetIDCodec),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// NextForwardID returns the id the packet forward middleware would give a
+// forward of the received packet, from the channel in its memo and the next
+// send sequence of that channel.
+func (k Keeper) NextForwardID(ctx sdk.Context, packet channeltypes.Packet) (PacketID, bool) {
+	portID, channelID, ok := types.ForwardChannel(packet.GetData())
+	if !ok {
+		return PacketID{}, false
+	}
+	return k.NextSendID(ctx, portID, channelID)
+}
+
+// NextSendID returns the id of the next packet sent on the channel.
+func (k Keeper) NextSendID(ctx sdk.Context, portID, channelID string) (PacketID, bool) {
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return PacketID{}, false
+	}
+	return collections.Join3(channelID, portID, sequence), true
+}
+
+// TrackForward records the block time as the time of the forward id if the
+// packet forward middleware holds it in flight. The middleware calls it once
+// the packet forward middleware handled a packet, so forwarded_at is the time
+// of the block the packet was forwarded in.
+func (k Keeper) TrackForward(ctx sdk.Context, id PacketID) error {
+	_, found, err := k.getInFlightPacket(ctx, id)
+	if err != nil || !found {
+		return err
+	}
+	has, err := k.InFlight.Has(ctx, id)
+	if err != nil || has {
+		return err
+	}
+	return k.InFlight.Set(ctx, id, ctx.BlockTime())
+}
+
+// UntrackForward drops the record of the forward id once the packet forward
+// middleware no longer holds it in flight.
+func (k Keeper) UntrackForward(ctx sdk.Context, id PacketID) error {
+	_, found, err := k.getInFlightPacket(ctx, id)
+	if err != nil || found {
+		return err
+	}
+	return k.InFlight.Remove(ctx, id)
+}
+
+// trackInFlightPackets records the block time for the in-flight packets of
+// the packet forward middleware that have no record yet, like the forwards
+// made before the module was added.
+func (k Keeper) trackInFlightPackets(ctx sdk.Context) error {
+	iter, err := k.pfmStoreService.OpenKVStore(ctx).Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	var untracked []PacketID
+	for ; iter.Valid(); iter.Next() {
+		channelID, portID, sequence, err := types.ParseInFlightPacketKey(string(iter.Key()))
+		if err != nil {
+			continue
+		}
+		id := collections.Join3(channelID, portID, sequence)
+		has, err := k.InFlight.Has(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !has {
+			untracked = append(untracked, id)
+		}
+	}
+
+	for _, id := range untracked {
+		if err := k.InFlight.Set(ctx, id, ctx.BlockTime()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InFlightForward describes the in-flight forward id, which the packet
+// forward middleware stores as inFlightPacket.
+func (k Keeper) InFlightForward(ctx sdk.Context, id PacketID, inFlightPacket pfmtypes.InFlightPacket) (types.InFlightForward, error) {
+	data, err := types.ForwardedPacketData(&inFlightPacket)
+	if err != nil {
+		return types.InFlightForward{}, err
+	}
+
+	forwardedAt, err := k.InFlight.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		// not tracked, which leaves the timeout of the forward no earlier than
+		// it is
+		forwardedAt = ctx.BlockTime()
+	} else if err != nil {
+		return types.InFlightForward{}, err
+	}
+
+	return types.InFlightForward{
+		ChannelId:             id.K1(),
+		PortId:                id.K2(),
+		Sequence:              id.K3(),
+		OriginalSenderAddress: inFlightPacket.OriginalSenderAddress,
+		RefundChannelId:       inFlightPacket.RefundChannelId,
+		RefundPortId:          inFlightPacket.RefundPortId,
+		RefundSequence:        inFlightPacket.RefundSequence,
+		Denom:                 data.Denom,
+		Amount:                data.Amount,
+		ForwardedAt:           forwardedAt,
+		Age:                   ctx.BlockTime().Sub(forwardedAt),
+		Status:                k.forwardStatus(ctx, id, forwardTimeout(forwardedAt, inFlightPacket)),
+		RetriesRemaining:      inFlightPacket.RetriesRemaining,
+		Nonrefundable:         inFlightPacket.Nonrefundable,
+	}, nil
+}
+
+// forwardTimeout returns the timeout timestamp of a forward sent at
+// forwardedAt, or 0 if it has none. The packet forward middleware sets it to
+// the block time of the forward plus the forward timeout, in nanoseconds.
+func forwardTimeout(forwardedAt time.Time, inFlightPacket pfmtypes.InFlightPacket) uint64 {
+	if inFlightPacket.Timeout == 0 {
+		return 0
+	}
+	return uint64(forwardedAt.UnixNano()) + inFlightPacket.Timeout
+}
+
+// forwardStatus tells whether the forward id can still complete. The status
+// is informational: a refund needs a proof of non-receipt whatever it is.
+func (k Keeper) forwardStatus(ctx sdk.Context, id PacketID, timeoutTimestamp uint64) types.ForwardStatus {
+	channel, found := k.channelKeeper.GetChannel(ctx, id.K2(), id.K1())
+	if !found || channel.State != channeltypes.OPEN {
+		return types.FORWARD_STATUS_CHANNEL_CLOSED
+	}
+
+	clientID, _, err := k.channelKeeper.GetChannelClientState(ctx, id.K2(), id.K1())
+	if err != nil || k.clientKeeper.GetClientStatus(ctx, clientID) != ibcexported.Active {
+		return types.FORWARD_STATUS_CLIENT_INACTIVE
+	}
+
+	// the packet times out by the time of the next hop, as known to the
+	// client, not by the block time of this chain
+	latest, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, clientID, k.clientKeeper.GetClientLatestHeight(ctx, clientID))
+	if err == nil && timeoutTimestamp > 0 && latest >= timeoutTimestamp {
+		return types.FORWARD_STATUS_TIMED_OUT
+	}
+
+	return types.FORWARD_STATUS_PENDING
+}
+
+// getInFlightPacket returns the packet forward middleware entry of id.
+func (k Keeper) getInFlightPacket(ctx sdk.Context, id PacketID) (pfmtypes.InFlightPacket, bool, error) {
+	bz, err := k.pfmStoreService.OpenKVStore(ctx).Get(types.InFlightPacketKey(id.K1(), id.K2(), id.K3()))
+	if err != nil || bz == nil {
+		return pfmtypes.InFlightPacket{}, false, err
+	}
+	var inFlightPacket pfmtypes.InFlightPacket
+	if err := k.cdc.Unmarshal(bz, &inFlightPacket); err != nil {
+		return pfmtypes.InFlightPacket{}, false, err
+	}
+	return inFlightPacket, true, nil
+}
+
+// RefundInFlightPacket clears the in-flight forward id and writes an error
+// acknowledgement for the original packet, so the previous hop refunds the
+// original sender. proof must show that the next hop had not received the
+// forward at proofHeight, when its timeout had passed, so that it can never
+// be received. Its eventual timeout is absorbed by SettleRefunded.
+func (k Keeper) RefundInFlightPacket(ctx sdk.Context, id PacketID, proof []byte, proofHeight clienttypes.Height) error {
+	inFlightPacket, found, err := k.getInFlightPacket(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%w: %s", types.ErrInFlightPacketNotFound, types.InFlightPacketKey(id.K1(), id.K2(), id.K3()))
+	}
+
+	forward, err := k.InFlightForward(ctx, id, inFlightPacket)
+	if err != nil {
+		return err
+	}
+	if err := k.verifyUnreceived(ctx, id, forwardTimeout(forward.ForwardedAt, inFlightPacket), proof, proofHeight); err != nil {
+		return err
+	}
+
+	data := transfertypes.NewFungibleTokenPacketData(forward.Denom, forward.Amount, "", "", "")
+	channel, _ := k.channelKeeper.GetChannel(ctx, id.K2(), id.K1())
+	packet := channeltypes.Packet{
+		Sequence:           id.K3(),
+		SourcePort:         id.K2(),
+		SourceChannel:      id.K1(),
+		DestinationPort:    channel.Counterparty.PortId,
+		DestinationChannel: channel.Counterparty.ChannelId,
+		Data:               data.GetBytes(),
+	}
+
+	cleared := k.packetForwardKeeper.GetAndClearInFlightPacket(ctx, id.K1(), id.K2(), id.K3())
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrForwardRefunded)
+	if err := k.packetForwardKeeper.WriteAcknowledgementForForwardedPacket(ctx, packet, data, cleared, ack); err != nil {
+		return err
+	}
+
+	if err := k.InFlight.Remove(ctx, id); err != nil {
+		return err
+	}
+	if err := k.Refunded.Set(ctx, id); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundForward,
+		sdk.NewAttribute(types.AttributeKeyChannelID, id.K1()),
+		sdk.NewAttribute(types.AttributeKeyPortID, id.K2()),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprint(id.K3())),
+		sdk.NewAttribute(types.AttributeKeyOriginalSender, inFlightPacket.OriginalSenderAddress),
+		sdk.NewAttribute(types.AttributeKeyRefundChannelID, inFlightPacket.RefundChannelId),
+		sdk.NewAttribute(types.AttributeKeyRefundSequence, fmt.Sprint(inFlightPacket.RefundSequence)),
+		sdk.NewAttribute(types.AttributeKeyStatus, forward.Status.String()),
+	))
+
+	return nil
+}
+
+// verifyUnreceived checks, like a MsgTimeout does, that the forward id timed
+// out by the consensus state of the next hop at proofHeight and that proof
+// shows no receipt for it at that height. The consensus states an expired
+// client stored while it was trusted remain trusted, so a forward that timed
+// out by one of them is refunded with a proof at its height. A forward whose
+// channel is closed or whose client is frozen, or that has no consensus state
+// past its timeout, is pending: recover the client with a MsgRecoverClient
+// proposal and relay a MsgTimeout, or refund it with a proof.
+func (k Keeper) verifyUnreceived(ctx sdk.Context, id PacketID, timeoutTimestamp uint64, proof []byte, proofHeight clienttypes.Height) error {
+	if len(k.channelKeeper.GetPacketCommitment(ctx, id.K2(), id.K1(), id.K3())) == 0 {
+		return fmt.Errorf("%w: no commitment for the forwarded packet", types.ErrInvalidProof)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, id.K2(), id.K1())
+	if !found {
+		return fmt.Errorf("%w: channel %s not found", types.ErrInvalidProof, id.K1())
+	}
+	if channel.Ordering != channeltypes.UNORDERED {
+		return fmt.Errorf("%w: only forwards on unordered channels can be refunded", types.ErrInvalidProof)
+	}
+	connection, err := k.channelKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	verifyReceiptAbsence := k.connectionKeeper.VerifyPacketReceiptAbsence
+	switch status := k.clientKeeper.GetClientStatus(ctx, connection.ClientId); status {
+	case ibcexported.Active:
+	case ibcexported.Expired:
+		verifyReceiptAbsence = k.verifyExpiredReceiptAbsence
+	default:
+		return fmt.Errorf("%w: client %s is %s, recover it with a MsgRecoverClient proposal then relay a MsgTimeout", types.ErrForwardPending, connection.ClientId, status)
+	}
+	if timeoutTimestamp == 0 {
+		return fmt.Errorf("%w: the forward has no timeout timestamp", types.ErrForwardPending)
+	}
+	proofTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, connection.ClientId, proofHeight)
+	if err != nil {
+		return fmt.Errorf("%w: %w", types.ErrInvalidProof, err)
+	}
+	if proofTimestamp < timeoutTimestamp {
+		return fmt.Errorf("%w: the next hop is at %s at proof height %s, before the forward timeout at %s",
+			types.ErrForwardPending, time.Unix(0, int64(proofTimestamp)).UTC(), proofHeight, time.Unix(0, int64(timeoutTimestamp)).UTC())
+	}
+
+	if err := verifyReceiptAbsence(
+		ctx, connection, proofHeight, proof, channel.Counterparty.PortId, channel.Counterparty.ChannelId, id.K3(),
+	); err != nil {
+		return fmt.Errorf("%w: %w", types.ErrInvalidProof, err)
+	}
+	return nil
+}
+
+// verifyExpiredReceiptAbsence is VerifyPacketReceiptAbsence of the
+// connection keeper for an expired client, which the client keeper refuses
+// to verify against. The light client module checks the proof against the
+// consensus state at height like it does for an active client.
+func (k Keeper) verifyExpiredReceiptAbsence(
+	ctx sdk.Context,
+	connection connectiontypes.ConnectionEnd,
+	height ibcexported.Height,
+	proof []byte,
+	portID, channelID string,
+	sequence uint64,
+) error {
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix,
+		commitmenttypes.NewMerklePath(host.PacketReceiptKey(portID, channelID, sequence)))
+	if err != nil {
+		return err
+	}
+
+	clientModule, err := k.clientKeeper.Route(ctx, connection.ClientId)
+	if err != nil {
+		return err
+	}
+
+	// the block delay is derived from the time delay as the connection keeper
+	// does
+	var blockDelay uint64
+	if expectedTimePerBlock := k.connectionKeeper.GetParams(ctx).MaxExpectedTimePerBlock; expectedTimePerBlock > 0 {
+		blockDelay = uint64(math.Ceil(float64(connection.DelayPeriod) / float64(expectedTimePerBlock)))
+	}
+
+	return clientModule.VerifyNonMembership(ctx, connection.ClientId, height, connection.DelayPeriod, blockDelay, proof, merklePath)
+}
+
+// SettleRefunded reports whether the packet is a refunded forward and, if so,
+// forgets it. The timeout of a refunded forward must not reach the transfer
+// application, which would refund the escrowed tokens a second time. A
+// refunded forward is proven unreceivable, so a successful acknowledgement
+// of it is only logged.
+func (k Keeper) SettleRefunded(ctx sdk.Context, packet channeltypes.Packet, ackSuccess bool) (bool, error) {
+	id := collections.Join3(packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	has, err := k.Refunded.Has(ctx, id)
+	if err != nil || !has {
+		return false, err
+	}
+	if err := k.Refunded.Remove(ctx, id); err != nil {
+		return false, err
+	}
+
+	if ackSuccess {
+		k.Logger(ctx).Error("refunded forward was acknowledged by the next hop",
+			"channel_id", packet.SourceChannel, "port_id", packet.SourcePort, "sequence", packet.Sequence)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundSettled,
+		sdk.NewAttribute(types.AttributeKeyChannelID, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeyPortID, packet.SourcePort),
+		sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprint(packet.Sequence)),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprint(ackSuccess)),
+	))
+
+	return true, nil
+}
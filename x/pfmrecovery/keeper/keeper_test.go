@@ -0,0 +1,541 @@
+package keeper_test
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery"
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/types"
+)
+
+const (
+	forwardChannel = "channel-1"
+	refundChannel  = "channel-0"
+	clientID       = "07-tendermint-1"
+	sequence       = uint64(7)
+)
+
+// mockPacketForward implements types.PacketForwardKeeper on the store of
+// the packet forward middleware.
+type mockPacketForward struct {
+	cdc     codec.BinaryCodec
+	store   corestore.KVStoreService
+	acked   []channeltypes.Packet
+	ackData []transfertypes.FungibleTokenPacketData
+	acks    []channeltypes.Acknowledgement
+}
+
+func (m *mockPacketForward) GetAndClearInFlightPacket(ctx sdk.Context, channel, port string, seq uint64) *pfmtypes.InFlightPacket {
+	store := m.store.OpenKVStore(ctx)
+	key := pfmtypes.RefundPacketKey(channel, port, seq)
+	bz, err := store.Get(key)
+	if err != nil || bz == nil {
+		return nil
+	}
+	var p pfmtypes.InFlightPacket
+	m.cdc.MustUnmarshal(bz, &p)
+	if err := store.Delete(key); err != nil {
+		panic(err)
+	}
+	return &p
+}
+
+func (m *mockPacketForward) hasInFlight(ctx sdk.Context, seq uint64) bool {
+	has, err := m.store.OpenKVStore(ctx).Has(pfmtypes.RefundPacketKey(forwardChannel, transfertypes.PortID, seq))
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+func (m *mockPacketForward) WriteAcknowledgementForForwardedPacket(
+	_ sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, _ *pfmtypes.InFlightPacket, ack channeltypes.Acknowledgement,
+) error {
+	m.acked = append(m.acked, packet)
+	m.ackData = append(m.ackData, data)
+	m.acks = append(m.acks, ack)
+	return nil
+}
+
+// mockIBC implements types.ChannelKeeper, types.ConnectionKeeper and
+// types.ClientKeeper for a single forward channel. The client has a
+// consensus state of the next hop at each height of consensus. Like the IBC
+// keepers, it only verifies proofs for an active client.
+type mockIBC struct {
+	state            channeltypes.State
+	clientStatus     ibcexported.Status
+	nextSequenceSend uint64
+	consensus        []time.Time
+}
+
+// proof is the only proof of non-receipt accepted by mockIBC.
+var proof = []byte("unreceived")
+
+// update adds a consensus state of the next hop at t and returns its height.
+func (m *mockIBC) update(t time.Time) clienttypes.Height {
+	m.consensus = append(m.consensus, t)
+	return clienttypes.NewHeight(1, uint64(len(m.consensus)))
+}
+
+func (m *mockIBC) GetChannel(_ sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	if portID != transfertypes.PortID || channelID != forwardChannel {
+		return channeltypes.Channel{}, false
+	}
+	return channeltypes.Channel{
+		State:          m.state,
+		Ordering:       channeltypes.UNORDERED,
+		Counterparty:   channeltypes.NewCounterparty(transfertypes.PortID, "channel-42"),
+		ConnectionHops: []string{"connection-1"},
+	}, true
+}
+
+func (*mockIBC) GetChannelClientState(sdk.Context, string, string) (string, ibcexported.ClientState, error) {
+	return clientID, nil, nil
+}
+
+func (m *mockIBC) GetNextSequenceSend(_ sdk.Context, portID, channelID string) (uint64, bool) {
+	return m.nextSequenceSend, portID == transfertypes.PortID && channelID == forwardChannel
+}
+
+func (*mockIBC) GetPacketCommitment(_ sdk.Context, portID, channelID string, _ uint64) []byte {
+	if portID != transfertypes.PortID || channelID != forwardChannel {
+		return nil
+	}
+	return []byte{1}
+}
+
+func (*mockIBC) GetConnection(_ sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error) {
+	return connectiontypes.ConnectionEnd{
+		ClientId:     clientID,
+		Counterparty: connectiontypes.NewCounterparty(clientID, "connection-42", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+	}, nil
+}
+
+func (*mockIBC) GetParams(sdk.Context) connectiontypes.Params {
+	return connectiontypes.DefaultParams()
+}
+
+func (m *mockIBC) VerifyPacketReceiptAbsence(
+	ctx sdk.Context, connection connectiontypes.ConnectionEnd, height ibcexported.Height, p []byte, portID, channelID string, seq uint64,
+) error {
+	if m.clientStatus != ibcexported.Active {
+		return errors.New("client not active")
+	}
+	path, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix,
+		commitmenttypes.NewMerklePath(host.PacketReceiptKey(portID, channelID, seq)))
+	if err != nil {
+		return err
+	}
+	return mockLightClient{ibc: m}.VerifyNonMembership(ctx, connection.ClientId, height, 0, 0, p, path)
+}
+
+func (m *mockIBC) Route(sdk.Context, string) (ibcexported.LightClientModule, error) {
+	return mockLightClient{ibc: m}, nil
+}
+
+// mockLightClient is the light client module of the mockIBC client, which
+// verifies proofs whatever the client status.
+type mockLightClient struct {
+	ibcexported.LightClientModule
+	ibc *mockIBC
+}
+
+func (m mockLightClient) VerifyNonMembership(
+	_ sdk.Context, _ string, height ibcexported.Height, _, _ uint64, p []byte, path ibcexported.Path,
+) error {
+	if _, err := m.ibc.timestampAt(height); err != nil {
+		return err
+	}
+	expected, err := commitmenttypes.ApplyPrefix(commitmenttypes.NewMerklePrefix([]byte("ibc")),
+		commitmenttypes.NewMerklePath(host.PacketReceiptKey(transfertypes.PortID, "channel-42", sequence)))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(p, proof) || !reflect.DeepEqual(path, expected) {
+		return errors.New("invalid proof")
+	}
+	return nil
+}
+
+func (m *mockIBC) GetClientStatus(sdk.Context, string) ibcexported.Status {
+	return m.clientStatus
+}
+
+func (m *mockIBC) GetClientLatestHeight(sdk.Context, string) clienttypes.Height {
+	return clienttypes.NewHeight(1, uint64(len(m.consensus)))
+}
+
+func (m *mockIBC) GetClientTimestampAtHeight(_ sdk.Context, _ string, height ibcexported.Height) (uint64, error) {
+	return m.timestampAt(height)
+}
+
+func (m *mockIBC) timestampAt(height ibcexported.Height) (uint64, error) {
+	h := height.GetRevisionHeight()
+	if height.GetRevisionNumber() != 1 || h == 0 || h > uint64(len(m.consensus)) {
+		return 0, errors.New("consensus state not found")
+	}
+	return uint64(m.consensus[h-1].UnixNano()), nil
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *mockPacketForward, *mockIBC) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	pfmKey := storetypes.NewKVStoreKey("packetfowardmiddleware")
+	ctx := testutil.DefaultContextWithKeys(map[string]*storetypes.KVStoreKey{types.StoreKey: key, pfmKey.Name(): pfmKey}, nil, nil).
+		WithBlockHeight(10).
+		WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	encCfg := moduletestutil.MakeTestEncodingConfig(pfmrecovery.AppModuleBasic{})
+
+	pfm := &mockPacketForward{cdc: encCfg.Codec, store: runtime.NewKVStoreService(pfmKey)}
+	ibc := &mockIBC{state: channeltypes.OPEN, clientStatus: ibcexported.Active, nextSequenceSend: sequence}
+	ibc.update(ctx.BlockTime())
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), pfm.store, pfm, ibc, ibc, ibc, authtypes.NewModuleAddress("gov").String())
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesis()))
+	return ctx, k, pfm, ibc
+}
+
+// forward writes the in-flight packet the packet forward middleware stores
+// for a forward with the sequence.
+func forward(ctx sdk.Context, pfm *mockPacketForward, seq uint64) {
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", "enoki1receiver", "")
+	inFlightPacket := pfmtypes.InFlightPacket{
+		OriginalSenderAddress: "cosmos1sender",
+		RefundChannelId:       refundChannel,
+		RefundPortId:          transfertypes.PortID,
+		PacketSrcChannelId:    "channel-9",
+		PacketSrcPortId:       transfertypes.PortID,
+		PacketTimeoutHeight:   "0-0",
+		PacketData:            data.GetBytes(),
+		RefundSequence:        3,
+		RetriesRemaining:      1,
+		Timeout:               uint64(time.Hour),
+	}
+	if err := pfm.store.OpenKVStore(ctx).Set(pfmtypes.RefundPacketKey(forwardChannel, transfertypes.PortID, seq), pfm.cdc.MustMarshal(&inFlightPacket)); err != nil {
+		panic(err)
+	}
+}
+
+func TestTrackForward(t *testing.T) {
+	ctx, k, pfm, ibc := setupKeeper(t)
+	id := collections.Join3(forwardChannel, transfertypes.PortID, sequence)
+	forwardedAt := ctx.BlockTime()
+
+	// only the forwards the packet forward middleware holds are recorded
+	require.NoError(t, k.TrackForward(ctx, id))
+	has, err := k.InFlight.Has(ctx, id)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	forward(ctx, pfm, sequence)
+	require.NoError(t, k.TrackForward(ctx, id))
+
+	// the time of the forward is kept in later blocks
+	ctx = ctx.WithBlockTime(forwardedAt.Add(10 * time.Minute))
+	require.NoError(t, k.TrackForward(ctx, id))
+
+	res, err := k.InFlightPackets(ctx, &types.QueryInFlightPacketsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Packets, 1)
+	packet := res.Packets[0]
+	require.Equal(t, forwardChannel, packet.ChannelId)
+	require.Equal(t, sequence, packet.Sequence)
+	require.Equal(t, "cosmos1sender", packet.OriginalSenderAddress)
+	require.Equal(t, "transfer/channel-0/uatom", packet.Denom)
+	require.Equal(t, "1000", packet.Amount)
+	require.Equal(t, forwardedAt, packet.ForwardedAt)
+	require.Equal(t, 10*time.Minute, packet.Age)
+	require.Equal(t, types.FORWARD_STATUS_PENDING, packet.Status)
+
+	single, err := k.InFlightPacket(ctx, &types.QueryInFlightPacketRequest{ChannelId: forwardChannel, PortId: transfertypes.PortID, Sequence: sequence})
+	require.NoError(t, err)
+	require.Equal(t, packet, single.Packet)
+
+	statusOf := func() types.ForwardStatus {
+		res, err := k.InFlightPacket(ctx, &types.QueryInFlightPacketRequest{ChannelId: forwardChannel, PortId: transfertypes.PortID, Sequence: sequence})
+		require.NoError(t, err)
+		return res.Packet.Status
+	}
+
+	ibc.clientStatus = ibcexported.Expired
+	require.Equal(t, types.FORWARD_STATUS_CLIENT_INACTIVE, statusOf())
+
+	ibc.state = channeltypes.CLOSED
+	require.Equal(t, types.FORWARD_STATUS_CHANNEL_CLOSED, statusOf())
+
+	// the forward times out by the time of the next hop, not the block time
+	ibc.state, ibc.clientStatus = channeltypes.OPEN, ibcexported.Active
+	ctx = ctx.WithBlockTime(forwardedAt.Add(time.Hour))
+	require.Equal(t, types.FORWARD_STATUS_PENDING, statusOf())
+	ibc.update(forwardedAt.Add(time.Hour))
+	require.Equal(t, types.FORWARD_STATUS_TIMED_OUT, statusOf())
+
+	// the record stays until the packet forward middleware clears the forward
+	require.NoError(t, k.UntrackForward(ctx, id))
+	has, err = k.InFlight.Has(ctx, id)
+	require.NoError(t, err)
+	require.True(t, has)
+	require.NotNil(t, pfm.GetAndClearInFlightPacket(ctx, forwardChannel, transfertypes.PortID, sequence))
+	require.NoError(t, k.UntrackForward(ctx, id))
+	has, err = k.InFlight.Has(ctx, id)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = k.InFlightPacket(ctx, &types.QueryInFlightPacketRequest{ChannelId: forwardChannel, PortId: transfertypes.PortID, Sequence: sequence})
+	require.Error(t, err)
+}
+
+func TestNextForwardID(t *testing.T) {
+	ctx, k, _, _ := setupKeeper(t)
+	packet := func(memo string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", "enoki1receiver", memo)
+		return channeltypes.Packet{Data: data.GetBytes()}
+	}
+
+	id, ok := k.NextForwardID(ctx, packet(`{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1"}}`))
+	require.True(t, ok)
+	require.Equal(t, collections.Join3(forwardChannel, transfertypes.PortID, sequence), id)
+
+	for _, memo := range []string{
+		"",
+		"not json",
+		`{"wasm":{}}`,
+		`{"forward":{"receiver":"osmo1receiver","port":"transfer"}}`,
+		`{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-8"}}`,
+	} {
+		_, ok := k.NextForwardID(ctx, packet(memo))
+		require.False(t, ok, memo)
+	}
+}
+
+func TestRefundInFlightPacket(t *testing.T) {
+	ctx, k, pfm, ibc := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	forward(ctx, pfm, sequence)
+	require.NoError(t, k.TrackForward(ctx, collections.Join3(forwardChannel, transfertypes.PortID, sequence)))
+	forwardedAt := ctx.BlockTime()
+	beforeTimeout := ibc.update(forwardedAt.Add(time.Hour - time.Second))
+
+	refund := func(seq uint64, p []byte, height clienttypes.Height) error {
+		_, err := srv.RefundInFlightPacket(ctx, types.NewMsgRefundInFlightPacket(k.GetAuthority(), forwardChannel, transfertypes.PortID, seq, p, height))
+		return err
+	}
+
+	_, err := srv.RefundInFlightPacket(ctx, types.NewMsgRefundInFlightPacket(authtypes.NewModuleAddress("other").String(), forwardChannel, transfertypes.PortID, sequence, proof, beforeTimeout))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	require.Error(t, refund(sequence, nil, beforeTimeout))
+	require.Error(t, refund(sequence, proof, clienttypes.ZeroHeight()))
+	require.ErrorIs(t, refund(sequence+1, proof, beforeTimeout), types.ErrInFlightPacketNotFound)
+
+	// a forward is not refunded before its timeout has passed on the next hop,
+	// even if it has by the block time of this chain
+	ctx = ctx.WithBlockTime(forwardedAt.Add(2 * time.Hour))
+	require.ErrorIs(t, refund(sequence, proof, beforeTimeout), types.ErrForwardPending)
+
+	// nor while the client is frozen, or the channel closed, as the packet
+	// can still be received once the client is recovered
+	afterTimeout := ibc.update(forwardedAt.Add(time.Hour))
+	ibc.clientStatus = ibcexported.Frozen
+	require.ErrorIs(t, refund(sequence, proof, afterTimeout), types.ErrForwardPending)
+	ibc.clientStatus, ibc.state = ibcexported.Active, channeltypes.CLOSED
+	require.ErrorIs(t, refund(sequence, proof, beforeTimeout), types.ErrForwardPending)
+
+	// the proof must verify at a height the client has a consensus state for
+	require.ErrorIs(t, refund(sequence, []byte("received"), afterTimeout), types.ErrInvalidProof)
+	require.ErrorIs(t, refund(sequence, proof, clienttypes.NewHeight(1, 99)), types.ErrInvalidProof)
+	require.Empty(t, pfm.acked)
+	require.True(t, pfm.hasInFlight(ctx, sequence))
+
+	// an expired client keeps the consensus states it trusted, so the proof is
+	// verified against them by its light client module
+	ibc.clientStatus = ibcexported.Expired
+	require.ErrorIs(t, refund(sequence, proof, beforeTimeout), types.ErrForwardPending)
+	require.ErrorIs(t, refund(sequence, []byte("received"), afterTimeout), types.ErrInvalidProof)
+	require.NoError(t, refund(sequence, proof, afterTimeout))
+	require.False(t, pfm.hasInFlight(ctx, sequence))
+
+	// an error acknowledgement is written for the forwarded packet
+	require.Len(t, pfm.acked, 1)
+	require.Equal(t, forwardChannel, pfm.acked[0].SourceChannel)
+	require.Equal(t, transfertypes.PortID, pfm.acked[0].SourcePort)
+	require.Equal(t, sequence, pfm.acked[0].Sequence)
+	require.Equal(t, "channel-42", pfm.acked[0].DestinationChannel)
+	require.Equal(t, "transfer/channel-0/uatom", pfm.ackData[0].Denom)
+	require.Equal(t, "1000", pfm.ackData[0].Amount)
+	require.False(t, pfm.acks[0].Success())
+
+	id := collections.Join3(forwardChannel, transfertypes.PortID, sequence)
+	has, err := k.Refunded.Has(ctx, id)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// the late timeout of the refunded forward is absorbed once
+	packet := channeltypes.Packet{Sequence: sequence, SourcePort: transfertypes.PortID, SourceChannel: forwardChannel}
+	settled, err := k.SettleRefunded(ctx, packet, false)
+	require.NoError(t, err)
+	require.True(t, settled)
+
+	settled, err = k.SettleRefunded(ctx, packet, false)
+	require.NoError(t, err)
+	require.False(t, settled)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k, pfm, _ := setupKeeper(t)
+
+	genState := types.GenesisState{
+		InFlight: []types.InFlightRecord{
+			{ChannelId: forwardChannel, PortId: transfertypes.PortID, Sequence: sequence, ForwardedAt: ctx.BlockTime()},
+		},
+		Refunded: []types.RefundedPacket{
+			{ChannelId: forwardChannel, PortId: transfertypes.PortID, Sequence: sequence + 1},
+		},
+	}
+	require.NoError(t, genState.Validate())
+	require.NoError(t, k.InitGenesis(ctx, genState))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genState, *exported)
+
+	// forwards without a record, like those made before the module was
+	// added, are recorded at the block time
+	forward(ctx, pfm, sequence+2)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, k.InitGenesis(ctx, genState))
+	forwardedAt, err := k.InFlight.Get(ctx, collections.Join3(forwardChannel, transfertypes.PortID, sequence+2))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime(), forwardedAt)
+
+	duplicate := genState
+	duplicate.Refunded = append(duplicate.Refunded, duplicate.Refunded[0])
+	require.Error(t, duplicate.Validate())
+}
+
+// mockForwardApp stands for the packet forward middleware under the
+// pfmrecovery middleware. It forwards received packets, clears forwards on
+// acknowledgement and retries them once on timeout.
+type mockForwardApp struct {
+	porttypes.IBCModule
+	pfm  *mockPacketForward
+	ibc  *mockIBC
+	acks int
+}
+
+func (m *mockForwardApp) OnRecvPacket(ctx sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	forward(ctx, m.pfm, m.ibc.nextSequenceSend)
+	m.ibc.nextSequenceSend++
+	return nil
+}
+
+func (m *mockForwardApp) OnAcknowledgementPacket(ctx sdk.Context, _ string, packet channeltypes.Packet, _ []byte, _ sdk.AccAddress) error {
+	m.acks++
+	m.pfm.GetAndClearInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	return nil
+}
+
+func (m *mockForwardApp) OnTimeoutPacket(ctx sdk.Context, _ string, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	m.pfm.GetAndClearInFlightPacket(ctx, packet.SourceChannel, packet.SourcePort, packet.Sequence)
+	m.OnRecvPacket(ctx, "", packet, nil)
+	return nil
+}
+
+func TestIBCMiddlewareTracksForwards(t *testing.T) {
+	ctx, k, pfm, ibc := setupKeeper(t)
+	app := &mockForwardApp{pfm: pfm, ibc: ibc}
+	im := pfmrecovery.NewIBCMiddleware(app, k)
+	forwardedAt := ctx.BlockTime()
+
+	memo := `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1"}}`
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", "enoki1receiver", memo)
+	require.Nil(t, im.OnRecvPacket(ctx, transfertypes.V1, channeltypes.Packet{Data: data.GetBytes(), Sequence: 3}, nil))
+	recorded, err := k.InFlight.Get(ctx, collections.Join3(forwardChannel, transfertypes.PortID, sequence))
+	require.NoError(t, err)
+	require.Equal(t, forwardedAt, recorded)
+
+	// the retry sent on timeout is recorded at the time of the timeout
+	ctx = ctx.WithBlockTime(forwardedAt.Add(time.Hour))
+	forwarded := channeltypes.Packet{Sequence: sequence, SourcePort: transfertypes.PortID, SourceChannel: forwardChannel}
+	require.NoError(t, im.OnTimeoutPacket(ctx, transfertypes.V1, forwarded, nil))
+	has, err := k.InFlight.Has(ctx, collections.Join3(forwardChannel, transfertypes.PortID, sequence))
+	require.NoError(t, err)
+	require.False(t, has)
+	recorded, err = k.InFlight.Get(ctx, collections.Join3(forwardChannel, transfertypes.PortID, sequence+1))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime(), recorded)
+
+	// the record is dropped with the acknowledgement of the retry
+	forwarded.Sequence = sequence + 1
+	ack := channeltypes.NewResultAcknowledgement([]byte{1})
+	require.NoError(t, im.OnAcknowledgementPacket(ctx, transfertypes.V1, forwarded, ack.Acknowledgement(), nil))
+	require.Equal(t, 1, app.acks)
+	has, err = k.InFlight.Has(ctx, collections.Join3(forwardChannel, transfertypes.PortID, sequence+1))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestIBCMiddlewareAcknowledgesUnrefundedForward(t *testing.T) {
+	ctx, k, pfm, ibc := setupKeeper(t)
+	app := &mockForwardApp{pfm: pfm, ibc: ibc}
+	im := pfmrecovery.NewIBCMiddleware(app, k)
+	srv := keeper.NewMsgServerImpl(k)
+	forwardedAt := ctx.BlockTime()
+
+	memo := `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1"}}`
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", "enoki1receiver", memo)
+	require.Nil(t, im.OnRecvPacket(ctx, transfertypes.V1, channeltypes.Packet{Data: data.GetBytes(), Sequence: 3}, nil))
+
+	// the client expires with the forward past its timeout by the block time,
+	// but before the next hop reached it, which may have received it, so it
+	// is not refunded
+	ctx = ctx.WithBlockTime(forwardedAt.Add(2 * time.Hour))
+	ibc.clientStatus = ibcexported.Expired
+	msg := types.NewMsgRefundInFlightPacket(k.GetAuthority(), forwardChannel, transfertypes.PortID, sequence, proof, ibc.update(forwardedAt.Add(time.Minute)))
+	_, err := srv.RefundInFlightPacket(ctx, msg)
+	require.ErrorIs(t, err, types.ErrForwardPending)
+
+	// once the client is recovered, the successful acknowledgement of the
+	// forward reaches the packet forward middleware and nothing is refunded
+	ibc.clientStatus = ibcexported.Active
+	forwarded := channeltypes.Packet{Sequence: sequence, SourcePort: transfertypes.PortID, SourceChannel: forwardChannel}
+	ack := channeltypes.NewResultAcknowledgement([]byte{1})
+	require.NoError(t, im.OnAcknowledgementPacket(ctx, transfertypes.V1, forwarded, ack.Acknowledgement(), nil))
+	require.Equal(t, 1, app.acks)
+	require.Empty(t, pfm.acked)
+	require.False(t, pfm.hasInFlight(ctx, sequence))
+
+	id := collections.Join3(forwardChannel, transfertypes.PortID, sequence)
+	has, err := k.Refunded.Has(ctx, id)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = k.InFlight.Has(ctx, id)
+	require.NoError(t, err)
+	require.False(t, has)
+}
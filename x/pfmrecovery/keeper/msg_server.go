@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/pfmrecovery/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// RefundInFlightPacket refunds a stuck forward to the original sender.
+func (k msgServer) RefundInFlightPacket(goCtx context.Context, msg *types.MsgRefundInFlightPacket) (*types.MsgRefundInFlightPacketResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RefundInFlightPacket(ctx, collections.Join3(msg.ChannelId, msg.PortId, msg.Sequence), msg.ProofUnreceived, msg.ProofHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundInFlightPacketResponse{}, nil
+}
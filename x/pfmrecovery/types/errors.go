@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidAuthority       = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrInFlightPacketNotFound = errorsmod.Register(ModuleName, 3, "in-flight packet not found")
+	ErrForwardPending         = errorsmod.Register(ModuleName, 4, "forward can still complete")
+	ErrInvalidPacketData      = errorsmod.Register(ModuleName, 5, "invalid in-flight packet data")
+	ErrForwardRefunded        = errorsmod.Register(ModuleName, 6, "forward refunded by governance")
+	ErrInvalidProof           = errorsmod.Register(ModuleName, 7, "invalid proof of non-receipt")
+)
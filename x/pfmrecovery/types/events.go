@@ -0,0 +1,16 @@
+package types
+
+// pfmrecovery module event types and attribute keys
+const (
+	EventTypeRefundForward = "pfm_forward_refunded"
+	EventTypeRefundSettled = "pfm_refunded_forward_settled"
+
+	AttributeKeyChannelID       = "channel_id"
+	AttributeKeyPortID          = "port_id"
+	AttributeKeySequence        = "sequence"
+	AttributeKeyOriginalSender  = "original_sender"
+	AttributeKeyRefundChannelID = "refund_channel_id"
+	AttributeKeyRefundSequence  = "refund_sequence"
+	AttributeKeyStatus          = "status"
+	AttributeKeyAckSuccess      = "ack_success"
+)
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+)
+
+// PacketForwardKeeper defines the packet forward middleware methods used by
+// the module.
+type PacketForwardKeeper interface {
+	GetAndClearInFlightPacket(ctx sdk.Context, channel, port string, sequence uint64) *pfmtypes.InFlightPacket
+	WriteAcknowledgementForForwardedPacket(
+		ctx sdk.Context,
+		packet channeltypes.Packet,
+		data transfertypes.FungibleTokenPacketData,
+		inFlightPacket *pfmtypes.InFlightPacket,
+		ack channeltypes.Acknowledgement,
+	) error
+}
+
+// ChannelKeeper defines the channel methods used by the module.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+	GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error)
+	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte
+	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error)
+}
+
+// ConnectionKeeper defines the connection methods used by the module.
+type ConnectionKeeper interface {
+	GetParams(ctx sdk.Context) connectiontypes.Params
+	VerifyPacketReceiptAbsence(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height ibcexported.Height,
+		proof []byte,
+		portID, channelID string,
+		sequence uint64,
+	) error
+}
+
+// ClientKeeper defines the client methods used by the module.
+type ClientKeeper interface {
+	GetClientStatus(ctx sdk.Context, clientID string) ibcexported.Status
+	GetClientLatestHeight(ctx sdk.Context, clientID string) clienttypes.Height
+	GetClientTimestampAtHeight(ctx sdk.Context, clientID string, height ibcexported.Height) (uint64, error)
+	Route(ctx sdk.Context, clientID string) (ibcexported.LightClientModule, error)
+}
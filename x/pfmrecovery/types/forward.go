@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+
+	denommetadatatypes "github.com/hyphacoop/cosmos-enoki/x/denommetadata/types"
+)
+
+// InFlightPacketKey returns the key the packet forward middleware stores the
+// in-flight packet forwarded on channelID/portID with sequence under.
+func InFlightPacketKey(channelID, portID string, sequence uint64) []byte {
+	return pfmtypes.RefundPacketKey(channelID, portID, sequence)
+}
+
+// ParseInFlightPacketKey is the inverse of InFlightPacketKey. IBC
+// identifiers cannot contain a slash, so the key splits unambiguously.
+func ParseInFlightPacketKey(key string) (channelID, portID string, sequence uint64, err error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 {
+		return "", "", 0, fmt.Errorf("invalid in-flight packet key %q", key)
+	}
+	sequence, err = strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid in-flight packet key %q: %w", key, err)
+	}
+	return parts[0], parts[1], sequence, nil
+}
+
+// forwardMemo is the part of a transfer memo the packet forward middleware
+// reads the next hop from.
+type forwardMemo struct {
+	Forward *struct {
+		Port    string `json:"port"`
+		Channel string `json:"channel"`
+	} `json:"forward"`
+}
+
+// ForwardChannel returns the channel the packet forward middleware forwards
+// the transfer packet data on, if its memo asks for a forward.
+func ForwardChannel(packetData []byte) (portID, channelID string, ok bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packetData, &data); err != nil || data.Memo == "" {
+		return "", "", false
+	}
+	var memo forwardMemo
+	if err := json.Unmarshal([]byte(data.Memo), &memo); err != nil || memo.Forward == nil {
+		return "", "", false
+	}
+	if memo.Forward.Port == "" || memo.Forward.Channel == "" {
+		return "", "", false
+	}
+	return memo.Forward.Port, memo.Forward.Channel, true
+}
+
+// ForwardedPacketData returns the transfer packet data the packet forward
+// middleware sent for inFlightPacket. The tokens keep the amount of the
+// original packet, with the denom as it was credited on this chain.
+func ForwardedPacketData(inFlightPacket *pfmtypes.InFlightPacket) (transfertypes.FungibleTokenPacketData, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(inFlightPacket.PacketData, &data); err != nil {
+		return transfertypes.FungibleTokenPacketData{}, errorsmod.Wrap(ErrInvalidPacketData, err.Error())
+	}
+
+	denom := denommetadatatypes.ReceivedDenom(
+		transfertypes.ExtractDenomFromPath(data.Denom),
+		inFlightPacket.PacketSrcPortId, inFlightPacket.PacketSrcChannelId,
+		inFlightPacket.RefundPortId, inFlightPacket.RefundChannelId,
+	)
+
+	return transfertypes.NewFungibleTokenPacketData(denom.Path(), data.Amount, "", "", ""), nil
+}
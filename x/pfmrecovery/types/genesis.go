@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default pfmrecovery genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		InFlight: []InFlightRecord{},
+		Refunded: []RefundedPacket{},
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.InFlight))
+	for _, r := range gs.InFlight {
+		if err := ValidatePacketID(r.ChannelId, r.PortId, r.Sequence); err != nil {
+			return err
+		}
+		key := string(InFlightPacketKey(r.ChannelId, r.PortId, r.Sequence))
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate in-flight record for %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+
+	seen = make(map[string]struct{}, len(gs.Refunded))
+	for _, r := range gs.Refunded {
+		if err := ValidatePacketID(r.ChannelId, r.PortId, r.Sequence); err != nil {
+			return err
+		}
+		key := string(InFlightPacketKey(r.ChannelId, r.PortId, r.Sequence))
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate refunded packet %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// ValidatePacketID checks the identifiers of a forwarded packet.
+func ValidatePacketID(channelID, portID string, sequence uint64) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return err
+	}
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return err
+	}
+	if sequence == 0 {
+		return fmt.Errorf("packet sequence cannot be 0")
+	}
+	return nil
+}
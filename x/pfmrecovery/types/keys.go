@@ -0,0 +1,22 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "pfmrecovery"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// InFlightKey indexes the block time in-flight forwards were sent
+	// by (channel id, port id, sequence).
+	InFlightKey = collections.NewPrefix(0)
+	// RefundedKey holds the refunded forwards awaiting an acknowledgement or
+	// timeout by (channel id, port id, sequence).
+	RefundedKey = collections.NewPrefix(1)
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+)
+
+var _ sdk.Msg = &MsgRefundInFlightPacket{}
+
+// NewMsgRefundInFlightPacket creates a new MsgRefundInFlightPacket instance.
+func NewMsgRefundInFlightPacket(authority, channelID, portID string, sequence uint64, proofUnreceived []byte, proofHeight clienttypes.Height) *MsgRefundInFlightPacket {
+	return &MsgRefundInFlightPacket{
+		Authority:       authority,
+		ChannelId:       channelID,
+		PortId:          portID,
+		Sequence:        sequence,
+		ProofUnreceived: proofUnreceived,
+		ProofHeight:     proofHeight,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgRefundInFlightPacket.
+func (msg *MsgRefundInFlightPacket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	if err := ValidatePacketID(msg.ChannelId, msg.PortId, msg.Sequence); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if len(msg.ProofUnreceived) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty proof of non-receipt")
+	}
+	if msg.ProofHeight.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "proof height cannot be zero")
+	}
+	return nil
+}
@@ -0,0 +1,1579 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: enoki/pfmrecovery/v1/pfmrecovery.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ForwardStatus describes whether an in-flight forward can still complete.
+type ForwardStatus int32
+
+const (
+	// FORWARD_STATUS_UNSPECIFIED is the default value.
+	FORWARD_STATUS_UNSPECIFIED ForwardStatus = 0
+	// FORWARD_STATUS_PENDING means the forward is waiting for an
+	// acknowledgement or timeout from the next hop.
+	FORWARD_STATUS_PENDING ForwardStatus = 1
+	// FORWARD_STATUS_TIMED_OUT means the latest consensus state of the next
+	// hop known to the client is past the forward timeout, but no relayer has
+	// timed the packet out yet.
+	FORWARD_STATUS_TIMED_OUT ForwardStatus = 2
+	// FORWARD_STATUS_CHANNEL_CLOSED means the channel the packet was forwarded
+	// on is no longer open.
+	FORWARD_STATUS_CHANNEL_CLOSED ForwardStatus = 3
+	// FORWARD_STATUS_CLIENT_INACTIVE means the client of the channel the
+	// packet was forwarded on is expired or frozen.
+	FORWARD_STATUS_CLIENT_INACTIVE ForwardStatus = 4
+)
+
+var ForwardStatus_name = map[int32]string{
+	0: "FORWARD_STATUS_UNSPECIFIED",
+	1: "FORWARD_STATUS_PENDING",
+	2: "FORWARD_STATUS_TIMED_OUT",
+	3: "FORWARD_STATUS_CHANNEL_CLOSED",
+	4: "FORWARD_STATUS_CLIENT_INACTIVE",
+}
+
+var ForwardStatus_value = map[string]int32{
+	"FORWARD_STATUS_UNSPECIFIED":     0,
+	"FORWARD_STATUS_PENDING":         1,
+	"FORWARD_STATUS_TIMED_OUT":       2,
+	"FORWARD_STATUS_CHANNEL_CLOSED":  3,
+	"FORWARD_STATUS_CLIENT_INACTIVE": 4,
+}
+
+func (x ForwardStatus) String() string {
+	return proto.EnumName(ForwardStatus_name, int32(x))
+}
+
+func (ForwardStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fa9ee2a607c0290c, []int{0}
+}
+
+// InFlightRecord records when a packet forward was sent.
+type InFlightRecord struct {
+	// channel_id is the channel the packet was forwarded on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// port_id is the port the packet was forwarded on.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// sequence is the sequence of the forwarded packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// forwarded_at is the time of the block the forward was sent in.
+	ForwardedAt time.Time `protobuf:"bytes,4,opt,name=forwarded_at,json=forwardedAt,proto3,stdtime" json:"forwarded_at"`
+}
+
+func (m *InFlightRecord) Reset()         { *m = InFlightRecord{} }
+func (m *InFlightRecord) String() string { return proto.CompactTextString(m) }
+func (*InFlightRecord) ProtoMessage()    {}
+func (*InFlightRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa9ee2a607c0290c, []int{0}
+}
+func (m *InFlightRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightRecord.Merge(m, src)
+}
+func (m *InFlightRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightRecord proto.InternalMessageInfo
+
+func (m *InFlightRecord) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InFlightRecord) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InFlightRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightRecord) GetForwardedAt() time.Time {
+	if m != nil {
+		return m.ForwardedAt
+	}
+	return time.Time{}
+}
+
+// RefundedPacket identifies a forwarded packet refunded by governance whose
+// acknowledgement or timeout has not been processed yet.
+type RefundedPacket struct {
+	// channel_id is the channel the packet was forwarded on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// port_id is the port the packet was forwarded on.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// sequence is the sequence of the forwarded packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *RefundedPacket) Reset()         { *m = RefundedPacket{} }
+func (m *RefundedPacket) String() string { return proto.CompactTextString(m) }
+func (*RefundedPacket) ProtoMessage()    {}
+func (*RefundedPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa9ee2a607c0290c, []int{1}
+}
+func (m *RefundedPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundedPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundedPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefundedPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundedPacket.Merge(m, src)
+}
+func (m *RefundedPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundedPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundedPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundedPacket proto.InternalMessageInfo
+
+func (m *RefundedPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RefundedPacket) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *RefundedPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// InFlightForward describes a packet forwarded by the packet forward
+// middleware that has not been acknowledged or timed out yet.
+type InFlightForward struct {
+	// channel_id is the channel the packet was forwarded on.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// port_id is the port the packet was forwarded on.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// sequence is the sequence of the forwarded packet.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// original_sender_address is the sender on the previous hop.
+	OriginalSenderAddress string `protobuf:"bytes,4,opt,name=original_sender_address,json=originalSenderAddress,proto3" json:"original_sender_address,omitempty"`
+	// refund_channel_id is the channel the original packet was received on.
+	RefundChannelId string `protobuf:"bytes,5,opt,name=refund_channel_id,json=refundChannelId,proto3" json:"refund_channel_id,omitempty"`
+	// refund_port_id is the port the original packet was received on.
+	RefundPortId string `protobuf:"bytes,6,opt,name=refund_port_id,json=refundPortId,proto3" json:"refund_port_id,omitempty"`
+	// refund_sequence is the sequence of the original packet.
+	RefundSequence uint64 `protobuf:"varint,7,opt,name=refund_sequence,json=refundSequence,proto3" json:"refund_sequence,omitempty"`
+	// denom is the full denom path of the forwarded tokens on this chain.
+	Denom string `protobuf:"bytes,8,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount of forwarded tokens.
+	Amount string `protobuf:"bytes,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	// forwarded_at is the time of the block the forward was sent in.
+	ForwardedAt time.Time `protobuf:"bytes,10,opt,name=forwarded_at,json=forwardedAt,proto3,stdtime" json:"forwarded_at"`
+	// age is the time elapsed since forwarded_at.
+	Age time.Duration `protobuf:"bytes,11,opt,name=age,proto3,stdduration" json:"age"`
+	// status tells whether the forward can still complete.
+	Status ForwardStatus `protobuf:"varint,12,opt,name=status,proto3,enum=enoki.pfmrecovery.v1.ForwardStatus" json:"status,omitempty"`
+	// retries_remaining is the number of retries left on timeout.
+	RetriesRemaining int32 `protobuf:"varint,13,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	// nonrefundable is set when the packet forward middleware will not move
+	// escrowed funds on failure.
+	Nonrefundable bool `protobuf:"varint,14,opt,name=nonrefundable,proto3" json:"nonrefundable,omitempty"`
+}
+
+func (m *InFlightForward) Reset()         { *m = InFlightForward{} }
+func (m *InFlightForward) String() string { return proto.CompactTextString(m) }
+func (*InFlightForward) ProtoMessage()    {}
+func (*InFlightForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fa9ee2a607c0290c, []int{2}
+}
+func (m *InFlightForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightForward.Merge(m, src)
+}
+func (m *InFlightForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightForward proto.InternalMessageInfo
+
+func (m *InFlightForward) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *InFlightForward) GetOriginalSenderAddress() string {
+	if m != nil {
+		return m.OriginalSenderAddress
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetRefundChannelId() string {
+	if m != nil {
+		return m.RefundChannelId
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetRefundPortId() string {
+	if m != nil {
+		return m.RefundPortId
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetRefundSequence() uint64 {
+	if m != nil {
+		return m.RefundSequence
+	}
+	return 0
+}
+
+func (m *InFlightForward) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *InFlightForward) GetForwardedAt() time.Time {
+	if m != nil {
+		return m.ForwardedAt
+	}
+	return time.Time{}
+}
+
+func (m *InFlightForward) GetAge() time.Duration {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *InFlightForward) GetStatus() ForwardStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FORWARD_STATUS_UNSPECIFIED
+}
+
+func (m *InFlightForward) GetRetriesRemaining() int32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func (m *InFlightForward) GetNonrefundable() bool {
+	if m != nil {
+		return m.Nonrefundable
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("enoki.pfmrecovery.v1.ForwardStatus", ForwardStatus_name, ForwardStatus_value)
+	proto.RegisterType((*InFlightRecord)(nil), "enoki.pfmrecovery.v1.InFlightRecord")
+	proto.RegisterType((*RefundedPacket)(nil), "enoki.pfmrecovery.v1.RefundedPacket")
+	proto.RegisterType((*InFlightForward)(nil), "enoki.pfmrecovery.v1.InFlightForward")
+}
+
+func init() {
+	proto.RegisterFile("enoki/pfmrecovery/v1/pfmrecovery.proto", fileDescriptor_fa9ee2a607c0290c)
+}
+
+var fileDescriptor_fa9ee2a607c0290c = []byte{
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x41, 0x4f, 0x1a, 0x4d,
+	0x18, 0xc7, 0x59, 0x45, 0x84, 0x51, 0x91, 0x77, 0xe2, 0xab, 0xfb, 0x92, 0xd7, 0x95, 0x52, 0xd3,
+	0x12, 0x9b, 0x2e, 0xd1, 0xc6, 0x5e, 0x7a, 0x42, 0x58, 0xec, 0x26, 0x16, 0xc8, 0x82, 0x6d, 0xd2,
+	0xcb, 0x66, 0xd8, 0x19, 0x96, 0x8d, 0xec, 0x0c, 0x9d, 0x1d, 0x6c, 0xfd, 0x06, 0x3d, 0x7a, 0xec,
+	0xbd, 0xe7, 0x5e, 0xfb, 0x19, 0x3c, 0x7a, 0x6a, 0x7a, 0x6a, 0x1b, 0xfd, 0x22, 0x0d, 0x33, 0x0b,
+	0x51, 0xec, 0xa9, 0x89, 0x37, 0x9e, 0xe7, 0xf7, 0xff, 0x33, 0xff, 0x7d, 0x9e, 0xdd, 0x01, 0x8f,
+	0x08, 0x65, 0x27, 0x41, 0x79, 0xd8, 0x0b, 0x39, 0xf1, 0xd8, 0x29, 0xe1, 0x67, 0xe5, 0xd3, 0xdd,
+	0x9b, 0xa5, 0x39, 0xe4, 0x4c, 0x30, 0xb8, 0x26, 0x75, 0xe6, 0x4d, 0x70, 0xba, 0x9b, 0x5f, 0xf3,
+	0x99, 0xcf, 0xa4, 0xa0, 0x3c, 0xfe, 0xa5, 0xb4, 0x79, 0xc3, 0x67, 0xcc, 0x1f, 0x90, 0xb2, 0xac,
+	0xba, 0xa3, 0x5e, 0x19, 0x8f, 0x38, 0x12, 0x01, 0xa3, 0x31, 0xdf, 0x9a, 0xe5, 0x22, 0x08, 0x49,
+	0x24, 0x50, 0x38, 0x54, 0x82, 0xe2, 0x17, 0x0d, 0x64, 0x6d, 0x5a, 0x1f, 0x04, 0x7e, 0x5f, 0x38,
+	0xc4, 0x63, 0x1c, 0xc3, 0x4d, 0x00, 0xbc, 0x3e, 0xa2, 0x94, 0x0c, 0xdc, 0x00, 0xeb, 0x5a, 0x41,
+	0x2b, 0x65, 0x9c, 0x4c, 0xdc, 0xb1, 0x31, 0xdc, 0x00, 0x8b, 0x43, 0xc6, 0xc5, 0x98, 0xcd, 0x49,
+	0x96, 0x1a, 0x97, 0x36, 0x86, 0x79, 0x90, 0x8e, 0xc8, 0xbb, 0x11, 0xa1, 0x1e, 0xd1, 0xe7, 0x0b,
+	0x5a, 0x29, 0xe9, 0x4c, 0x6b, 0x78, 0x08, 0x96, 0x7b, 0x8c, 0xbf, 0x47, 0x1c, 0x13, 0xec, 0x22,
+	0xa1, 0x27, 0x0b, 0x5a, 0x69, 0x69, 0x2f, 0x6f, 0xaa, 0x78, 0xe6, 0x24, 0x9e, 0xd9, 0x99, 0xc4,
+	0x3b, 0x48, 0x5f, 0xfc, 0xd8, 0x4a, 0x9c, 0xff, 0xdc, 0xd2, 0x9c, 0xa5, 0xa9, 0xb3, 0x22, 0x8a,
+	0x18, 0x64, 0x1d, 0xd2, 0x1b, 0x51, 0x4c, 0x70, 0x0b, 0x79, 0x27, 0x44, 0xdc, 0x47, 0xdc, 0xe2,
+	0xb7, 0x24, 0x58, 0x9d, 0x4c, 0xa5, 0xae, 0x4e, 0xbf, 0x97, 0xb1, 0x3c, 0x07, 0x1b, 0x8c, 0x07,
+	0x7e, 0x40, 0xd1, 0xc0, 0x8d, 0x08, 0xc5, 0x84, 0xbb, 0x08, 0x63, 0x4e, 0xa2, 0x48, 0x4e, 0x28,
+	0xe3, 0xfc, 0x3b, 0xc1, 0x6d, 0x49, 0x2b, 0x0a, 0xc2, 0x1d, 0xf0, 0x0f, 0x97, 0x53, 0x70, 0x6f,
+	0x44, 0x5a, 0x90, 0x8e, 0x55, 0x05, 0xaa, 0xd3, 0x60, 0xdb, 0x20, 0x1b, 0x6b, 0x27, 0xf9, 0x52,
+	0x52, 0xb8, 0xac, 0xba, 0x2d, 0x95, 0xf2, 0x31, 0x88, 0x8d, 0xee, 0x34, 0xec, 0xa2, 0x0c, 0x1b,
+	0x9b, 0xdb, 0x93, 0xc8, 0x6b, 0x60, 0x01, 0x13, 0xca, 0x42, 0x3d, 0x2d, 0xff, 0x45, 0x15, 0x70,
+	0x1d, 0xa4, 0x50, 0xc8, 0x46, 0x54, 0xe8, 0x19, 0xf5, 0xf0, 0xaa, 0xba, 0xb3, 0x77, 0xf0, 0x97,
+	0x7b, 0x87, 0xfb, 0x60, 0x1e, 0xf9, 0x44, 0x5f, 0x92, 0xfe, 0xff, 0xee, 0xf8, 0x6b, 0xf1, 0x6b,
+	0xaf, 0xec, 0x9f, 0xc6, 0xf6, 0xb1, 0x1e, 0xbe, 0x00, 0xa9, 0x48, 0x20, 0x31, 0x8a, 0xf4, 0xe5,
+	0x82, 0x56, 0xca, 0xee, 0x3d, 0x34, 0xff, 0xf4, 0x71, 0x99, 0xf1, 0x8e, 0xdb, 0x52, 0xea, 0xc4,
+	0x16, 0xf8, 0x64, 0x3c, 0x65, 0xc1, 0x03, 0x12, 0xb9, 0x9c, 0x84, 0x28, 0xa0, 0x01, 0xf5, 0xf5,
+	0x95, 0x82, 0x56, 0x5a, 0x70, 0x72, 0x31, 0x70, 0x26, 0x7d, 0xb8, 0x0d, 0x56, 0x28, 0xa3, 0x6a,
+	0x58, 0xa8, 0x3b, 0x20, 0x7a, 0xb6, 0xa0, 0x95, 0xd2, 0xce, 0xed, 0xe6, 0xce, 0x57, 0x0d, 0xac,
+	0xdc, 0x3a, 0x0c, 0x1a, 0x20, 0x5f, 0x6f, 0x3a, 0x6f, 0x2a, 0x4e, 0xcd, 0x6d, 0x77, 0x2a, 0x9d,
+	0xe3, 0xb6, 0x7b, 0xdc, 0x68, 0xb7, 0xac, 0xaa, 0x5d, 0xb7, 0xad, 0x5a, 0x2e, 0x01, 0xf3, 0x60,
+	0x7d, 0x86, 0xb7, 0xac, 0x46, 0xcd, 0x6e, 0x1c, 0xe6, 0x34, 0xf8, 0x3f, 0xd0, 0x67, 0x58, 0xc7,
+	0x7e, 0x65, 0xd5, 0xdc, 0xe6, 0x71, 0x27, 0x37, 0x07, 0x1f, 0x80, 0xcd, 0x19, 0x5a, 0x7d, 0x59,
+	0x69, 0x34, 0xac, 0x23, 0xb7, 0x7a, 0xd4, 0x6c, 0x5b, 0xb5, 0xdc, 0x3c, 0x2c, 0x02, 0x63, 0x56,
+	0x72, 0x64, 0x5b, 0x8d, 0x8e, 0x6b, 0x37, 0x2a, 0xd5, 0x8e, 0xfd, 0xda, 0xca, 0x25, 0xf3, 0xc9,
+	0x8f, 0x9f, 0x8d, 0xc4, 0x41, 0xf3, 0xe2, 0xca, 0xd0, 0x2e, 0xaf, 0x0c, 0xed, 0xd7, 0x95, 0xa1,
+	0x9d, 0x5f, 0x1b, 0x89, 0xcb, 0x6b, 0x23, 0xf1, 0xfd, 0xda, 0x48, 0xbc, 0xdd, 0xf7, 0x03, 0xd1,
+	0x1f, 0x75, 0x4d, 0x8f, 0x85, 0xe5, 0xfe, 0xd9, 0xb0, 0x8f, 0x3c, 0xc6, 0x86, 0x65, 0x8f, 0x45,
+	0x21, 0x8b, 0x9e, 0xaa, 0x2b, 0xef, 0xc3, 0xad, 0x4b, 0x4f, 0x9c, 0x0d, 0x49, 0xd4, 0x4d, 0xc9,
+	0xdd, 0x3d, 0xfb, 0x3d, 0x00, 0x16, 0xd6, 0x70, 0x18, 0x16, 0x05, 0x00, 0x00,
+}
+
+func (m *InFlightRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ForwardedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ForwardedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPfmrecovery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundedPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundedPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundedPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InFlightForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonrefundable {
+		i--
+		if m.Nonrefundable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Status != 0 {
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x60
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Age, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Age):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPfmrecovery(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ForwardedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ForwardedAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPfmrecovery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x52
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RefundSequence != 0 {
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(m.RefundSequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RefundPortId) > 0 {
+		i -= len(m.RefundPortId)
+		copy(dAtA[i:], m.RefundPortId)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.RefundPortId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RefundChannelId) > 0 {
+		i -= len(m.RefundChannelId)
+		copy(dAtA[i:], m.RefundChannelId)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.RefundChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OriginalSenderAddress) > 0 {
+		i -= len(m.OriginalSenderAddress)
+		copy(dAtA[i:], m.OriginalSenderAddress)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.OriginalSenderAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPfmrecovery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPfmrecovery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPfmrecovery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPfmrecovery(uint64(m.Sequence))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ForwardedAt)
+	n += 1 + l + sovPfmrecovery(uint64(l))
+	return n
+}
+
+func (m *RefundedPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPfmrecovery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *InFlightForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovPfmrecovery(uint64(m.Sequence))
+	}
+	l = len(m.OriginalSenderAddress)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	l = len(m.RefundChannelId)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	l = len(m.RefundPortId)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	if m.RefundSequence != 0 {
+		n += 1 + sovPfmrecovery(uint64(m.RefundSequence))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovPfmrecovery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ForwardedAt)
+	n += 1 + l + sovPfmrecovery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Age)
+	n += 1 + l + sovPfmrecovery(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovPfmrecovery(uint64(m.Status))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovPfmrecovery(uint64(m.RetriesRemaining))
+	}
+	if m.Nonrefundable {
+		n += 2
+	}
+	return n
+}
+
+func sovPfmrecovery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPfmrecovery(x uint64) (n int) {
+	return sovPfmrecovery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfmrecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ForwardedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfmrecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundedPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfmrecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundedPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundedPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfmrecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InFlightForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfmrecovery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundPortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSequence", wireType)
+			}
+			m.RefundSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ForwardedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Age, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ForwardStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonrefundable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Nonrefundable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfmrecovery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfmrecovery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPfmrecovery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPfmrecovery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPfmrecovery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPfmrecovery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPfmrecovery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPfmrecovery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPfmrecovery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPfmrecovery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPfmrecovery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,804 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: enoki/pfmrecovery/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRefundInFlightPacket is the Msg/RefundInFlightPacket request type.
+type MsgRefundInFlightPacket struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// channel_id is the channel the packet was forwarded on.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// port_id is the port the packet was forwarded on.
+	PortId string `protobuf:"bytes,3,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// sequence is the sequence of the forwarded packet.
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// proof_unreceived proves that the next hop has no receipt for the
+	// forwarded packet at proof_height.
+	ProofUnreceived []byte `protobuf:"bytes,5,opt,name=proof_unreceived,json=proofUnreceived,proto3" json:"proof_unreceived,omitempty"`
+	// proof_height is a height of the next hop whose consensus state, known to
+	// the client of the channel, is past the timeout of the forwarded packet.
+	ProofHeight types.Height `protobuf:"bytes,6,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+}
+
+func (m *MsgRefundInFlightPacket) Reset()         { *m = MsgRefundInFlightPacket{} }
+func (m *MsgRefundInFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundInFlightPacket) ProtoMessage()    {}
+func (*MsgRefundInFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d82411f39aafe64, []int{0}
+}
+func (m *MsgRefundInFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundInFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundInFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundInFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundInFlightPacket.Merge(m, src)
+}
+func (m *MsgRefundInFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundInFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundInFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundInFlightPacket proto.InternalMessageInfo
+
+func (m *MsgRefundInFlightPacket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRefundInFlightPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgRefundInFlightPacket) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *MsgRefundInFlightPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *MsgRefundInFlightPacket) GetProofUnreceived() []byte {
+	if m != nil {
+		return m.ProofUnreceived
+	}
+	return nil
+}
+
+func (m *MsgRefundInFlightPacket) GetProofHeight() types.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types.Height{}
+}
+
+// MsgRefundInFlightPacketResponse defines the response structure for
+// executing a MsgRefundInFlightPacket message.
+type MsgRefundInFlightPacketResponse struct {
+}
+
+func (m *MsgRefundInFlightPacketResponse) Reset()         { *m = MsgRefundInFlightPacketResponse{} }
+func (m *MsgRefundInFlightPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundInFlightPacketResponse) ProtoMessage()    {}
+func (*MsgRefundInFlightPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8d82411f39aafe64, []int{1}
+}
+func (m *MsgRefundInFlightPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundInFlightPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundInFlightPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundInFlightPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundInFlightPacketResponse.Merge(m, src)
+}
+func (m *MsgRefundInFlightPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundInFlightPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundInFlightPacketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundInFlightPacketResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRefundInFlightPacket)(nil), "enoki.pfmrecovery.v1.MsgRefundInFlightPacket")
+	proto.RegisterType((*MsgRefundInFlightPacketResponse)(nil), "enoki.pfmrecovery.v1.MsgRefundInFlightPacketResponse")
+}
+
+func init() { proto.RegisterFile("enoki/pfmrecovery/v1/tx.proto", fileDescriptor_8d82411f39aafe64) }
+
+var fileDescriptor_8d82411f39aafe64 = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0xf5, 0x1f, 0xe4, 0x5a, 0x09, 0xb0, 0x22, 0xc5, 0x58, 0xaa, 0x13, 0x3a, 0xa5, 0x91,
+	0x72, 0x56, 0x8a, 0xca, 0x80, 0x58, 0xc8, 0x80, 0x9a, 0xa1, 0x02, 0x19, 0xb1, 0xb0, 0x44, 0xce,
+	0xf9, 0xc5, 0x3e, 0x35, 0xbe, 0x33, 0x77, 0x67, 0xab, 0x91, 0x18, 0x10, 0x63, 0x27, 0x3e, 0x06,
+	0x63, 0x06, 0x3e, 0x44, 0xc7, 0x8a, 0xa9, 0x13, 0x42, 0xc9, 0x90, 0xaf, 0x81, 0xec, 0x33, 0x2d,
+	0x88, 0x66, 0x60, 0xb1, 0xee, 0xf7, 0xe7, 0x9e, 0xef, 0xfd, 0xde, 0xc3, 0xfb, 0xc0, 0xc5, 0x19,
+	0xf3, 0xd2, 0x49, 0x22, 0x81, 0x8a, 0x1c, 0xe4, 0xcc, 0xcb, 0xfb, 0x9e, 0x3e, 0x27, 0xa9, 0x14,
+	0x5a, 0x58, 0x8d, 0x52, 0x26, 0x7f, 0xc8, 0x24, 0xef, 0x3b, 0x8f, 0x82, 0x84, 0x71, 0xe1, 0x95,
+	0x5f, 0x63, 0x74, 0x9a, 0x54, 0xa8, 0x44, 0x28, 0x2f, 0x51, 0x51, 0x51, 0x20, 0x51, 0x51, 0x25,
+	0x3c, 0x36, 0xc2, 0xa8, 0x44, 0x9e, 0x01, 0x95, 0xd4, 0x88, 0x44, 0x24, 0x0c, 0x5f, 0x9c, 0x2a,
+	0xb6, 0xc5, 0xc6, 0xd4, 0xa3, 0x42, 0x82, 0x47, 0xa7, 0x0c, 0xb8, 0x2e, 0xca, 0x99, 0x93, 0x31,
+	0x1c, 0x5c, 0x6f, 0xe0, 0xe6, 0xa9, 0x8a, 0x7c, 0x98, 0x64, 0x3c, 0x1c, 0xf2, 0x57, 0x53, 0x16,
+	0xc5, 0xfa, 0x4d, 0x40, 0xcf, 0x40, 0x5b, 0xcf, 0x70, 0x3d, 0xc8, 0x74, 0x2c, 0x24, 0xd3, 0x33,
+	0x1b, 0xb5, 0x51, 0xa7, 0x3e, 0xb0, 0xbf, 0x7f, 0xeb, 0x35, 0xaa, 0xff, 0xbe, 0x0c, 0x43, 0x09,
+	0x4a, 0xbd, 0xd5, 0x92, 0xf1, 0xc8, 0xbf, 0xb5, 0x5a, 0xfb, 0x18, 0xd3, 0x38, 0xe0, 0x1c, 0xa6,
+	0x23, 0x16, 0xda, 0x1b, 0xc5, 0x45, 0xbf, 0x5e, 0x31, 0xc3, 0xd0, 0x6a, 0xe2, 0x7b, 0xa9, 0x90,
+	0xba, 0xd0, 0x36, 0x4b, 0x6d, 0xa7, 0x80, 0xc3, 0xd0, 0x72, 0xf0, 0x7d, 0x05, 0x1f, 0x32, 0xe0,
+	0x14, 0xec, 0xad, 0x36, 0xea, 0x6c, 0xf9, 0x37, 0xd8, 0x3a, 0xc4, 0x0f, 0x53, 0x29, 0xc4, 0x64,
+	0x94, 0x71, 0x09, 0x14, 0x58, 0x0e, 0xa1, 0xbd, 0xdd, 0x46, 0x9d, 0x3d, 0xff, 0x41, 0xc9, 0xbf,
+	0xbb, 0xa1, 0xad, 0x13, 0xbc, 0x67, 0xac, 0x31, 0x14, 0xcd, 0xd8, 0x3b, 0x6d, 0xd4, 0xd9, 0x3d,
+	0x72, 0x08, 0x1b, 0x53, 0x52, 0x44, 0x41, 0xaa, 0x00, 0xf2, 0x3e, 0x39, 0x29, 0x1d, 0x83, 0xfa,
+	0xe5, 0x8f, 0x56, 0xed, 0xeb, 0x6a, 0xde, 0x45, 0xfe, 0x6e, 0x79, 0xd5, 0xf0, 0xcf, 0x5f, 0x7c,
+	0x5e, 0xcd, 0xbb, 0xb7, 0x8d, 0x5d, 0xac, 0xe6, 0xdd, 0xc3, 0x7f, 0x47, 0xbc, 0x26, 0xbe, 0x83,
+	0x27, 0xb8, 0xb5, 0x46, 0xf2, 0x41, 0xa5, 0x82, 0x2b, 0x38, 0xba, 0x40, 0x78, 0xf3, 0x54, 0x45,
+	0xd6, 0x47, 0xdc, 0xb8, 0x73, 0x02, 0x3d, 0x72, 0xd7, 0xca, 0x90, 0x35, 0x65, 0x9d, 0xe3, 0xff,
+	0xb2, 0xff, 0x7e, 0x85, 0xb3, 0xfd, 0xa9, 0x68, 0x7d, 0xf0, 0xfa, 0x72, 0xe1, 0xa2, 0xab, 0x85,
+	0x8b, 0x7e, 0x2e, 0x5c, 0xf4, 0x65, 0xe9, 0xd6, 0xae, 0x96, 0x6e, 0xed, 0x7a, 0xe9, 0xd6, 0xde,
+	0x1f, 0x47, 0x4c, 0xc7, 0xd9, 0x98, 0x50, 0x91, 0x78, 0xf1, 0x2c, 0x8d, 0x03, 0x2a, 0x44, 0x5a,
+	0xad, 0x5f, 0xcf, 0x04, 0x72, 0xfe, 0x57, 0x24, 0x7a, 0x96, 0x82, 0x1a, 0xef, 0x94, 0x2b, 0xf6,
+	0xf4, 0xd7, 0x00, 0xe1, 0xad, 0xb1, 0x25, 0x17, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RefundInFlightPacket refunds a stuck forward to the original sender on
+	// the previous hop by writing an error acknowledgement for the original
+	// packet, once a proof shows the next hop can no longer receive it.
+	RefundInFlightPacket(ctx context.Context, in *MsgRefundInFlightPacket, opts ...grpc.CallOption) (*MsgRefundInFlightPacketResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RefundInFlightPacket(ctx context.Context, in *MsgRefundInFlightPacket, opts ...grpc.CallOption) (*MsgRefundInFlightPacketResponse, error) {
+	out := new(MsgRefundInFlightPacketResponse)
+	err := c.cc.Invoke(ctx, "/enoki.pfmrecovery.v1.Msg/RefundInFlightPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RefundInFlightPacket refunds a stuck forward to the original sender on
+	// the previous hop by writing an error acknowledgement for the original
+	// packet, once a proof shows the next hop can no longer receive it.
+	RefundInFlightPacket(context.Context, *MsgRefundInFlightPacket) (*MsgRefundInFlightPacketResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RefundInFlightPacket(ctx context.Context, req *MsgRefundInFlightPacket) (*MsgRefundInFlightPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundInFlightPacket not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RefundInFlightPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundInFlightPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundInFlightPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enoki.pfmrecovery.v1.Msg/RefundInFlightPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundInFlightPacket(ctx, req.(*MsgRefundInFlightPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "enoki.pfmrecovery.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RefundInFlightPacket",
+			Handler:    _Msg_RefundInFlightPacket_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "enoki/pfmrecovery/v1/tx.proto",
+}
+
+func (m *MsgRefundInFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundInFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundInFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ProofUnreceived) > 0 {
+		i -= len(m.ProofUnreceived)
+		copy(dAtA[i:], m.ProofUnreceived)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofUnreceived)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundInFlightPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundInFlightPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundInFlightPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRefundInFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	l = len(m.ProofUnreceived)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRefundInFlightPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRefundInFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundInFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundInFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofUnreceived", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofUnreceived = append(m.ProofUnreceived[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofUnreceived == nil {
+				m.ProofUnreceived = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundInFlightPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundInFlightPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundInFlightPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,52 @@
+package relayerincentives
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the relayer rewards and epoch limits",
+				},
+				{
+					RpcMethod: "Tallies",
+					Use:       "tallies",
+					Short:     "Query the packets relayed in the current epoch",
+				},
+				{
+					RpcMethod:      "RelayerRewards",
+					Use:            "relayer [relayer]",
+					Short:          "Query the packets relayed by a relayer in the current epoch and the rewards owed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "relayer"}},
+				},
+				{
+					RpcMethod: "RewardPool",
+					Use:       "reward-pool",
+					Short:     "Query the reward pool balance and the next payout height",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params [params]",
+					Short:          "Submit a proposal to replace the relayer rewards",
+					Example:        `update-params '{"epoch_blocks":"14400","fee_share":"0.02","max_packets_per_relayer":"1000","channel_rewards":[{"port_id":"transfer","channel_id":"channel-0","recv_reward":[{"denom":"uenoki","amount":"1000"}],"ack_reward":[{"denom":"uenoki","amount":"500"}],"max_packets_per_epoch":"5000","min_transfer":[{"denom":"uenoki","amount":"1000000"}]}]}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
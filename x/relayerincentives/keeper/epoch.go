@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+)
+
+// FundFromFees moves the fee share of the fees collected in the previous
+// block to the reward pool. It runs before x/distribution allocates the fee
+// collector balance.
+func (k Keeper) FundFromFees(ctx sdk.Context) error {
+	params, err := k.Rewards.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.FeeShare.IsPositive() {
+		return nil
+	}
+
+	fees := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+	share, _ := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(params.FeeShare).TruncateDecimal()
+	if share.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, share); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeShare,
+		sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+	))
+	return nil
+}
+
+// NextPayoutHeight returns the height at the end of which the current epoch
+// is paid out.
+func NextPayoutHeight(height int64, epochBlocks uint64) int64 {
+	epoch := int64(epochBlocks)
+	return (height/epoch + 1) * epoch
+}
+
+// PendingRewards returns the tallies of a relayer in the current epoch and the
+// rewards owed for them in full.
+func (k Keeper) PendingRewards(ctx sdk.Context, params types.Params, relayer sdk.AccAddress) ([]types.RelayerTally, sdk.Coins, error) {
+	tallies := []types.RelayerTally{}
+	rewards := sdk.NewCoins()
+
+	rng := collections.NewPrefixedTripleRange[sdk.AccAddress, string, string](relayer)
+	err := k.Tally.Walk(ctx, rng, func(id TallyID, counts types.PacketCounts) (bool, error) {
+		tallies = append(tallies, newRelayerTally(id, counts))
+		if reward, found := params.ChannelReward(id.K2(), id.K3()); found {
+			rewards = rewards.Add(reward.Reward(counts)...)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return tallies, rewards, nil
+}
+
+// PayRewards pays every relayer for the packets recorded in the epoch ending
+// at this block and starts a new epoch. If the reward pool cannot pay every
+// relayer in full, each denom is paid pro rata.
+func (k Keeper) PayRewards(ctx sdk.Context) error {
+	params, err := k.Rewards.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if ctx.BlockHeight()%int64(params.EpochBlocks) != 0 {
+		return nil
+	}
+
+	type payout struct {
+		relayer sdk.AccAddress
+		counts  types.PacketCounts
+		owed    sdk.Coins
+	}
+	var payouts []payout
+	total := sdk.NewCoins()
+
+	err = k.Tally.Walk(ctx, nil, func(id TallyID, counts types.PacketCounts) (bool, error) {
+		reward, found := params.ChannelReward(id.K2(), id.K3())
+		if !found {
+			return false, nil
+		}
+		owed := reward.Reward(counts)
+		total = total.Add(owed...)
+
+		// tallies are ordered by relayer
+		if n := len(payouts); n > 0 && payouts[n-1].relayer.Equals(id.K1()) {
+			payouts[n-1].owed = payouts[n-1].owed.Add(owed...)
+			payouts[n-1].counts.RecvPackets += counts.RecvPackets
+			payouts[n-1].counts.AckPackets += counts.AckPackets
+			return false, nil
+		}
+		payouts = append(payouts, payout{relayer: id.K1(), counts: counts, owed: owed})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	pool := k.bankKeeper.GetAllBalances(ctx, k.GetRewardPool())
+	for _, p := range payouts {
+		amount := scaleReward(p.owed, total, pool)
+		if amount.IsZero() {
+			continue
+		}
+
+		// a relayer that cannot receive funds, such as a module account, is
+		// skipped and its reward stays in the pool
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, p.relayer, amount); err != nil {
+			k.Logger(ctx).Error("failed to pay relayer reward", "relayer", p.relayer, "amount", amount, "error", err)
+			continue
+		}
+		write()
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRelayerRewarded,
+			sdk.NewAttribute(types.AttributeKeyRelayer, p.relayer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRecvPackets, math.NewIntFromUint64(p.counts.RecvPackets).String()),
+			sdk.NewAttribute(types.AttributeKeyAckPackets, math.NewIntFromUint64(p.counts.AckPackets).String()),
+		))
+	}
+
+	if err := k.Tally.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.ChannelPackets.Clear(ctx, nil); err != nil {
+		return err
+	}
+	return k.RelayerPackets.Clear(ctx, nil)
+}
+
+// scaleReward scales each denom of owed down by pool/total when the pool
+// holds less than the total owed to all relayers.
+func scaleReward(owed, total, pool sdk.Coins) sdk.Coins {
+	amount := sdk.NewCoins()
+	for _, c := range owed {
+		available := pool.AmountOf(c.Denom)
+		needed := total.AmountOf(c.Denom)
+		if available.GTE(needed) {
+			amount = amount.Add(c)
+			continue
+		}
+		amount = amount.Add(sdk.NewCoin(c.Denom, c.Amount.Mul(available).Quo(needed)))
+	}
+	return amount
+}
+
+func newRelayerTally(id TallyID, counts types.PacketCounts) types.RelayerTally {
+	return types.RelayerTally{
+		Relayer:   id.K1().String(),
+		PortId:    id.K2(),
+		ChannelId: id.K3(),
+		Counts:    counts,
+	}
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state. The
+// reward pool account is created so funds sent to it before the first fee
+// share do not create a plain account at its address.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	if err := k.Rewards.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	// collections pairs hold pointers, so the channels and relayers are
+	// counted by their index in the order they are first seen
+	var channels []collections.Pair[string, string]
+	var channelPackets []uint64
+	index := make(map[string]int)
+	var relayers []sdk.AccAddress
+	var relayerPackets []uint64
+	relayerIndex := make(map[string]int)
+	for _, t := range genState.Tallies {
+		relayer, err := sdk.AccAddressFromBech32(t.Relayer)
+		if err != nil {
+			return err
+		}
+		if err := k.Tally.Set(ctx, collections.Join3(relayer, t.PortId, t.ChannelId), t.Counts); err != nil {
+			return err
+		}
+
+		key := t.PortId + "/" + t.ChannelId
+		i, ok := index[key]
+		if !ok {
+			i = len(channels)
+			index[key] = i
+			channels = append(channels, collections.Join(t.PortId, t.ChannelId))
+			channelPackets = append(channelPackets, 0)
+		}
+		channelPackets[i] += t.Counts.Total()
+
+		j, ok := relayerIndex[t.Relayer]
+		if !ok {
+			j = len(relayers)
+			relayerIndex[t.Relayer] = j
+			relayers = append(relayers, relayer)
+			relayerPackets = append(relayerPackets, 0)
+		}
+		relayerPackets[j] += t.Counts.Total()
+	}
+	for i, channel := range channels {
+		if err := k.ChannelPackets.Set(ctx, channel, channelPackets[i]); err != nil {
+			return err
+		}
+	}
+	for j, relayer := range relayers {
+		if err := k.RelayerPackets.Set(ctx, relayer, relayerPackets[j]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.Rewards.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState := &types.GenesisState{
+		Params:  params,
+		Tallies: []types.RelayerTally{},
+	}
+	err = k.Tally.Walk(ctx, nil, func(id TallyID, counts types.PacketCounts) (bool, error) {
+		genState.Tallies = append(genState.Tallies, newRelayerTally(id, counts))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return genState, nil
+}
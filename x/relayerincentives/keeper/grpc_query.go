@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the module parameters.
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := k.Rewards.Get(goCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Tallies returns the packets recorded in the current epoch.
+func (k Keeper) Tallies(goCtx context.Context, req *types.QueryTalliesRequest) (*types.QueryTalliesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tallies, pageRes, err := query.CollectionPaginate(goCtx, k.Tally, req.Pagination,
+		func(id TallyID, counts types.PacketCounts) (types.RelayerTally, error) {
+			return newRelayerTally(id, counts), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTalliesResponse{Tallies: tallies, Pagination: pageRes}, nil
+}
+
+// RelayerRewards returns the packets a relayer delivered in the current epoch
+// and the rewards owed for them.
+func (k Keeper) RelayerRewards(goCtx context.Context, req *types.QueryRelayerRewardsRequest) (*types.QueryRelayerRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	relayer, err := sdk.AccAddressFromBech32(req.Relayer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, err := k.Rewards.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	tallies, rewards, err := k.PendingRewards(ctx, params, relayer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRelayerRewardsResponse{Tallies: tallies, Rewards: rewards}, nil
+}
+
+// RewardPool returns the reward pool balance and the next payout height.
+func (k Keeper) RewardPool(goCtx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, err := k.Rewards.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	pool := k.GetRewardPool()
+
+	return &types.QueryRewardPoolResponse{
+		Address:          pool.String(),
+		Balance:          k.bankKeeper.GetAllBalances(ctx, pool),
+		NextPayoutHeight: NextPayoutHeight(ctx.BlockHeight(), params.EpochBlocks),
+	}, nil
+}
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+)
+
+// TallyID identifies the packets of a relayer on a channel by (relayer,
+// port id, channel id).
+type TallyID = collections.Triple[sdk.AccAddress, string, string]
+
+// Keeper records the packets delivered by relayers and pays them from the
+// reward pool at the end of every epoch.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema         collections.Schema
+	Rewards        collections.Item[types.Params]
+	Tally          collections.Map[TallyID, types.PacketCounts]
+	ChannelPackets collections.Map[collections.Pair[string, string], uint64]
+	RelayerPackets collections.Map[sdk.AccAddress, uint64]
+}
+
+// NewKeeper returns a new relayerincentives keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	authority string,
+) Keeper {
+	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the %s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		authority:     authority,
+
+		Rewards: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Tally: collections.NewMap(sb, types.TalliesKey, "tallies",
+			collections.TripleKeyCodec(sdk.AccAddressKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[types.PacketCounts](cdc)),
+		ChannelPackets: collections.NewMap(sb, types.ChannelPacketsKey, "channel_packets",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value),
+		RelayerPackets: collections.NewMap(sb, types.RelayerPacketsKey, "relayer_packets", sdk.AccAddressKey, collections.Uint64Value),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetRewardPool returns the address of the reward pool.
+func (k Keeper) GetRewardPool() sdk.AccAddress {
+	return k.accountKeeper.GetModuleAddress(types.ModuleName)
+}
+
+// RecordRecvPacket records a packet received on portID/channelID with a
+// successful acknowledgement. transferred are the tokens credited on this
+// chain by an ICS-20 packet.
+func (k Keeper) RecordRecvPacket(ctx sdk.Context, relayer sdk.AccAddress, portID, channelID string, transferred sdk.Coins) {
+	k.record(ctx, relayer, portID, channelID, transferred, func(c *types.PacketCounts) { c.RecvPackets++ })
+}
+
+// RecordAcknowledgement records the acknowledgement of a packet sent on
+// portID/channelID. transferred are the tokens sent from this chain by an
+// ICS-20 packet.
+func (k Keeper) RecordAcknowledgement(ctx sdk.Context, relayer sdk.AccAddress, portID, channelID string, transferred sdk.Coins) {
+	k.record(ctx, relayer, portID, channelID, transferred, func(c *types.PacketCounts) { c.AckPackets++ })
+}
+
+// record increments the packet counts of relayer on a rewarded channel until
+// the relayer or the channel reaches its epoch limit. Failures are logged
+// rather than returned so they never affect packet handling.
+func (k Keeper) record(ctx sdk.Context, relayer sdk.AccAddress, portID, channelID string, transferred sdk.Coins, inc func(*types.PacketCounts)) {
+	if err := k.tryRecord(ctx, relayer, portID, channelID, transferred, inc); err != nil {
+		k.Logger(ctx).Error("failed to record relayed packet", "relayer", relayer, "port", portID, "channel", channelID, "error", err)
+	}
+}
+
+func (k Keeper) tryRecord(ctx sdk.Context, relayer sdk.AccAddress, portID, channelID string, transferred sdk.Coins, inc func(*types.PacketCounts)) error {
+	if relayer.Empty() {
+		return nil
+	}
+	params, err := k.Rewards.Get(ctx)
+	if err != nil {
+		return err
+	}
+	reward, found := params.ChannelReward(portID, channelID)
+	if !found || !reward.RewardsTransfer(transferred) {
+		return nil
+	}
+
+	channel := collections.Join(portID, channelID)
+	channelPackets, err := k.ChannelPackets.Get(ctx, channel)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if channelPackets >= reward.MaxPacketsPerEpoch {
+		return nil
+	}
+
+	relayerPackets, err := k.RelayerPackets.Get(ctx, relayer)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if relayerPackets >= params.MaxPacketsPerRelayer {
+		return nil
+	}
+
+	id := collections.Join3(relayer, portID, channelID)
+	counts, err := k.Tally.Get(ctx, id)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	inc(&counts)
+	if err := k.Tally.Set(ctx, id, counts); err != nil {
+		return err
+	}
+	if err := k.ChannelPackets.Set(ctx, channel, channelPackets+1); err != nil {
+		return err
+	}
+	return k.RelayerPackets.Set(ctx, relayer, relayerPackets+1)
+}
@@ -0,0 +1,287 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives"
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+)
+
+const channel = "channel-0"
+
+var (
+	relayerA = sdk.AccAddress("relayer-a___________")
+	relayerB = sdk.AccAddress("relayer-b___________")
+	blocked  = authtypes.NewModuleAddress("bonded_tokens_pool")
+)
+
+// mockAccounts implements types.AccountKeeper.
+type mockAccounts struct{}
+
+func (mockAccounts) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (mockAccounts) GetModuleAccount(_ context.Context, name string) sdk.ModuleAccountI {
+	return authtypes.NewEmptyModuleAccount(name)
+}
+
+// mockBank implements types.BankKeeper with in-memory balances.
+type mockBank struct {
+	balances map[string]sdk.Coins
+}
+
+func (m *mockBank) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBank) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := m.balances[from.String()].SafeSub(amt...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.balances[from.String()] = balance
+	m.balances[to.String()] = m.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBank) SendCoinsFromModuleToModule(_ context.Context, sender, recipient string, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(sender), authtypes.NewModuleAddress(recipient), amt)
+}
+
+func (m *mockBank) SendCoinsFromModuleToAccount(_ context.Context, sender string, recipient sdk.AccAddress, amt sdk.Coins) error {
+	if recipient.Equals(blocked) {
+		return sdkerrors.ErrUnauthorized
+	}
+	return m.send(authtypes.NewModuleAddress(sender), recipient, amt)
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *mockBank) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).WithBlockHeight(5)
+	encCfg := moduletestutil.MakeTestEncodingConfig(relayerincentives.AppModuleBasic{})
+
+	bank := &mockBank{balances: map[string]sdk.Coins{}}
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), mockAccounts{}, bank, authtypes.NewModuleAddress("gov").String())
+
+	genState := types.DefaultGenesis()
+	genState.Params.EpochBlocks = 10
+	genState.Params.MaxPacketsPerRelayer = 3
+	genState.Params.ChannelRewards = []types.ChannelReward{{
+		PortId:             "transfer",
+		ChannelId:          channel,
+		RecvReward:         sdk.NewCoins(sdk.NewInt64Coin("uenoki", 100)),
+		AckReward:          sdk.NewCoins(sdk.NewInt64Coin("uenoki", 40)),
+		MaxPacketsPerEpoch: 5,
+	}}
+	require.NoError(t, genState.Validate())
+	require.NoError(t, k.InitGenesis(ctx, *genState))
+	return ctx, k, bank
+}
+
+func TestRecordPackets(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+
+	// packets on channels without a reward are ignored
+	k.RecordRecvPacket(ctx, relayerA, "transfer", "channel-1", nil)
+	k.RecordRecvPacket(ctx, relayerA, "icahost", channel, nil)
+
+	// a relayer is rewarded for at most 3 packets
+	for range 4 {
+		k.RecordRecvPacket(ctx, relayerA, "transfer", channel, nil)
+	}
+	k.RecordAcknowledgement(ctx, relayerA, "transfer", channel, nil)
+
+	// and the channel for at most 5 packets across relayers
+	for range 3 {
+		k.RecordAcknowledgement(ctx, relayerB, "transfer", channel, nil)
+	}
+
+	res, err := k.Tallies(ctx, &types.QueryTalliesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RelayerTally{
+		{Relayer: relayerA.String(), PortId: "transfer", ChannelId: channel, Counts: types.PacketCounts{RecvPackets: 3}},
+		{Relayer: relayerB.String(), PortId: "transfer", ChannelId: channel, Counts: types.PacketCounts{AckPackets: 2}},
+	}, res.Tallies)
+
+	channelPackets, err := k.ChannelPackets.Get(ctx, collections.Join("transfer", channel))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), channelPackets)
+
+	rewards, err := k.RelayerRewards(ctx, &types.QueryRelayerRewardsRequest{Relayer: relayerB.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uenoki", 80)), rewards.Rewards)
+}
+
+func TestRecordPacketLimits(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	params, err := k.Rewards.Get(ctx)
+	require.NoError(t, err)
+	params.ChannelRewards = append(params.ChannelRewards, types.ChannelReward{
+		PortId:             "transfer",
+		ChannelId:          "channel-1",
+		RecvReward:         sdk.NewCoins(sdk.NewInt64Coin("uenoki", 100)),
+		MaxPacketsPerEpoch: 5,
+		MinTransfer:        sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000), sdk.NewInt64Coin("uenoki", 1_000)),
+	})
+	require.NoError(t, params.Validate())
+	require.NoError(t, k.Rewards.Set(ctx, params))
+	enoki := sdk.NewCoins(sdk.NewInt64Coin("uenoki", 1_000))
+
+	// packets below the minimum transfer of the channel, of other denoms or
+	// of other applications are not rewarded
+	k.RecordRecvPacket(ctx, relayerB, "transfer", "channel-1", sdk.NewCoins(sdk.NewInt64Coin("uenoki", 999)))
+	k.RecordRecvPacket(ctx, relayerB, "transfer", "channel-1", sdk.NewCoins(sdk.NewInt64Coin("uosmo", 1_000)))
+	k.RecordRecvPacket(ctx, relayerB, "transfer", "channel-1", nil)
+	k.RecordRecvPacket(ctx, relayerB, "transfer", "channel-1", enoki)
+
+	// the relayer limit of 3 packets holds across channels
+	k.RecordRecvPacket(ctx, relayerA, "transfer", channel, nil)
+	k.RecordRecvPacket(ctx, relayerA, "transfer", channel, nil)
+	k.RecordRecvPacket(ctx, relayerA, "transfer", "channel-1", enoki)
+	k.RecordRecvPacket(ctx, relayerA, "transfer", "channel-1", enoki)
+
+	res, err := k.Tallies(ctx, &types.QueryTalliesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RelayerTally{
+		{Relayer: relayerA.String(), PortId: "transfer", ChannelId: channel, Counts: types.PacketCounts{RecvPackets: 2}},
+		{Relayer: relayerA.String(), PortId: "transfer", ChannelId: "channel-1", Counts: types.PacketCounts{RecvPackets: 1}},
+		{Relayer: relayerB.String(), PortId: "transfer", ChannelId: "channel-1", Counts: types.PacketCounts{RecvPackets: 1}},
+	}, res.Tallies)
+
+	relayerPackets, err := k.RelayerPackets.Get(ctx, relayerA)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), relayerPackets)
+}
+
+func TestPayRewards(t *testing.T) {
+	ctx, k, bank := setupKeeper(t)
+	pool := k.GetRewardPool()
+
+	k.RecordRecvPacket(ctx, relayerA, "transfer", channel, nil)
+	k.RecordRecvPacket(ctx, relayerA, "transfer", channel, nil)
+	k.RecordAcknowledgement(ctx, relayerB, "transfer", channel, nil)
+	k.RecordAcknowledgement(ctx, blocked, "transfer", channel, nil)
+
+	// relayers are only paid at the end of the epoch
+	bank.balances[pool.String()] = sdk.NewCoins(sdk.NewInt64Coin("uenoki", 1_000))
+	require.NoError(t, k.PayRewards(ctx))
+	require.Empty(t, bank.balances[relayerA.String()])
+
+	res, err := k.RewardPool(ctx, &types.QueryRewardPoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), res.NextPayoutHeight)
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.PayRewards(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uenoki", 200)), bank.balances[relayerA.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uenoki", 40)), bank.balances[relayerB.String()])
+	// the reward of a relayer that cannot receive funds stays in the pool
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uenoki", 760)), bank.balances[pool.String()])
+
+	// a new epoch starts
+	tallies, err := k.Tallies(ctx, &types.QueryTalliesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, tallies.Tallies)
+	has, err := k.ChannelPackets.Has(ctx, collections.Join("transfer", channel))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// an underfunded pool pays pro rata
+	k.RecordRecvPacket(ctx, relayerA, "transfer", channel, nil)
+	k.RecordRecvPacket(ctx, relayerB, "transfer", channel, nil)
+	k.RecordAcknowledgement(ctx, relayerB, "transfer", channel, nil)
+	bank.balances[pool.String()] = sdk.NewCoins(sdk.NewInt64Coin("uenoki", 120))
+	ctx = ctx.WithBlockHeight(20)
+	require.NoError(t, k.PayRewards(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uenoki", 250)), bank.balances[relayerA.String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uenoki", 110)), bank.balances[relayerB.String()])
+}
+
+func TestFundFromFees(t *testing.T) {
+	ctx, k, bank := setupKeeper(t)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	bank.balances[feeCollector.String()] = sdk.NewCoins(sdk.NewInt64Coin("uenoki", 1_005))
+
+	// no fees are taken by default
+	require.NoError(t, k.FundFromFees(ctx))
+	require.Empty(t, bank.balances[k.GetRewardPool().String()])
+
+	params, err := k.Rewards.Get(ctx)
+	require.NoError(t, err)
+	params.FeeShare = math.LegacyNewDecWithPrec(1, 1)
+	_, err = keeper.NewMsgServerImpl(k).UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.NoError(t, err)
+
+	require.NoError(t, k.FundFromFees(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uenoki", 100)), bank.balances[k.GetRewardPool().String()])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uenoki", 905)), bank.balances[feeCollector.String()])
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	_, err := srv.UpdateParams(ctx, types.NewMsgUpdateParams(authtypes.NewModuleAddress("other").String(), types.DefaultParams()))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	for name, malleate := range map[string]func(*types.Params){
+		"no epoch":        func(p *types.Params) { p.EpochBlocks = 0 },
+		"fee share":       func(p *types.Params) { p.FeeShare = math.LegacyNewDec(2) },
+		"relayer limit":   func(p *types.Params) { p.MaxPacketsPerRelayer = 0 },
+		"channel limit":   func(p *types.Params) { p.ChannelRewards[0].MaxPacketsPerEpoch = 0 },
+		"no reward":       func(p *types.Params) { p.ChannelRewards[0].RecvReward, p.ChannelRewards[0].AckReward = nil, nil },
+		"invalid channel": func(p *types.Params) { p.ChannelRewards[0].ChannelId = "" },
+		"duplicate":       func(p *types.Params) { p.ChannelRewards = append(p.ChannelRewards, p.ChannelRewards[0]) },
+		"min transfer":    func(p *types.Params) { p.ChannelRewards[0].MinTransfer = sdk.Coins{sdk.NewInt64Coin("uenoki", 0)} },
+	} {
+		params, err := k.Rewards.Get(ctx)
+		require.NoError(t, err)
+		malleate(&params)
+		_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), params))
+		require.ErrorIs(t, err, types.ErrInvalidParams, name)
+	}
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+
+	k.RecordRecvPacket(ctx, relayerA, "transfer", channel, nil)
+	k.RecordAcknowledgement(ctx, relayerB, "transfer", channel, nil)
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Tallies, 2)
+
+	// the channel and relayer limits are restored from the tallies
+	ctx2, k2, _ := setupKeeper(t)
+	require.NoError(t, k2.InitGenesis(ctx2, *exported))
+	channelPackets, err := k2.ChannelPackets.Get(ctx2, collections.Join("transfer", channel))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), channelPackets)
+	relayerPackets, err := k2.RelayerPackets.Get(ctx2, relayerA)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), relayerPackets)
+
+	unrewarded := *exported
+	unrewarded.Tallies = append([]types.RelayerTally(nil), exported.Tallies...)
+	unrewarded.Tallies[0].ChannelId = "channel-9"
+	require.ErrorIs(t, unrewarded.Validate(), types.ErrInvalidGenesis)
+}
@@ -0,0 +1,15 @@
+package types
+
+// relayerincentives module event types and attribute keys
+const (
+	EventTypeRelayerRewarded = "relayer_rewarded"
+	EventTypeFeeShare        = "relayer_incentives_fee_share"
+	EventTypeUpdateParams    = "relayer_incentives_updated"
+
+	AttributeKeyRelayer     = "relayer"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyRecvPackets = "recv_packets"
+	AttributeKeyAckPackets  = "ack_packets"
+	AttributeKeyEpochBlocks = "epoch_blocks"
+	AttributeKeyChannels    = "channels"
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default relayerincentives genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:  DefaultParams(),
+		Tallies: []RelayerTally{},
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.Tallies))
+	for _, t := range gs.Tallies {
+		if _, err := sdk.AccAddressFromBech32(t.Relayer); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid relayer address: %s", err)
+		}
+		if _, found := gs.Params.ChannelReward(t.PortId, t.ChannelId); !found {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "tally for %s/%s which is not rewarded", t.PortId, t.ChannelId)
+		}
+		key := fmt.Sprintf("%s/%s/%s", t.Relayer, t.PortId, t.ChannelId)
+		if _, ok := seen[key]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate tally for relayer %s on %s/%s", t.Relayer, t.PortId, t.ChannelId)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "relayerincentives"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey stores the module parameters.
+	ParamsKey = collections.NewPrefix(0)
+	// TalliesKey stores the packets of each relayer and channel in the
+	// current epoch.
+	TalliesKey = collections.NewPrefix(1)
+	// ChannelPacketsKey stores the rewarded packets of each channel in the
+	// current epoch.
+	ChannelPacketsKey = collections.NewPrefix(2)
+	// RelayerPacketsKey stores the rewarded packets of each relayer across
+	// all channels in the current epoch.
+	RelayerPacketsKey = collections.NewPrefix(3)
+)
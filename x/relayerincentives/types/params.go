@@ -0,0 +1,123 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+const (
+	// DefaultEpochBlocks pays relayers about once a day with 6 second blocks.
+	DefaultEpochBlocks uint64 = 14_400
+	// DefaultMaxPacketsPerRelayer bounds the rewards a relayer can farm by
+	// relaying its own packets, across all channels.
+	DefaultMaxPacketsPerRelayer uint64 = 1_000
+)
+
+// DefaultParams returns the default parameters. No channel is rewarded and
+// no fees are taken until governance opts in.
+func DefaultParams() Params {
+	return Params{
+		EpochBlocks:          DefaultEpochBlocks,
+		FeeShare:             math.LegacyZeroDec(),
+		MaxPacketsPerRelayer: DefaultMaxPacketsPerRelayer,
+		ChannelRewards:       []ChannelReward{},
+	}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	if p.EpochBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "epoch blocks must be positive")
+	}
+	if p.FeeShare.IsNil() || p.FeeShare.IsNegative() || p.FeeShare.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidParams, "fee share must be between 0 and 1, got %s", p.FeeShare)
+	}
+	if p.MaxPacketsPerRelayer == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "max packets per relayer must be positive")
+	}
+
+	seen := make(map[string]struct{}, len(p.ChannelRewards))
+	for _, r := range p.ChannelRewards {
+		if err := host.PortIdentifierValidator(r.PortId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "channel reward: %s", err)
+		}
+		if host.ChannelIdentifierValidator(r.ChannelId) != nil && host.ClientIdentifierValidator(r.ChannelId) != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "%q is neither a channel nor a client identifier", r.ChannelId)
+		}
+		key := r.PortId + "/" + r.ChannelId
+		if _, ok := seen[key]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate channel reward for %s", key)
+		}
+		seen[key] = struct{}{}
+
+		if err := validateReward(r.RecvReward); err != nil {
+			return errorsmod.Wrapf(err, "recv reward for %s", key)
+		}
+		if err := validateReward(r.AckReward); err != nil {
+			return errorsmod.Wrapf(err, "ack reward for %s", key)
+		}
+		if r.RecvReward.IsZero() && r.AckReward.IsZero() {
+			return errorsmod.Wrapf(ErrInvalidParams, "channel reward for %s pays nothing", key)
+		}
+		if r.MaxPacketsPerEpoch == 0 {
+			return errorsmod.Wrapf(ErrInvalidParams, "max packets per epoch for %s must be positive", key)
+		}
+		if err := validateReward(r.MinTransfer); err != nil {
+			return errorsmod.Wrapf(err, "min transfer for %s", key)
+		}
+	}
+
+	return nil
+}
+
+// ChannelReward returns the reward of the channel portID/channelID.
+func (p Params) ChannelReward(portID, channelID string) (ChannelReward, bool) {
+	for _, r := range p.ChannelRewards {
+		if r.PortId == portID && r.ChannelId == channelID {
+			return r, true
+		}
+	}
+	return ChannelReward{}, false
+}
+
+// RewardsTransfer reports whether a packet transferring transferred, in the
+// denoms held on this chain, is rewarded on the channel.
+func (r ChannelReward) RewardsTransfer(transferred sdk.Coins) bool {
+	if r.MinTransfer.Empty() {
+		return true
+	}
+	for _, c := range r.MinTransfer {
+		if transferred.AmountOf(c.Denom).GTE(c.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reward returns the reward owed for the packet counts on the channel.
+func (r ChannelReward) Reward(counts PacketCounts) sdk.Coins {
+	reward := sdk.NewCoins()
+	for _, c := range r.RecvReward {
+		reward = reward.Add(sdk.NewCoin(c.Denom, c.Amount.MulRaw(int64(counts.RecvPackets))))
+	}
+	for _, c := range r.AckReward {
+		reward = reward.Add(sdk.NewCoin(c.Denom, c.Amount.MulRaw(int64(counts.AckPackets))))
+	}
+	return reward
+}
+
+// Total returns the number of recorded packets.
+func (c PacketCounts) Total() uint64 {
+	return c.RecvPackets + c.AckPackets
+}
+
+func validateReward(reward sdk.Coins) error {
+	if err := reward.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
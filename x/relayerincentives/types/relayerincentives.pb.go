@@ -0,0 +1,1463 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: enoki/relayerincentives/v1/relayerincentives.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the relayerincentives module parameters.
+type Params struct {
+	// epoch_blocks is the number of blocks between reward payouts.
+	EpochBlocks uint64 `protobuf:"varint,1,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty"`
+	// fee_share is the fraction of the collected transaction fees moved to the
+	// reward pool at the start of every block.
+	FeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=fee_share,json=feeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_share"`
+	// max_packets_per_relayer is the number of packets a single relayer is
+	// rewarded for in an epoch, across all channels.
+	MaxPacketsPerRelayer uint64 `protobuf:"varint,3,opt,name=max_packets_per_relayer,json=maxPacketsPerRelayer,proto3" json:"max_packets_per_relayer,omitempty"`
+	// channel_rewards are the rewarded channels. Packets on other channels are
+	// not recorded.
+	ChannelRewards []ChannelReward `protobuf:"bytes,4,rep,name=channel_rewards,json=channelRewards,proto3" json:"channel_rewards"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e1c8a96226939f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEpochBlocks() uint64 {
+	if m != nil {
+		return m.EpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPacketsPerRelayer() uint64 {
+	if m != nil {
+		return m.MaxPacketsPerRelayer
+	}
+	return 0
+}
+
+func (m *Params) GetChannelRewards() []ChannelReward {
+	if m != nil {
+		return m.ChannelRewards
+	}
+	return nil
+}
+
+// ChannelReward defines the reward paid per packet relayed on a channel.
+type ChannelReward struct {
+	// port_id is the port on this chain.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the channel on this chain. For IBC v2 the client id is used
+	// instead.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// recv_reward is paid for every packet received on the channel with a
+	// successful acknowledgement.
+	RecvReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=recv_reward,json=recvReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recv_reward"`
+	// ack_reward is paid for every acknowledgement of a packet sent on the
+	// channel.
+	AckReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=ack_reward,json=ackReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"ack_reward"`
+	// max_packets_per_epoch is the number of packets rewarded on the channel
+	// in an epoch, across all relayers.
+	MaxPacketsPerEpoch uint64 `protobuf:"varint,5,opt,name=max_packets_per_epoch,json=maxPacketsPerEpoch,proto3" json:"max_packets_per_epoch,omitempty"`
+	// min_transfer, when set, only rewards ICS-20 packets transferring at
+	// least the amount of one of these denoms, as held on this chain, so that
+	// dust transfers cannot use up the channel's packets. Packets of other
+	// applications are then not rewarded on the channel.
+	MinTransfer github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=min_transfer,json=minTransfer,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_transfer"`
+}
+
+func (m *ChannelReward) Reset()         { *m = ChannelReward{} }
+func (m *ChannelReward) String() string { return proto.CompactTextString(m) }
+func (*ChannelReward) ProtoMessage()    {}
+func (*ChannelReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e1c8a96226939f, []int{1}
+}
+func (m *ChannelReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelReward.Merge(m, src)
+}
+func (m *ChannelReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelReward proto.InternalMessageInfo
+
+func (m *ChannelReward) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelReward) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelReward) GetRecvReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecvReward
+	}
+	return nil
+}
+
+func (m *ChannelReward) GetAckReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AckReward
+	}
+	return nil
+}
+
+func (m *ChannelReward) GetMaxPacketsPerEpoch() uint64 {
+	if m != nil {
+		return m.MaxPacketsPerEpoch
+	}
+	return 0
+}
+
+func (m *ChannelReward) GetMinTransfer() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinTransfer
+	}
+	return nil
+}
+
+// PacketCounts are the packets a relayer delivered on a channel in the
+// current epoch.
+type PacketCounts struct {
+	RecvPackets uint64 `protobuf:"varint,1,opt,name=recv_packets,json=recvPackets,proto3" json:"recv_packets,omitempty"`
+	AckPackets  uint64 `protobuf:"varint,2,opt,name=ack_packets,json=ackPackets,proto3" json:"ack_packets,omitempty"`
+}
+
+func (m *PacketCounts) Reset()         { *m = PacketCounts{} }
+func (m *PacketCounts) String() string { return proto.CompactTextString(m) }
+func (*PacketCounts) ProtoMessage()    {}
+func (*PacketCounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e1c8a96226939f, []int{2}
+}
+func (m *PacketCounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketCounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketCounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketCounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketCounts.Merge(m, src)
+}
+func (m *PacketCounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketCounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketCounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketCounts proto.InternalMessageInfo
+
+func (m *PacketCounts) GetRecvPackets() uint64 {
+	if m != nil {
+		return m.RecvPackets
+	}
+	return 0
+}
+
+func (m *PacketCounts) GetAckPackets() uint64 {
+	if m != nil {
+		return m.AckPackets
+	}
+	return 0
+}
+
+// RelayerTally are the rewarded packets of a relayer on a channel in the
+// current epoch.
+type RelayerTally struct {
+	Relayer   string       `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	PortId    string       `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string       `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Counts    PacketCounts `protobuf:"bytes,4,opt,name=counts,proto3" json:"counts"`
+}
+
+func (m *RelayerTally) Reset()         { *m = RelayerTally{} }
+func (m *RelayerTally) String() string { return proto.CompactTextString(m) }
+func (*RelayerTally) ProtoMessage()    {}
+func (*RelayerTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1e1c8a96226939f, []int{3}
+}
+func (m *RelayerTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerTally.Merge(m, src)
+}
+func (m *RelayerTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerTally.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerTally proto.InternalMessageInfo
+
+func (m *RelayerTally) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RelayerTally) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *RelayerTally) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RelayerTally) GetCounts() PacketCounts {
+	if m != nil {
+		return m.Counts
+	}
+	return PacketCounts{}
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "enoki.relayerincentives.v1.Params")
+	proto.RegisterType((*ChannelReward)(nil), "enoki.relayerincentives.v1.ChannelReward")
+	proto.RegisterType((*PacketCounts)(nil), "enoki.relayerincentives.v1.PacketCounts")
+	proto.RegisterType((*RelayerTally)(nil), "enoki.relayerincentives.v1.RelayerTally")
+}
+
+func init() {
+	proto.RegisterFile("enoki/relayerincentives/v1/relayerincentives.proto", fileDescriptor_b1e1c8a96226939f)
+}
+
+var fileDescriptor_b1e1c8a96226939f = []byte{
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x93, 0x90, 0x92, 0x4b, 0x00, 0x61, 0x15, 0xd5, 0x2d, 0xc2, 0x09, 0x91, 0x90, 0x42,
+	0xa5, 0xda, 0x4a, 0x50, 0x19, 0x60, 0xc2, 0x2d, 0x43, 0x05, 0x43, 0xe5, 0x96, 0x05, 0x09, 0x59,
+	0x97, 0xf3, 0x35, 0x3e, 0x39, 0xbe, 0x33, 0x77, 0x6e, 0x68, 0xfe, 0x02, 0x13, 0x3f, 0x03, 0x31,
+	0x75, 0xe8, 0xca, 0x4c, 0xc7, 0xaa, 0x2c, 0x88, 0xa1, 0xa0, 0x66, 0xe8, 0xdf, 0x40, 0xbe, 0x3b,
+	0x4b, 0x69, 0x4b, 0xbb, 0x75, 0x49, 0x72, 0xef, 0x7b, 0x77, 0xef, 0x7b, 0xdf, 0xf7, 0x5e, 0x40,
+	0x1f, 0x53, 0x16, 0x13, 0x97, 0xe3, 0x11, 0x9c, 0x60, 0x4e, 0x28, 0xc2, 0x34, 0x23, 0x63, 0x2c,
+	0xdc, 0x71, 0xef, 0x72, 0xd0, 0x49, 0x39, 0xcb, 0x98, 0xb9, 0x24, 0xef, 0x38, 0x97, 0xe1, 0x71,
+	0x6f, 0xe9, 0x3e, 0x4c, 0x08, 0x65, 0xae, 0xfc, 0x54, 0xe9, 0x4b, 0x36, 0x62, 0x22, 0x61, 0xc2,
+	0x1d, 0x40, 0x81, 0xdd, 0x71, 0x6f, 0x80, 0x33, 0xd8, 0x73, 0x11, 0x23, 0x54, 0xe3, 0x8b, 0x0a,
+	0x0f, 0xe4, 0xc9, 0x55, 0x07, 0x0d, 0xcd, 0x0f, 0xd9, 0x90, 0xa9, 0x78, 0xfe, 0x4b, 0x45, 0x3b,
+	0xdf, 0xcb, 0xa0, 0xb6, 0x09, 0x39, 0x4c, 0x84, 0xf9, 0x18, 0x34, 0x71, 0xca, 0x50, 0x14, 0x0c,
+	0x46, 0x0c, 0xc5, 0xc2, 0x32, 0xda, 0x46, 0xb7, 0xea, 0x37, 0x64, 0xcc, 0x93, 0x21, 0x73, 0x0b,
+	0xd4, 0x77, 0x30, 0x0e, 0x44, 0x04, 0x39, 0xb6, 0xca, 0x6d, 0xa3, 0x5b, 0xf7, 0x9e, 0x1f, 0x9e,
+	0xb4, 0x4a, 0xbf, 0x4f, 0x5a, 0x0f, 0x55, 0x31, 0x11, 0xc6, 0x0e, 0x61, 0x6e, 0x02, 0xb3, 0xc8,
+	0x79, 0x8b, 0x87, 0x10, 0x4d, 0xd6, 0x31, 0x3a, 0x3e, 0x58, 0x01, 0x9a, 0xcb, 0x3a, 0x46, 0x5f,
+	0xcf, 0xf6, 0x97, 0x0d, 0xff, 0xf6, 0x0e, 0xc6, 0x5b, 0xf9, 0x3b, 0xe6, 0x2a, 0x58, 0x48, 0xe0,
+	0x5e, 0x90, 0x42, 0x14, 0xe3, 0x4c, 0x04, 0x29, 0xe6, 0x81, 0x96, 0xc3, 0xaa, 0x48, 0x0a, 0xf3,
+	0x09, 0xdc, 0xdb, 0x54, 0xe8, 0x26, 0xe6, 0xbe, 0xc2, 0xcc, 0x0f, 0xe0, 0x1e, 0x8a, 0x20, 0xa5,
+	0x78, 0x14, 0x70, 0xfc, 0x09, 0xf2, 0x50, 0x58, 0xd5, 0x76, 0xa5, 0xdb, 0xe8, 0x3f, 0x75, 0xae,
+	0xd6, 0xd4, 0x59, 0x53, 0x57, 0x7c, 0x79, 0xc3, 0xab, 0xe7, 0xe4, 0x15, 0x9f, 0xbb, 0x68, 0x16,
+	0x11, 0x2f, 0x9e, 0x7c, 0x3e, 0xdb, 0x5f, 0x6e, 0x2b, 0x47, 0xf7, 0xfe, 0xe3, 0xa9, 0x12, 0xad,
+	0xf3, 0xb3, 0x02, 0xee, 0x9c, 0x7b, 0xd3, 0x5c, 0x00, 0x73, 0x29, 0xe3, 0x59, 0x40, 0x42, 0xa9,
+	0x60, 0xdd, 0xaf, 0xe5, 0xc7, 0x8d, 0xd0, 0x7c, 0x04, 0x40, 0x41, 0x98, 0x84, 0x4a, 0x3d, 0xbf,
+	0xae, 0x23, 0x1b, 0xa1, 0xf9, 0x11, 0x34, 0x38, 0x46, 0x63, 0xdd, 0x8c, 0x55, 0x91, 0xbd, 0x2c,
+	0x3a, 0x5a, 0xb7, 0xdc, 0x70, 0x47, 0x1b, 0xee, 0xac, 0x31, 0x42, 0xbd, 0xd5, 0x9c, 0xfb, 0xb7,
+	0x3f, 0xad, 0xee, 0x90, 0x64, 0xd1, 0xee, 0xc0, 0x41, 0x2c, 0xd1, 0x86, 0xeb, 0xaf, 0x15, 0x11,
+	0xc6, 0x6e, 0x36, 0x49, 0xb1, 0x90, 0x17, 0x84, 0xea, 0x13, 0xe4, 0x45, 0x34, 0x55, 0x06, 0x00,
+	0x44, 0x71, 0x51, 0xb1, 0x7a, 0x43, 0x15, 0xeb, 0x10, 0xc5, 0xba, 0x60, 0x0f, 0x3c, 0xb8, 0x68,
+	0xb5, 0x1c, 0x2f, 0xeb, 0x96, 0x34, 0xda, 0x3c, 0x67, 0xf4, 0xeb, 0x1c, 0x31, 0x05, 0x68, 0x26,
+	0x84, 0x06, 0x19, 0x87, 0x54, 0xec, 0x60, 0x6e, 0xd5, 0x6e, 0x88, 0x65, 0x23, 0x21, 0x74, 0x5b,
+	0x17, 0xe9, 0xf8, 0xa0, 0xa9, 0x78, 0xac, 0xb1, 0x5d, 0x9a, 0xc9, 0xd5, 0x90, 0xde, 0x68, 0xe2,
+	0xc5, 0x6a, 0xe4, 0x31, 0xcd, 0xd7, 0x6c, 0x81, 0x46, 0xae, 0x65, 0x91, 0x51, 0x96, 0x19, 0xb9,
+	0xbc, 0x3a, 0xa1, 0xf3, 0xc3, 0x00, 0x4d, 0x3d, 0xbb, 0xdb, 0x70, 0x34, 0x9a, 0x98, 0x7d, 0x30,
+	0x57, 0xcc, 0xb9, 0x1c, 0x14, 0xcf, 0x3a, 0x3e, 0x58, 0x99, 0xd7, 0x7d, 0xbd, 0x0a, 0x43, 0x8e,
+	0x85, 0xd8, 0xca, 0x38, 0xa1, 0x43, 0xbf, 0x48, 0x9c, 0x1d, 0xae, 0xf2, 0x35, 0xc3, 0x55, 0xb9,
+	0x38, 0x5c, 0x6f, 0x40, 0x0d, 0xc9, 0x56, 0xac, 0x6a, 0xdb, 0xe8, 0x36, 0xfa, 0xdd, 0xeb, 0x76,
+	0x64, 0xb6, 0xf5, 0xd9, 0x15, 0xd1, 0x4f, 0x78, 0xef, 0x0e, 0x4f, 0x6d, 0xe3, 0xe8, 0xd4, 0x36,
+	0xfe, 0x9e, 0xda, 0xc6, 0x97, 0xa9, 0x5d, 0x3a, 0x9a, 0xda, 0xa5, 0x5f, 0x53, 0xbb, 0xf4, 0xfe,
+	0xe5, 0x8c, 0xe6, 0xd1, 0x24, 0x8d, 0x20, 0x62, 0x2c, 0x2d, 0x64, 0xbf, 0x7a, 0x97, 0xa4, 0x19,
+	0x83, 0x9a, 0xfc, 0x47, 0x7a, 0xf6, 0x6f, 0x00, 0x5c, 0xd8, 0x63, 0x35, 0x47, 0x05, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelRewards) > 0 {
+		for iNdEx := len(m.ChannelRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayerincentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxPacketsPerRelayer != 0 {
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(m.MaxPacketsPerRelayer))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.FeeShare.Size()
+		i -= size
+		if _, err := m.FeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochBlocks != 0 {
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinTransfer) > 0 {
+		for iNdEx := len(m.MinTransfer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinTransfer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayerincentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxPacketsPerEpoch != 0 {
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(m.MaxPacketsPerEpoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AckReward) > 0 {
+		for iNdEx := len(m.AckReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayerincentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecvReward) > 0 {
+		for iNdEx := len(m.RecvReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRelayerincentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketCounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketCounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketCounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AckPackets != 0 {
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(m.AckPackets))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RecvPackets != 0 {
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(m.RecvPackets))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Counts.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintRelayerincentives(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRelayerincentives(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRelayerincentives(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochBlocks != 0 {
+		n += 1 + sovRelayerincentives(uint64(m.EpochBlocks))
+	}
+	l = m.FeeShare.Size()
+	n += 1 + l + sovRelayerincentives(uint64(l))
+	if m.MaxPacketsPerRelayer != 0 {
+		n += 1 + sovRelayerincentives(uint64(m.MaxPacketsPerRelayer))
+	}
+	if len(m.ChannelRewards) > 0 {
+		for _, e := range m.ChannelRewards {
+			l = e.Size()
+			n += 1 + l + sovRelayerincentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovRelayerincentives(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRelayerincentives(uint64(l))
+	}
+	if len(m.RecvReward) > 0 {
+		for _, e := range m.RecvReward {
+			l = e.Size()
+			n += 1 + l + sovRelayerincentives(uint64(l))
+		}
+	}
+	if len(m.AckReward) > 0 {
+		for _, e := range m.AckReward {
+			l = e.Size()
+			n += 1 + l + sovRelayerincentives(uint64(l))
+		}
+	}
+	if m.MaxPacketsPerEpoch != 0 {
+		n += 1 + sovRelayerincentives(uint64(m.MaxPacketsPerEpoch))
+	}
+	if len(m.MinTransfer) > 0 {
+		for _, e := range m.MinTransfer {
+			l = e.Size()
+			n += 1 + l + sovRelayerincentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketCounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecvPackets != 0 {
+		n += 1 + sovRelayerincentives(uint64(m.RecvPackets))
+	}
+	if m.AckPackets != 0 {
+		n += 1 + sovRelayerincentives(uint64(m.AckPackets))
+	}
+	return n
+}
+
+func (m *RelayerTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovRelayerincentives(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovRelayerincentives(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRelayerincentives(uint64(l))
+	}
+	l = m.Counts.Size()
+	n += 1 + l + sovRelayerincentives(uint64(l))
+	return n
+}
+
+func sovRelayerincentives(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRelayerincentives(x uint64) (n int) {
+	return sovRelayerincentives(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayerincentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketsPerRelayer", wireType)
+			}
+			m.MaxPacketsPerRelayer = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketsPerRelayer |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelRewards = append(m.ChannelRewards, ChannelReward{})
+			if err := m.ChannelRewards[len(m.ChannelRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayerincentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayerincentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvReward = append(m.RecvReward, types.Coin{})
+			if err := m.RecvReward[len(m.RecvReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckReward = append(m.AckReward, types.Coin{})
+			if err := m.AckReward[len(m.AckReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketsPerEpoch", wireType)
+			}
+			m.MaxPacketsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinTransfer = append(m.MinTransfer, types.Coin{})
+			if err := m.MinTransfer[len(m.MinTransfer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayerincentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketCounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayerincentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketCounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvPackets", wireType)
+			}
+			m.RecvPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecvPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckPackets", wireType)
+			}
+			m.AckPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayerincentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerTally) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRelayerincentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerTally: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerTally: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRelayerincentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRelayerincentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRelayerincentives(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRelayerincentives
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRelayerincentives
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRelayerincentives
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRelayerincentives
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRelayerincentives
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRelayerincentives        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRelayerincentives          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRelayerincentives = fmt.Errorf("proto: unexpected end of group")
+)
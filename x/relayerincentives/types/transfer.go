@@ -0,0 +1,50 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// SentTransfer returns the tokens of an ICS-20 packet sent from this chain.
+// Packets of other applications transfer nothing.
+func SentTransfer(data []byte, version, encoding string) sdk.Coins {
+	packetData, err := transfertypes.UnmarshalPacketData(data, version, encoding)
+	if err != nil {
+		return nil
+	}
+	return transferredCoins(packetData.Token.Denom, packetData.Token.Amount)
+}
+
+// ReceivedTransfer returns the tokens of an ICS-20 packet received on
+// destPort/destChannel from sourcePort/sourceChannel, in the denom credited
+// on this chain. It mirrors the trace handling of the transfer keeper.
+// Packets of other applications transfer nothing.
+func ReceivedTransfer(data []byte, version, encoding, sourcePort, sourceChannel, destPort, destChannel string) sdk.Coins {
+	packetData, err := transfertypes.UnmarshalPacketData(data, version, encoding)
+	if err != nil {
+		return nil
+	}
+
+	denom := packetData.Token.Denom
+	if denom.HasPrefix(sourcePort, sourceChannel) {
+		// the token is returning, remove the hop added by the sender chain
+		denom = transfertypes.NewDenom(denom.Base, denom.Trace[1:]...)
+	} else {
+		trace := make([]transfertypes.Hop, 0, len(denom.Trace)+1)
+		trace = append(trace, transfertypes.NewHop(destPort, destChannel))
+		denom = transfertypes.NewDenom(denom.Base, append(trace, denom.Trace...)...)
+	}
+	return transferredCoins(denom, packetData.Token.Amount)
+}
+
+func transferredCoins(denom transfertypes.Denom, amount string) sdk.Coins {
+	amt, ok := math.NewIntFromString(amount)
+	if !ok || !amt.IsPositive() {
+		return nil
+	}
+	// the coin is not validated so that malformed packets cannot panic
+	return sdk.Coins{{Denom: denom.IBCDenom(), Amount: amt}}
+}
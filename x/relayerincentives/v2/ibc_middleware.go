@@ -0,0 +1,99 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/relayerincentives/types"
+)
+
+var (
+	_ api.IBCModule             = IBCMiddleware{}
+	_ api.PacketDataUnmarshaler = IBCMiddleware{}
+)
+
+// IBCMiddleware records the relayer of the IBC v2 packets handled by the app
+// it wraps. IBC v2 packets are identified by the client on this chain.
+type IBCMiddleware struct {
+	app    api.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping an IBC v2
+// application stack.
+func NewIBCMiddleware(app api.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket implements the IBCModule interface. Only packets received
+// successfully or asynchronously are rewarded.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	res := im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+	if res.Status == channeltypesv2.PacketStatus_Success || res.Status == channeltypesv2.PacketStatus_Async {
+		transferred := types.ReceivedTransfer(payload.Value, payload.Version, payload.Encoding,
+			payload.SourcePort, sourceClient, payload.DestinationPort, destinationClient)
+		im.keeper.RecordRecvPacket(ctx, relayer, payload.DestinationPort, destinationClient, transferred)
+	}
+	return res
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	return im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+	transferred := types.SentTransfer(payload.Value, payload.Version, payload.Encoding)
+	im.keeper.RecordAcknowledgement(ctx, relayer, payload.SourcePort, sourceClient, transferred)
+	return nil
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface by
+// delegating to the wrapped app.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return im.app.(api.PacketDataUnmarshaler).UnmarshalPacketData(payload)
+}
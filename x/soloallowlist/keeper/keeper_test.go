@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/soloallowlist"
+	"github.com/hyphacoop/cosmos-enoki/x/soloallowlist/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/soloallowlist/types"
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, codec.Codec) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(soloallowlist.AppModuleBasic{})
+
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), authtypes.NewModuleAddress("gov").String())
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesis()))
+	return ctx, k, encCfg.Codec
+}
+
+func TestCheckPublicKey(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	allowed := secp256k1.GenPrivKey().PubKey()
+	other := ed25519.GenPrivKey().PubKey()
+
+	// no keys are allowed by default
+	require.ErrorIs(t, k.CheckPublicKey(ctx, allowed), types.ErrPublicKeyNotAllowed)
+
+	params, err := types.NewParams(allowed)
+	require.NoError(t, err)
+	require.NoError(t, k.Allowlist.Set(ctx, params))
+
+	require.NoError(t, k.CheckPublicKey(ctx, allowed))
+	require.ErrorIs(t, k.CheckPublicKey(ctx, other), types.ErrPublicKeyNotAllowed)
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k, cdc := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	authority := authtypes.NewModuleAddress("gov").String()
+	pk := secp256k1.GenPrivKey().PubKey()
+
+	params, err := types.NewParams(pk)
+	require.NoError(t, err)
+
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(authtypes.NewModuleAddress("other").String(), params))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	duplicate, err := types.NewParams(pk, pk)
+	require.NoError(t, err)
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(authority, duplicate))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	notAKey := types.Params{PublicKeys: []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"}}}
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(authority, notAKey))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Params.PublicKeys, 1)
+	require.True(t, res.Params.Allows(pk))
+
+	// the exported genesis round trips through JSON with its public keys
+	genState, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	var imported types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(cdc.MustMarshalJSON(genState), &imported))
+	require.NoError(t, imported.Validate())
+	require.True(t, imported.Params.Allows(pk))
+}
@@ -0,0 +1,66 @@
+package soloallowlist
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
+
+	"github.com/hyphacoop/cosmos-enoki/x/soloallowlist/keeper"
+)
+
+var _ exported.LightClientModule = (*LightClientModule)(nil)
+
+// LightClientModule wraps the 06-solomachine light client module and only
+// accepts clients and key rotations for allowlisted public keys.
+type LightClientModule struct {
+	solomachine.LightClientModule
+
+	cdc    codec.BinaryCodec
+	keeper keeper.Keeper
+}
+
+// NewLightClientModule creates a LightClientModule wrapping lcm.
+func NewLightClientModule(cdc codec.BinaryCodec, lcm solomachine.LightClientModule, k keeper.Keeper) LightClientModule {
+	return LightClientModule{
+		LightClientModule: lcm,
+		cdc:               cdc,
+		keeper:            k,
+	}
+}
+
+// Initialize rejects clients whose public key is not allowlisted before
+// creating the client.
+func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientStateBz, consensusStateBz []byte) error {
+	var consensusState solomachine.ConsensusState
+	if err := l.cdc.Unmarshal(consensusStateBz, &consensusState); err != nil {
+		return err
+	}
+
+	pk, err := consensusState.GetPubKey()
+	if err != nil {
+		return err
+	}
+	if err := l.keeper.CheckPublicKey(ctx, pk); err != nil {
+		return err
+	}
+
+	return l.LightClientModule.Initialize(ctx, clientID, clientStateBz, consensusStateBz)
+}
+
+// VerifyClientMessage rejects headers rotating to a public key that is not
+// allowlisted.
+func (l LightClientModule) VerifyClientMessage(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	if header, ok := clientMsg.(*solomachine.Header); ok {
+		pk, err := header.GetPubKey()
+		if err != nil {
+			return err
+		}
+		if err := l.keeper.CheckPublicKey(ctx, pk); err != nil {
+			return err
+		}
+	}
+
+	return l.LightClientModule.VerifyClientMessage(ctx, clientID, clientMsg)
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidAuthority    = errorsmod.Register(ModuleName, 2, "invalid authority")
+	ErrInvalidParams       = errorsmod.Register(ModuleName, 3, "invalid params")
+	ErrPublicKeyNotAllowed = errorsmod.Register(ModuleName, 4, "solo machine public key is not allowlisted")
+)
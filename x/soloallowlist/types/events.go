@@ -0,0 +1,8 @@
+package types
+
+// soloallowlist module event types and attribute keys
+const (
+	EventTypeUpdateParams = "solo_machine_allowlist_updated"
+
+	AttributeKeyPublicKeys = "public_keys"
+)
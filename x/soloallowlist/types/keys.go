@@ -0,0 +1,16 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "soloallowlist"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+// ParamsKey stores the module parameters.
+var ParamsKey = collections.NewPrefix(0)
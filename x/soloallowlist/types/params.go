@@ -0,0 +1,85 @@
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = Params{}
+
+// NewParams creates new parameters allowing pubKeys.
+func NewParams(pubKeys ...cryptotypes.PubKey) (Params, error) {
+	params := Params{PublicKeys: make([]*codectypes.Any, 0, len(pubKeys))}
+	for _, pk := range pubKeys {
+		anyPk, err := codectypes.NewAnyWithValue(pk)
+		if err != nil {
+			return Params{}, err
+		}
+		params.PublicKeys = append(params.PublicKeys, anyPk)
+	}
+	return params, nil
+}
+
+// DefaultParams returns the default parameters. No solo machine clients can
+// be created until governance allows a public key.
+func DefaultParams() Params {
+	return Params{
+		PublicKeys: []*codectypes.Any{},
+	}
+}
+
+// Validate performs basic validation of the parameters.
+func (p Params) Validate() error {
+	for i, anyPk := range p.PublicKeys {
+		pk, ok := anyPk.GetCachedValue().(cryptotypes.PubKey)
+		if !ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "public key %d is not a public key: %s", i, anyPk.GetTypeUrl())
+		}
+		for _, other := range p.PublicKeys[:i] {
+			if other.TypeUrl == anyPk.TypeUrl && bytes.Equal(other.Value, anyPk.Value) {
+				return errorsmod.Wrapf(ErrInvalidParams, "duplicate public key %s", pk)
+			}
+		}
+	}
+	return nil
+}
+
+// Allows returns whether pk is one of the allowed public keys.
+func (p Params) Allows(pk cryptotypes.PubKey) bool {
+	for _, anyPk := range p.PublicKeys {
+		if allowed, ok := anyPk.GetCachedValue().(cryptotypes.PubKey); ok && allowed.Equals(pk) {
+			return true
+		}
+	}
+	return false
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (p Params) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, anyPk := range p.PublicKeys {
+		var pk cryptotypes.PubKey
+		if err := unpacker.UnpackAny(anyPk, &pk); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return gs.Params.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (msg MsgUpdateParams) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return msg.Params.UnpackInterfaces(unpacker)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces.
+func (res QueryParamsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return res.Params.UnpackInterfaces(unpacker)
+}
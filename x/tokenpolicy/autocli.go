@@ -0,0 +1,108 @@
+package tokenpolicy
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the governance controlled tokenfactory capabilities",
+				},
+				{
+					RpcMethod:      "DenomCapabilities",
+					Use:            "capabilities [denom]",
+					Short:          "Query the tokenfactory capabilities a denom opted in to and those enabled for it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "BeforeSendHook",
+					Use:            "before-send-hook [denom]",
+					Short:          "Query the before send hook contract of a tokenfactory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "BeforeSendHooks",
+					Use:       "before-send-hooks",
+					Short:     "Query the before send hook contracts of all tokenfactory denoms",
+				},
+				{
+					RpcMethod:      "SupplyPolicy",
+					Use:            "supply-policy [denom]",
+					Short:          "Query the supply cap and mint limit of a tokenfactory denom and how much may still be minted",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "SupplyPolicies",
+					Use:       "supply-policies",
+					Short:     "Query the supply caps and mint limits of all tokenfactory denoms",
+				},
+				{
+					RpcMethod:      "IBCPolicy",
+					Use:            "ibc-policy [denom]",
+					Short:          "Query the IBC policy of a tokenfactory denom and its remaining outbound quota",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "IBCPolicies",
+					Use:       "ibc-policies",
+					Short:     "Query the IBC policies of all tokenfactory denoms",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SetIBCPolicy",
+					Use:            "set-ibc-policy [policy]",
+					Short:          "Set the IBC policy of a tokenfactory denom you administer",
+					Example:        `set-ibc-policy '{"denom":"factory/enoki1.../mytoken","allowed_channels":["channel-0"],"outbound_quota":"1000000","quota_period":"86400s"}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy"}},
+				},
+				{
+					RpcMethod:      "RemoveIBCPolicy",
+					Use:            "remove-ibc-policy [denom]",
+					Short:          "Remove the IBC policy of a tokenfactory denom you administer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "SetSupplyPolicy",
+					Use:            "set-supply-policy [policy]",
+					Short:          "Set the supply cap and mint limit of a tokenfactory denom you administer, a cap is fixed once set and a limit can only be tightened",
+					Example:        `set-supply-policy '{"denom":"factory/enoki1.../mytoken","max_supply":"21000000000000","mint_limit":"1000000000","mint_period":"604800s"}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy"}},
+				},
+				{
+					RpcMethod:      "SetDenomCapabilities",
+					Use:            "set-capabilities [denom] [capabilities...]",
+					Short:          "Opt a tokenfactory denom you administer in to capabilities while it has no supply, or drop them",
+					Example:        `set-capabilities factory/enoki1.../usdx enable_force_transfer enable_burn_from`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "capabilities", Varargs: true}},
+				},
+				{
+					RpcMethod:      "SetBeforeSendHook",
+					Use:            "set-before-send-hook [denom] [contract-address]",
+					Short:          "Set the wasm contract called before every transfer of a tokenfactory denom you administer, omit the contract to remove it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "contract_address", Optional: true}},
+				},
+				{
+					RpcMethod:      "UpdateParams",
+					Use:            "update-params [params]",
+					Short:          "Submit a proposal to replace the governance controlled tokenfactory capabilities and before send hook gas limit",
+					Example:        `update-params '{"enabled_capabilities":[],"opt_in_capabilities":["enable_force_transfer","enable_burn_from"],"before_send_hook_gas_limit":"500000"}'`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "params"}},
+					GovProposal:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+// skipBeforeSendHooksKey marks the context of a hook fee payment so it does
+// not call the hook again.
+type skipBeforeSendHooksKey struct{}
+
+// refundEscrowKey holds the escrow account of a failed IBC transfer while its
+// tokens are refunded.
+type refundEscrowKey struct{}
+
+// WithRefundEscrow returns a context in which sends from the escrow account
+// of a failed IBC transfer do not call the before send hooks, so that a hook
+// cannot keep the refunded tokens locked in escrow.
+func WithRefundEscrow(ctx sdk.Context, escrow sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(refundEscrowKey{}, escrow)
+}
+
+var _ banktypes.SendRestrictionFn = Keeper{}.SendRestriction
+
+// SendRestriction is a bank send restriction calling the before send hook
+// contract of every transferred denom that has one. A hook blocks the
+// transfer by returning an error and may charge a fee in the denom, paid by
+// the sender to the contract. Refunds of failed IBC transfers out of their
+// escrow account are not hooked.
+func (k Keeper) SendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if skip, _ := ctx.Value(skipBeforeSendHooksKey{}).(bool); skip {
+		return toAddr, nil
+	}
+	if escrow, ok := ctx.Value(refundEscrowKey{}).(sdk.AccAddress); ok && escrow.Equals(fromAddr) {
+		return toAddr, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, coin := range amt {
+		hook, err := k.Hooks.Get(ctx, coin.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return toAddr, err
+		}
+		if err := k.callBeforeSendHook(sdkCtx, hook, fromAddr, toAddr, coin); err != nil {
+			return toAddr, err
+		}
+	}
+	return toAddr, nil
+}
+
+// callBeforeSendHook calls the hook contract in a cached context with the
+// gas limit from the params and pays the fee it asks for. State changes of
+// the contract are only written when the call succeeds.
+func (k Keeper) callBeforeSendHook(ctx sdk.Context, hook types.BeforeSendHook, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) error {
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return err
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(hook.ContractAddress)
+	if err != nil {
+		return err
+	}
+	bz, err := json.Marshal(types.BeforeSendSudoMsg{
+		BlockBeforeSend: &types.BlockBeforeSend{
+			From:   fromAddr.String(),
+			To:     toAddr.String(),
+			Amount: coin,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasMeter := storetypes.NewGasMeter(params.BeforeSendHookGasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	data, err := func() (data []byte, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+				if !ok {
+					panic(r)
+				}
+				err = fmt.Errorf("out of gas in location: %s", outOfGas.Descriptor)
+			}
+		}()
+		return k.contractKeeper.Sudo(cacheCtx, contractAddr, bz)
+	}()
+
+	ctx.GasMeter().ConsumeGas(min(gasMeter.GasConsumed(), params.BeforeSendHookGasLimit), "tokenfactory before send hook")
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrSendBlocked, "%s: %s", coin.Denom, err)
+	}
+	writeCache()
+
+	if len(data) == 0 {
+		return nil
+	}
+	var res types.BlockBeforeSendResponse
+	if err := json.Unmarshal(data, &res); err != nil {
+		return errorsmod.Wrapf(types.ErrSendBlocked, "%s: invalid hook response: %s", coin.Denom, err)
+	}
+	if res.Fee.IsNil() || res.Fee.IsZero() {
+		return nil
+	}
+	if res.Fee.IsNegative() {
+		return errorsmod.Wrapf(types.ErrSendBlocked, "%s: negative hook fee %s", coin.Denom, res.Fee)
+	}
+
+	fee := sdk.NewCoin(coin.Denom, res.Fee)
+	if err := k.bankKeeper.SendCoins(ctx.WithValue(skipBeforeSendHooksKey{}, true), fromAddr, contractAddr, sdk.NewCoins(fee)); err != nil {
+		return errorsmod.Wrapf(err, "paying before send hook fee of %s", fee)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeHookFee,
+		sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+		sdk.NewAttribute(types.AttributeKeyContract, hook.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+	))
+	return nil
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+// InitGenesis initializes the module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.ParamsStore.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+	for _, p := range genState.IbcPolicies {
+		if err := k.Policies.Set(ctx, p.Denom, p); err != nil {
+			return err
+		}
+	}
+	for _, f := range genState.OutboundFlows {
+		if err := k.Flows.Set(ctx, f.Denom, f); err != nil {
+			return err
+		}
+	}
+	for _, c := range genState.DenomCapabilities {
+		if err := k.Capabilities.Set(ctx, c.Denom, c); err != nil {
+			return err
+		}
+	}
+	for _, h := range genState.BeforeSendHooks {
+		if err := k.Hooks.Set(ctx, h.Denom, h); err != nil {
+			return err
+		}
+	}
+	for _, p := range genState.SupplyPolicies {
+		if err := k.SupplyLimits.Set(ctx, p.Denom, p); err != nil {
+			return err
+		}
+	}
+	for _, f := range genState.MintFlows {
+		if err := k.MintFlows.Set(ctx, f.Denom, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the module's exported genesis state.
+func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error) {
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genState := types.DefaultGenesis()
+	genState.Params = params
+	err = k.Policies.Walk(ctx, nil, func(_ string, p types.IBCPolicy) (bool, error) {
+		genState.IbcPolicies = append(genState.IbcPolicies, p)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Flows.Walk(ctx, nil, func(_ string, f types.OutboundFlow) (bool, error) {
+		genState.OutboundFlows = append(genState.OutboundFlows, f)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Capabilities.Walk(ctx, nil, func(_ string, c types.DenomCapabilities) (bool, error) {
+		genState.DenomCapabilities = append(genState.DenomCapabilities, c)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.Hooks.Walk(ctx, nil, func(_ string, h types.BeforeSendHook) (bool, error) {
+		genState.BeforeSendHooks = append(genState.BeforeSendHooks, h)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.SupplyLimits.Walk(ctx, nil, func(_ string, p types.SupplyPolicy) (bool, error) {
+		genState.SupplyPolicies = append(genState.SupplyPolicies, p)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.MintFlows.Walk(ctx, nil, func(_ string, f types.MintFlow) (bool, error) {
+		genState.MintFlows = append(genState.MintFlows, f)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return genState, nil
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the governance controlled tokenfactory capabilities.
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := k.ParamsStore.Get(goCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// IBCPolicy returns the IBC policy of a denom and its outbound flow in the
+// current quota period.
+func (k Keeper) IBCPolicy(goCtx context.Context, req *types.QueryIBCPolicyRequest) (*types.QueryIBCPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy, err := k.Policies.Get(ctx, req.Denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no IBC policy for %s", req.Denom)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryIBCPolicyResponse{Policy: policy}
+	if policy.HasQuota() {
+		flow, err := k.currentFlow(ctx, policy)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		remaining := policy.OutboundQuota.Sub(flow.Amount)
+		res.Flow = flow
+		res.RemainingQuota = &remaining
+	}
+
+	return res, nil
+}
+
+// IBCPolicies returns the IBC policies of all denoms.
+func (k Keeper) IBCPolicies(goCtx context.Context, req *types.QueryIBCPoliciesRequest) (*types.QueryIBCPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	policies, pageRes, err := query.CollectionPaginate(goCtx, k.Policies, req.Pagination,
+		func(_ string, policy types.IBCPolicy) (types.IBCPolicy, error) {
+			return policy, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIBCPoliciesResponse{Policies: policies, Pagination: pageRes}, nil
+}
+
+// DenomCapabilities returns the tokenfactory capabilities a denom opted in
+// to and those currently enabled for it.
+func (k Keeper) DenomCapabilities(goCtx context.Context, req *types.QueryDenomCapabilitiesRequest) (*types.QueryDenomCapabilitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := k.ParamsStore.Get(goCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	optedIn, err := k.Capabilities.Get(goCtx, req.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryDenomCapabilitiesResponse{OptedIn: optedIn.Capabilities, Enabled: []string{}}
+	for _, c := range types.Capabilities {
+		if params.CapabilityEnabled(c, optedIn.Capabilities) {
+			res.Enabled = append(res.Enabled, c)
+		}
+	}
+
+	return res, nil
+}
+
+// BeforeSendHook returns the before send hook contract of a denom.
+func (k Keeper) BeforeSendHook(goCtx context.Context, req *types.QueryBeforeSendHookRequest) (*types.QueryBeforeSendHookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	hook, err := k.Hooks.Get(goCtx, req.Denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no before send hook for %s", req.Denom)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBeforeSendHookResponse{ContractAddress: hook.ContractAddress}, nil
+}
+
+// BeforeSendHooks returns the before send hook contracts of all denoms.
+func (k Keeper) BeforeSendHooks(goCtx context.Context, req *types.QueryBeforeSendHooksRequest) (*types.QueryBeforeSendHooksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	hooks, pageRes, err := query.CollectionPaginate(goCtx, k.Hooks, req.Pagination,
+		func(_ string, hook types.BeforeSendHook) (types.BeforeSendHook, error) {
+			return hook, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBeforeSendHooksResponse{Hooks: hooks, Pagination: pageRes}, nil
+}
+
+// SupplyPolicy returns the supply policy of a denom and how much may still be
+// minted under it.
+func (k Keeper) SupplyPolicy(goCtx context.Context, req *types.QuerySupplyPolicyRequest) (*types.QuerySupplyPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policy, err := k.SupplyLimits.Get(ctx, req.Denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no supply policy for %s", req.Denom)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QuerySupplyPolicyResponse{Policy: policy}
+	if policy.HasMaxSupply() {
+		remaining := math.MaxInt(policy.MaxSupply.Sub(k.bankKeeper.GetSupply(ctx, req.Denom).Amount), math.ZeroInt())
+		res.RemainingSupply = &remaining
+	}
+	if policy.HasMintLimit() {
+		flow, err := k.currentMintFlow(ctx, policy)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		remaining := policy.MintLimit.Sub(flow.Amount)
+		res.Flow = flow
+		res.RemainingMint = &remaining
+	}
+
+	return res, nil
+}
+
+// SupplyPolicies returns the supply policies of all denoms.
+func (k Keeper) SupplyPolicies(goCtx context.Context, req *types.QuerySupplyPoliciesRequest) (*types.QuerySupplyPoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	policies, pageRes, err := query.CollectionPaginate(goCtx, k.SupplyLimits, req.Pagination,
+		func(_ string, policy types.SupplyPolicy) (types.SupplyPolicy, error) {
+			return policy, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySupplyPoliciesResponse{Policies: policies, Pagination: pageRes}, nil
+}
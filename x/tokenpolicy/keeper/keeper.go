@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+// Keeper stores the IBC and supply policies and before send hooks
+// tokenfactory denom admins set on their denoms and the governance controlled
+// tokenfactory capabilities, and enforces them.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+
+	tokenFactoryKeeper types.TokenFactoryKeeper
+	bankKeeper         types.BankKeeper
+	contractKeeper     types.ContractKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema       collections.Schema
+	Policies     collections.Map[string, types.IBCPolicy]
+	Flows        collections.Map[string, types.OutboundFlow]
+	ParamsStore  collections.Item[types.Params]
+	Capabilities collections.Map[string, types.DenomCapabilities]
+	Hooks        collections.Map[string, types.BeforeSendHook]
+	SupplyLimits collections.Map[string, types.SupplyPolicy]
+	MintFlows    collections.Map[string, types.MintFlow]
+}
+
+// NewKeeper returns a new tokenpolicy keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	tokenFactoryKeeper types.TokenFactoryKeeper,
+	bankKeeper types.BankKeeper,
+	contractKeeper types.ContractKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+
+	k := Keeper{
+		cdc:                cdc,
+		storeService:       storeService,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		bankKeeper:         bankKeeper,
+		contractKeeper:     contractKeeper,
+		authority:          authority,
+
+		Policies: collections.NewMap(sb, types.IBCPoliciesKey, "ibc_policies",
+			collections.StringKey, codec.CollValue[types.IBCPolicy](cdc)),
+		Flows: collections.NewMap(sb, types.OutboundFlowsKey, "outbound_flows",
+			collections.StringKey, codec.CollValue[types.OutboundFlow](cdc)),
+		ParamsStore: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Capabilities: collections.NewMap(sb, types.DenomCapabilitiesKey, "denom_capabilities",
+			collections.StringKey, codec.CollValue[types.DenomCapabilities](cdc)),
+		Hooks: collections.NewMap(sb, types.BeforeSendHooksKey, "before_send_hooks",
+			collections.StringKey, codec.CollValue[types.BeforeSendHook](cdc)),
+		SupplyLimits: collections.NewMap(sb, types.SupplyPoliciesKey, "supply_policies",
+			collections.StringKey, codec.CollValue[types.SupplyPolicy](cdc)),
+		MintFlows: collections.NewMap(sb, types.MintFlowsKey, "mint_flows",
+			collections.StringKey, codec.CollValue[types.MintFlow](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	return sdk.UnwrapSDKContext(ctx).Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// checkAdmin returns an error unless sender is the tokenfactory admin of
+// denom.
+func (k Keeper) checkAdmin(ctx context.Context, sender, denom string) error {
+	metadata, err := k.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if metadata.Admin == "" || metadata.Admin != sender {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", sender, denom)
+	}
+	return nil
+}
+
+// CheckSend returns an error if the IBC policy of denom does not allow
+// sending amount over channelID, the client id for IBC v2. Sends within the
+// quota are added to the outbound flow of the current quota period. Denoms
+// without a policy are always allowed.
+func (k Keeper) CheckSend(ctx sdk.Context, channelID, denom string, amount math.Int) error {
+	policy, err := k.Policies.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := policy.AllowsChannel(channelID); err != nil {
+		return err
+	}
+	if !policy.HasQuota() {
+		return nil
+	}
+
+	flow, err := k.currentFlow(ctx, policy)
+	if err != nil {
+		return err
+	}
+	flow.Amount = flow.Amount.Add(amount)
+	if flow.Amount.GT(policy.OutboundQuota) {
+		return errorsmod.Wrapf(types.ErrQuotaExceeded, "%s: %s remaining", denom, policy.OutboundQuota.Sub(flow.Amount.Sub(amount)))
+	}
+	return k.Flows.Set(ctx, denom, flow)
+}
+
+// RefundSend returns amount to the outbound quota of denom after a send
+// failed with an error acknowledgement or timed out. A refund arriving in a
+// later quota period only lowers that period's flow down to zero.
+func (k Keeper) RefundSend(ctx sdk.Context, denom string, amount math.Int) {
+	if err := k.refundSend(ctx, denom, amount); err != nil {
+		k.Logger(ctx).Error("failed to refund outbound IBC quota", "denom", denom, "amount", amount, "error", err)
+	}
+}
+
+func (k Keeper) refundSend(ctx sdk.Context, denom string, amount math.Int) error {
+	flow, err := k.Flows.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	flow.Amount = math.MaxInt(flow.Amount.Sub(amount), math.ZeroInt())
+	return k.Flows.Set(ctx, denom, flow)
+}
+
+// currentFlow returns the outbound flow of the policy's denom in the quota
+// period containing the block time.
+func (k Keeper) currentFlow(ctx sdk.Context, policy types.IBCPolicy) (types.OutboundFlow, error) {
+	flow, err := k.Flows.Get(ctx, policy.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.OutboundFlow{}, err
+	}
+	return policy.CurrentFlow(flow, ctx.BlockTime()), nil
+}
+
+// CapabilityEnabled reports whether a tokenfactory capability is enabled for
+// denom, either for every denom by governance or through the denom's opt-in.
+func (k Keeper) CapabilityEnabled(ctx context.Context, denom, capability string) (bool, error) {
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	optedIn, err := k.Capabilities.Get(ctx, denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, err
+	}
+	return params.CapabilityEnabled(capability, optedIn.Capabilities), nil
+}
+
+// CheckCapability returns an error unless a tokenfactory capability is
+// enabled for denom.
+func (k Keeper) CheckCapability(ctx context.Context, denom, capability string) error {
+	enabled, err := k.CapabilityEnabled(ctx, denom, capability)
+	if err != nil {
+		return err
+	}
+	if !enabled {
+		return errorsmod.Wrapf(tokenfactorytypes.ErrCapabilityNotEnabled, "%s is not enabled for %s", capability, denom)
+	}
+	return nil
+}
@@ -0,0 +1,510 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy"
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/keeper"
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+var (
+	admin = sdk.AccAddress("admin_______________")
+	other = sdk.AccAddress("other_______________")
+	denom = "factory/" + admin.String() + "/mushroom"
+)
+
+// mockBank implements types.BankKeeper.
+type mockBank struct {
+	supply map[string]math.Int
+	sent   []sdk.Coins
+}
+
+func (m *mockBank) GetSupply(_ context.Context, denom string) sdk.Coin {
+	if amount, ok := m.supply[denom]; ok {
+		return sdk.NewCoin(denom, amount)
+	}
+	return sdk.NewCoin(denom, math.ZeroInt())
+}
+
+func (m *mockBank) SendCoins(_ context.Context, _, _ sdk.AccAddress, amt sdk.Coins) error {
+	m.sent = append(m.sent, amt)
+	return nil
+}
+
+// mockContracts implements types.ContractKeeper.
+type mockContracts struct {
+	contracts map[string]bool
+	sudo      func(ctx sdk.Context, msg types.BeforeSendSudoMsg) ([]byte, error)
+}
+
+func (m *mockContracts) HasContractInfo(_ context.Context, contractAddress sdk.AccAddress) bool {
+	return m.contracts[contractAddress.String()]
+}
+
+func (m *mockContracts) Sudo(ctx context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	var sudoMsg types.BeforeSendSudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	return m.sudo(sdk.UnwrapSDKContext(ctx), sudoMsg)
+}
+
+// mockTokenFactory implements types.TokenFactoryKeeper.
+type mockTokenFactory struct {
+	admins map[string]string
+}
+
+func (m mockTokenFactory) GetAuthorityMetadata(_ context.Context, denom string) (tokenfactorytypes.DenomAuthorityMetadata, error) {
+	return tokenfactorytypes.DenomAuthorityMetadata{Admin: m.admins[denom]}, nil
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	ctx, k, _, _ := setupKeeperWithMocks(t)
+	return ctx, k
+}
+
+func setupKeeperWithMocks(t *testing.T) (sdk.Context, keeper.Keeper, *mockBank, *mockContracts) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).
+		WithBlockTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	encCfg := moduletestutil.MakeTestEncodingConfig(tokenpolicy.AppModuleBasic{})
+
+	bank := &mockBank{supply: map[string]math.Int{}}
+	contracts := &mockContracts{contracts: map[string]bool{}}
+	tokenFactory := mockTokenFactory{admins: map[string]string{denom: admin.String()}}
+	k := keeper.NewKeeper(encCfg.Codec, runtime.NewKVStoreService(key), tokenFactory, bank, contracts, authtypes.NewModuleAddress("gov").String())
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesis()))
+	return ctx, k, bank, contracts
+}
+
+func TestSetIBCPolicy(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	policy := types.IBCPolicy{Denom: denom, AllowedChannels: []string{"channel-0"}, OutboundQuota: math.ZeroInt()}
+
+	// only the denom admin sets the policy
+	_, err := srv.SetIBCPolicy(ctx, types.NewMsgSetIBCPolicy(other.String(), policy))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.SetIBCPolicy(ctx, types.NewMsgSetIBCPolicy(admin.String(), policy))
+	require.NoError(t, err)
+
+	res, err := k.IBCPolicy(ctx, &types.QueryIBCPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, policy, res.Policy)
+	require.Nil(t, res.RemainingQuota)
+
+	for name, malleate := range map[string]func(*types.IBCPolicy){
+		"not a factory denom": func(p *types.IBCPolicy) { p.Denom = "uenoki" },
+		"invalid channel":     func(p *types.IBCPolicy) { p.AllowedChannels = []string{"c"} },
+		"duplicate channel":   func(p *types.IBCPolicy) { p.AllowedChannels = []string{"channel-0", "channel-0"} },
+		"negative quota":      func(p *types.IBCPolicy) { p.OutboundQuota = math.NewInt(-1) },
+		"no quota period":     func(p *types.IBCPolicy) { p.OutboundQuota = math.NewInt(1) },
+	} {
+		invalid := policy
+		malleate(&invalid)
+		_, err := srv.SetIBCPolicy(ctx, types.NewMsgSetIBCPolicy(admin.String(), invalid))
+		require.ErrorIs(t, err, types.ErrInvalidPolicy, name)
+	}
+
+	_, err = srv.RemoveIBCPolicy(ctx, types.NewMsgRemoveIBCPolicy(other.String(), denom))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.RemoveIBCPolicy(ctx, types.NewMsgRemoveIBCPolicy(admin.String(), denom))
+	require.NoError(t, err)
+	_, err = srv.RemoveIBCPolicy(ctx, types.NewMsgRemoveIBCPolicy(admin.String(), denom))
+	require.ErrorIs(t, err, types.ErrPolicyNotFound)
+}
+
+func TestCheckSend(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	// denoms without a policy are always sent
+	require.NoError(t, k.CheckSend(ctx, "channel-0", denom, math.NewInt(1_000_000)))
+
+	policy := types.IBCPolicy{Denom: denom, AllowedChannels: []string{"channel-0", "07-tendermint-1"}, OutboundQuota: math.NewInt(100), QuotaPeriod: time.Hour}
+	_, err := srv.SetIBCPolicy(ctx, types.NewMsgSetIBCPolicy(admin.String(), policy))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, k.CheckSend(ctx, "channel-1", denom, math.NewInt(1)), types.ErrChannelNotAllowed)
+	require.NoError(t, k.CheckSend(ctx, "channel-0", denom, math.NewInt(60)))
+	require.NoError(t, k.CheckSend(ctx, "07-tendermint-1", denom, math.NewInt(40)))
+	require.ErrorIs(t, k.CheckSend(ctx, "channel-0", denom, math.NewInt(1)), types.ErrQuotaExceeded)
+
+	// failed sends are returned to the quota
+	k.RefundSend(ctx, denom, math.NewInt(30))
+	res, err := k.IBCPolicy(ctx, &types.QueryIBCPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(70), res.Flow.Amount)
+	require.Equal(t, math.NewInt(30), *res.RemainingQuota)
+
+	// the quota resets once the period has passed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err = k.IBCPolicy(ctx, &types.QueryIBCPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), *res.RemainingQuota)
+	require.NoError(t, k.CheckSend(ctx, "channel-0", denom, math.NewInt(100)))
+
+	// refunds never take the flow below zero
+	k.RefundSend(ctx, denom, math.NewInt(1_000))
+	flow, err := k.Flows.Get(ctx, denom)
+	require.NoError(t, err)
+	require.True(t, flow.Amount.IsZero())
+
+	// a disabled denom is not sent on any channel
+	policy.Disabled = true
+	_, err = srv.SetIBCPolicy(ctx, types.NewMsgSetIBCPolicy(admin.String(), policy))
+	require.NoError(t, err)
+	require.ErrorIs(t, k.CheckSend(ctx, "channel-0", denom, math.NewInt(1)), types.ErrTransferDisabled)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+
+	policy := types.IBCPolicy{Denom: denom, OutboundQuota: math.NewInt(100), QuotaPeriod: time.Hour}
+	_, err := srv.SetIBCPolicy(ctx, types.NewMsgSetIBCPolicy(admin.String(), policy))
+	require.NoError(t, err)
+	require.NoError(t, k.CheckSend(ctx, "channel-0", denom, math.NewInt(10)))
+	_, err = srv.SetDenomCapabilities(ctx, types.NewMsgSetDenomCapabilities(admin.String(), denom, []string{tokenfactorytypes.EnableBurnFrom}))
+	require.NoError(t, err)
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, []types.IBCPolicy{policy}, exported.IbcPolicies)
+	require.Len(t, exported.OutboundFlows, 1)
+	require.Equal(t, types.DefaultParams(), exported.Params)
+	require.Equal(t, []types.DenomCapabilities{{Denom: denom, Capabilities: []string{tokenfactorytypes.EnableBurnFrom}}}, exported.DenomCapabilities)
+
+	ctx2, k2 := setupKeeper(t)
+	require.NoError(t, k2.InitGenesis(ctx2, *exported))
+	reexported, err := k2.ExportGenesis(ctx2)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	// flows are only kept for denoms with a quota
+	orphan := *exported
+	orphan.IbcPolicies = nil
+	require.ErrorIs(t, orphan.Validate(), types.ErrInvalidGenesis)
+
+	unknown := *exported
+	unknown.DenomCapabilities = []types.DenomCapabilities{{Denom: denom, Capabilities: []string{"enable_sudo_mint"}}}
+	require.ErrorIs(t, unknown.Validate(), types.ErrInvalidGenesis)
+}
+
+func TestSetDenomCapabilities(t *testing.T) {
+	ctx, k, bank, _ := setupKeeperWithMocks(t)
+	srv := keeper.NewMsgServerImpl(k)
+	both := []string{tokenfactorytypes.EnableForceTransfer, tokenfactorytypes.EnableBurnFrom}
+
+	// only the denom admin opts in
+	_, err := srv.SetDenomCapabilities(ctx, types.NewMsgSetDenomCapabilities(other.String(), denom, both))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.SetDenomCapabilities(ctx, types.NewMsgSetDenomCapabilities(admin.String(), denom, []string{"enable_metadata"}))
+	require.ErrorIs(t, err, types.ErrInvalidCapabilities)
+
+	_, err = srv.SetDenomCapabilities(ctx, types.NewMsgSetDenomCapabilities(admin.String(), denom, both))
+	require.NoError(t, err)
+	res, err := k.DenomCapabilities(ctx, &types.QueryDenomCapabilitiesRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, both, res.OptedIn)
+	require.Equal(t, both, res.Enabled)
+
+	// once the denom has a supply capabilities can be dropped but not added
+	bank.supply[denom] = math.NewInt(1)
+	_, err = srv.SetDenomCapabilities(ctx, types.NewMsgSetDenomCapabilities(admin.String(), denom, both[:1]))
+	require.NoError(t, err)
+	_, err = srv.SetDenomCapabilities(ctx, types.NewMsgSetDenomCapabilities(admin.String(), denom, both))
+	require.ErrorIs(t, err, types.ErrDenomHasSupply)
+	require.NoError(t, k.CheckCapability(ctx, denom, tokenfactorytypes.EnableForceTransfer))
+	require.ErrorIs(t, k.CheckCapability(ctx, denom, tokenfactorytypes.EnableBurnFrom), tokenfactorytypes.ErrCapabilityNotEnabled)
+
+	_, err = srv.SetDenomCapabilities(ctx, types.NewMsgSetDenomCapabilities(admin.String(), denom, nil))
+	require.NoError(t, err)
+	has, err := k.Capabilities.Has(ctx, denom)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	srv := keeper.NewMsgServerImpl(k)
+	_, err := srv.SetDenomCapabilities(ctx, types.NewMsgSetDenomCapabilities(admin.String(), denom, []string{tokenfactorytypes.EnableForceTransfer}))
+	require.NoError(t, err)
+
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(other.String(), types.DefaultParams()))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	invalid := types.DefaultParams()
+	invalid.EnabledCapabilities = []string{"enable_sudo_mint"}
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), invalid))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), types.Params{}))
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	// governance enabling a capability enables it for every denom
+	params := types.Params{EnabledCapabilities: []string{tokenfactorytypes.EnableBurnFrom}, BeforeSendHookGasLimit: 100_000}
+	_, err = srv.UpdateParams(ctx, types.NewMsgUpdateParams(k.GetAuthority(), params))
+	require.NoError(t, err)
+	require.NoError(t, k.CheckCapability(ctx, "factory/"+other.String()+"/other", tokenfactorytypes.EnableBurnFrom))
+
+	// and no longer allowing an opt-in disables it for the denoms that opted in
+	require.ErrorIs(t, k.CheckCapability(ctx, denom, tokenfactorytypes.EnableForceTransfer), tokenfactorytypes.ErrCapabilityNotEnabled)
+	res, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
+
+// mockTokenFactoryMsgServer records the tokenfactory messages it receives.
+type mockTokenFactoryMsgServer struct {
+	tokenfactorytypes.MsgServer
+	calls *int
+}
+
+func (m mockTokenFactoryMsgServer) ForceTransfer(context.Context, *tokenfactorytypes.MsgForceTransfer) (*tokenfactorytypes.MsgForceTransferResponse, error) {
+	*m.calls++
+	return &tokenfactorytypes.MsgForceTransferResponse{}, nil
+}
+
+func (m mockTokenFactoryMsgServer) Mint(context.Context, *tokenfactorytypes.MsgMint) (*tokenfactorytypes.MsgMintResponse, error) {
+	*m.calls++
+	return &tokenfactorytypes.MsgMintResponse{}, nil
+}
+
+func (m mockTokenFactoryMsgServer) Burn(context.Context, *tokenfactorytypes.MsgBurn) (*tokenfactorytypes.MsgBurnResponse, error) {
+	*m.calls++
+	return &tokenfactorytypes.MsgBurnResponse{}, nil
+}
+
+func TestTokenFactoryMsgServer(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	var calls int
+	srv := keeper.NewTokenFactoryMsgServer(k, mockTokenFactoryMsgServer{calls: &calls})
+	coin := sdk.NewInt64Coin(denom, 10)
+
+	// burning from the admin's own balance needs no capability
+	_, err := srv.Burn(ctx, tokenfactorytypes.NewMsgBurn(admin.String(), coin))
+	require.NoError(t, err)
+
+	_, err = srv.Burn(ctx, tokenfactorytypes.NewMsgBurnFrom(admin.String(), coin, other.String()))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrCapabilityNotEnabled)
+	_, err = srv.ForceTransfer(ctx, tokenfactorytypes.NewMsgForceTransfer(admin.String(), coin, other.String(), admin.String()))
+	require.ErrorIs(t, err, tokenfactorytypes.ErrCapabilityNotEnabled)
+	require.Equal(t, 1, calls)
+
+	both := []string{tokenfactorytypes.EnableForceTransfer, tokenfactorytypes.EnableBurnFrom}
+	_, err = keeper.NewMsgServerImpl(k).SetDenomCapabilities(ctx, types.NewMsgSetDenomCapabilities(admin.String(), denom, both))
+	require.NoError(t, err)
+	_, err = srv.Burn(ctx, tokenfactorytypes.NewMsgBurnFrom(admin.String(), coin, other.String()))
+	require.NoError(t, err)
+	_, err = srv.ForceTransfer(ctx, tokenfactorytypes.NewMsgForceTransfer(admin.String(), coin, other.String(), admin.String()))
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+
+	// mints are checked against the supply policy
+	policy := types.SupplyPolicy{Denom: denom, MaxSupply: math.NewInt(15), MintLimit: math.ZeroInt()}
+	_, err = keeper.NewMsgServerImpl(k).SetSupplyPolicy(ctx, types.NewMsgSetSupplyPolicy(admin.String(), policy))
+	require.NoError(t, err)
+	_, err = srv.Mint(ctx, tokenfactorytypes.NewMsgMint(admin.String(), coin))
+	require.NoError(t, err)
+	_, err = srv.Mint(ctx, tokenfactorytypes.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 16)))
+	require.ErrorIs(t, err, types.ErrMaxSupplyExceeded)
+	require.Equal(t, 4, calls)
+}
+
+func TestSetSupplyPolicy(t *testing.T) {
+	ctx, k, bank, _ := setupKeeperWithMocks(t)
+	srv := keeper.NewMsgServerImpl(k)
+	policy := types.SupplyPolicy{Denom: denom, MaxSupply: math.ZeroInt(), MintLimit: math.NewInt(100), MintPeriod: 24 * time.Hour}
+
+	// only the denom admin sets the policy
+	_, err := srv.SetSupplyPolicy(ctx, types.NewMsgSetSupplyPolicy(other.String(), policy))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.SetSupplyPolicy(ctx, types.NewMsgSetSupplyPolicy(admin.String(), policy))
+	require.NoError(t, err)
+
+	for name, malleate := range map[string]func(*types.SupplyPolicy){
+		"not a factory denom":  func(p *types.SupplyPolicy) { p.Denom = "uenoki" },
+		"negative max supply":  func(p *types.SupplyPolicy) { p.MaxSupply = math.NewInt(-1) },
+		"negative mint limit":  func(p *types.SupplyPolicy) { p.MintLimit = math.NewInt(-1) },
+		"no mint period":       func(p *types.SupplyPolicy) { p.MintPeriod = 0 },
+		"period without limit": func(p *types.SupplyPolicy) { p.MintLimit = math.ZeroInt() },
+		"raised mint limit":    func(p *types.SupplyPolicy) { p.MintLimit = math.NewInt(101) },
+		"shorter mint period":  func(p *types.SupplyPolicy) { p.MintPeriod = time.Hour },
+	} {
+		invalid := policy
+		malleate(&invalid)
+		_, err := srv.SetSupplyPolicy(ctx, types.NewMsgSetSupplyPolicy(admin.String(), invalid))
+		require.ErrorIs(t, err, types.ErrInvalidSupplyPolicy, name)
+	}
+
+	// a cap below the current supply is rejected, and once set it is fixed
+	bank.supply[denom] = math.NewInt(500)
+	policy.MaxSupply = math.NewInt(499)
+	_, err = srv.SetSupplyPolicy(ctx, types.NewMsgSetSupplyPolicy(admin.String(), policy))
+	require.ErrorIs(t, err, types.ErrInvalidSupplyPolicy)
+	policy.MaxSupply = math.NewInt(1_000)
+	policy.MintLimit = math.NewInt(50)
+	_, err = srv.SetSupplyPolicy(ctx, types.NewMsgSetSupplyPolicy(admin.String(), policy))
+	require.NoError(t, err)
+	for _, maxSupply := range []int64{0, 999, 1_001} {
+		changed := policy
+		changed.MaxSupply = math.NewInt(maxSupply)
+		_, err = srv.SetSupplyPolicy(ctx, types.NewMsgSetSupplyPolicy(admin.String(), changed))
+		require.ErrorIs(t, err, types.ErrInvalidSupplyPolicy)
+	}
+
+	res, err := k.SupplyPolicy(ctx, &types.QuerySupplyPolicyRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, policy, res.Policy)
+	require.Equal(t, math.NewInt(500), *res.RemainingSupply)
+	require.Equal(t, math.NewInt(50), *res.RemainingMint)
+}
+
+func TestCheckMint(t *testing.T) {
+	ctx, k, bank, _ := setupKeeperWithMocks(t)
+	srv := keeper.NewMsgServerImpl(k)
+	policy := types.SupplyPolicy{Denom: denom, MaxSupply: math.NewInt(1_000), MintLimit: math.NewInt(300), MintPeriod: time.Hour}
+	_, err := srv.SetSupplyPolicy(ctx, types.NewMsgSetSupplyPolicy(admin.String(), policy))
+	require.NoError(t, err)
+	mint := func(ctx sdk.Context, amount int64) error {
+		if err := k.CheckMint(ctx, denom, math.NewInt(amount)); err != nil {
+			return err
+		}
+		bank.supply[denom] = bank.GetSupply(ctx, denom).Amount.AddRaw(amount)
+		return nil
+	}
+
+	// denoms without a policy mint freely
+	require.NoError(t, k.CheckMint(ctx, "factory/"+other.String()+"/other", math.NewInt(1_000_000)))
+
+	require.NoError(t, mint(ctx, 200))
+	require.ErrorIs(t, mint(ctx, 101), types.ErrMintLimitExceeded)
+	require.NoError(t, mint(ctx, 100))
+
+	// the limit resets every period until the cap is reached
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, mint(ctx, 300))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, mint(ctx, 300))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.ErrorIs(t, mint(ctx, 101), types.ErrMaxSupplyExceeded)
+	require.NoError(t, mint(ctx, 100))
+
+	// burns make room under the cap but not under the mint limit
+	bank.supply[denom] = math.NewInt(500)
+	require.NoError(t, mint(ctx, 200))
+	require.ErrorIs(t, mint(ctx, 1), types.ErrMintLimitExceeded)
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.SupplyPolicy{policy}, exported.SupplyPolicies)
+	require.Len(t, exported.MintFlows, 1)
+	require.NoError(t, exported.Validate())
+	orphan := *exported
+	orphan.SupplyPolicies = nil
+	require.ErrorIs(t, orphan.Validate(), types.ErrInvalidGenesis)
+}
+
+func TestSetBeforeSendHook(t *testing.T) {
+	ctx, k, _, contracts := setupKeeperWithMocks(t)
+	srv := keeper.NewMsgServerImpl(k)
+	contract := sdk.AccAddress("contract____________")
+
+	// only the denom admin sets an existing contract as hook
+	_, err := srv.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin.String(), denom, contract.String()))
+	require.ErrorIs(t, err, types.ErrInvalidHook)
+	contracts.contracts[contract.String()] = true
+	_, err = srv.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(other.String(), denom, contract.String()))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = srv.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin.String(), "uenoki", contract.String()))
+	require.ErrorIs(t, err, types.ErrInvalidHook)
+	_, err = srv.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin.String(), denom, contract.String()))
+	require.NoError(t, err)
+
+	res, err := k.BeforeSendHook(ctx, &types.QueryBeforeSendHookRequest{Denom: denom})
+	require.NoError(t, err)
+	require.Equal(t, contract.String(), res.ContractAddress)
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []types.BeforeSendHook{{Denom: denom, ContractAddress: contract.String()}}, exported.BeforeSendHooks)
+	require.NoError(t, exported.Validate())
+
+	_, err = srv.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(admin.String(), denom, ""))
+	require.NoError(t, err)
+	_, err = k.BeforeSendHook(ctx, &types.QueryBeforeSendHookRequest{Denom: denom})
+	require.Error(t, err)
+}
+
+func TestSendRestriction(t *testing.T) {
+	ctx, k, bank, contracts := setupKeeperWithMocks(t)
+	contract := sdk.AccAddress("contract____________")
+	require.NoError(t, k.Hooks.Set(ctx, denom, types.BeforeSendHook{Denom: denom, ContractAddress: contract.String()}))
+	send := func(coins ...sdk.Coin) error {
+		_, err := k.SendRestriction(ctx, admin, other, sdk.NewCoins(coins...))
+		return err
+	}
+
+	// the hook sees every transfer of the denom and blocks large ones
+	var calls []types.BlockBeforeSend
+	contracts.sudo = func(_ sdk.Context, msg types.BeforeSendSudoMsg) ([]byte, error) {
+		calls = append(calls, *msg.BlockBeforeSend)
+		if msg.BlockBeforeSend.Amount.Amount.GT(math.NewInt(100)) {
+			return nil, errors.New("too much")
+		}
+		return nil, nil
+	}
+	require.NoError(t, send(sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin("uenoki", 1_000)))
+	require.Equal(t, []types.BlockBeforeSend{{From: admin.String(), To: other.String(), Amount: sdk.NewInt64Coin(denom, 100)}}, calls)
+	require.ErrorIs(t, send(sdk.NewInt64Coin(denom, 101)), types.ErrSendBlocked)
+	require.NoError(t, send(sdk.NewInt64Coin("uenoki", 1_000)))
+	require.Len(t, calls, 2)
+
+	// a fee asked for by the hook is paid to the contract
+	contracts.sudo = func(sdk.Context, types.BeforeSendSudoMsg) ([]byte, error) {
+		return []byte(`{"fee":"3"}`), nil
+	}
+	require.NoError(t, send(sdk.NewInt64Coin(denom, 100)))
+	require.Equal(t, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin(denom, 3))}, bank.sent)
+	contracts.sudo = func(sdk.Context, types.BeforeSendSudoMsg) ([]byte, error) {
+		return []byte(`{"fee":"-3"}`), nil
+	}
+	require.ErrorIs(t, send(sdk.NewInt64Coin(denom, 100)), types.ErrSendBlocked)
+
+	// hooks running out of their gas block the transfer and only charge the
+	// gas limit
+	contracts.sudo = func(ctx sdk.Context, _ types.BeforeSendSudoMsg) ([]byte, error) {
+		ctx.GasMeter().ConsumeGas(types.DefaultBeforeSendHookGasLimit+1, "hook")
+		return nil, nil
+	}
+	gasBefore := ctx.GasMeter().GasConsumed()
+	require.ErrorIs(t, send(sdk.NewInt64Coin(denom, 100)), types.ErrSendBlocked)
+	require.LessOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, types.DefaultBeforeSendHookGasLimit+10_000)
+
+	// refunds out of the escrow account of a failed transfer skip the hook,
+	// other sends in the same context do not
+	refundCtx := keeper.WithRefundEscrow(ctx, other)
+	_, err := k.SendRestriction(refundCtx, other, admin, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	require.NoError(t, err)
+	_, err = k.SendRestriction(refundCtx, admin, other, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+	require.ErrorIs(t, err, types.ErrSendBlocked)
+}
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// SetIBCPolicy replaces the IBC policy of a denom. The outbound flow of the
+// current quota period is kept so changing the policy cannot reset the quota.
+func (k msgServer) SetIBCPolicy(goCtx context.Context, msg *types.MsgSetIBCPolicy) (*types.MsgSetIBCPolicyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdmin(ctx, msg.Sender, msg.Policy.Denom); err != nil {
+		return nil, err
+	}
+	if err := k.Policies.Set(ctx, msg.Policy.Denom, msg.Policy); err != nil {
+		return nil, err
+	}
+	if !msg.Policy.HasQuota() {
+		if err := k.Flows.Remove(ctx, msg.Policy.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetIBCPolicy,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Policy.Denom),
+		sdk.NewAttribute(types.AttributeKeyDisabled, strconv.FormatBool(msg.Policy.Disabled)),
+		sdk.NewAttribute(types.AttributeKeyAllowedChannels, strings.Join(msg.Policy.AllowedChannels, ",")),
+		sdk.NewAttribute(types.AttributeKeyOutboundQuota, msg.Policy.OutboundQuota.String()),
+	))
+
+	return &types.MsgSetIBCPolicyResponse{}, nil
+}
+
+// RemoveIBCPolicy removes the IBC policy of a denom.
+func (k msgServer) RemoveIBCPolicy(goCtx context.Context, msg *types.MsgRemoveIBCPolicy) (*types.MsgRemoveIBCPolicyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+	has, err := k.Policies.Has(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, types.ErrPolicyNotFound.Wrap(msg.Denom)
+	}
+	if err := k.Policies.Remove(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+	if err := k.Flows.Remove(ctx, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveIBCPolicy,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+	))
+
+	return &types.MsgRemoveIBCPolicyResponse{}, nil
+}
+
+// SetDenomCapabilities replaces the capabilities a denom opted in to.
+// Capabilities are only added while the denom has no supply, so nobody holds
+// the denom before learning what its admin may do with it. They can be
+// dropped at any time.
+func (k msgServer) SetDenomCapabilities(goCtx context.Context, msg *types.MsgSetDenomCapabilities) (*types.MsgSetDenomCapabilitiesResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, err := k.Capabilities.Get(ctx, msg.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	for _, c := range msg.Capabilities {
+		if slices.Contains(current.Capabilities, c) {
+			continue
+		}
+		if !slices.Contains(params.OptInCapabilities, c) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidCapabilities, "%s cannot be opted in to", c)
+		}
+		if supply := k.bankKeeper.GetSupply(ctx, msg.Denom); !supply.IsZero() {
+			return nil, errorsmod.Wrapf(types.ErrDenomHasSupply, "%s has a supply of %s", msg.Denom, supply.Amount)
+		}
+	}
+
+	if len(msg.Capabilities) == 0 {
+		err = k.Capabilities.Remove(ctx, msg.Denom)
+	} else {
+		err = k.Capabilities.Set(ctx, msg.Denom, types.DenomCapabilities{Denom: msg.Denom, Capabilities: msg.Capabilities})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetCapabilities,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyCapabilities, strings.Join(msg.Capabilities, ",")),
+	))
+
+	return &types.MsgSetDenomCapabilitiesResponse{}, nil
+}
+
+// SetBeforeSendHook sets or, with an empty contract address, removes the
+// before send hook contract of a denom.
+func (k msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdmin(ctx, msg.Sender, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if msg.ContractAddress == "" {
+		err = k.Hooks.Remove(ctx, msg.Denom)
+	} else {
+		if !k.contractKeeper.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(msg.ContractAddress)) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidHook, "%s is not a contract", msg.ContractAddress)
+		}
+		err = k.Hooks.Set(ctx, msg.Denom, types.BeforeSendHook{Denom: msg.Denom, ContractAddress: msg.ContractAddress})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetHook,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+	))
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+// SetSupplyPolicy sets the supply policy of a denom. A supply cap is fixed
+// once set and a mint limit can only be tightened, so the admin cannot lift
+// the guarantees holders rely on. The mint flow of the current period is kept.
+func (k msgServer) SetSupplyPolicy(goCtx context.Context, msg *types.MsgSetSupplyPolicy) (*types.MsgSetSupplyPolicyResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkAdmin(ctx, msg.Sender, msg.Policy.Denom); err != nil {
+		return nil, err
+	}
+	current, err := k.SupplyLimits.Get(ctx, msg.Policy.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if err := current.ValidateUpdate(msg.Policy); err != nil {
+		return nil, err
+	}
+	if msg.Policy.HasMaxSupply() {
+		if supply := k.bankKeeper.GetSupply(ctx, msg.Policy.Denom); supply.Amount.GT(msg.Policy.MaxSupply) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidSupplyPolicy, "%s already has a supply of %s", msg.Policy.Denom, supply.Amount)
+		}
+	}
+
+	if err := k.SupplyLimits.Set(ctx, msg.Policy.Denom, msg.Policy); err != nil {
+		return nil, err
+	}
+	if !msg.Policy.HasMintLimit() {
+		if err := k.MintFlows.Remove(ctx, msg.Policy.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetSupplyPolicy,
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Policy.Denom),
+		sdk.NewAttribute(types.AttributeKeyMaxSupply, msg.Policy.MaxSupply.String()),
+		sdk.NewAttribute(types.AttributeKeyMintLimit, msg.Policy.MintLimit.String()),
+		sdk.NewAttribute(types.AttributeKeyMintPeriod, msg.Policy.MintPeriod.String()),
+	))
+
+	return &types.MsgSetSupplyPolicyResponse{}, nil
+}
+
+// UpdateParams replaces the governance controlled tokenfactory capabilities
+// and before send hook limits.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ParamsStore.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateParams,
+		sdk.NewAttribute(types.AttributeKeyCapabilities, strings.Join(msg.Params.EnabledCapabilities, ",")),
+		sdk.NewAttribute(types.AttributeKeyOptIn, strings.Join(msg.Params.OptInCapabilities, ",")),
+		sdk.NewAttribute(types.AttributeKeyHookGasLimit, strconv.FormatUint(msg.Params.BeforeSendHookGasLimit, 10)),
+	))
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+// GetSupplyPolicy returns the supply policy of denom and whether it has one.
+func (k Keeper) GetSupplyPolicy(ctx context.Context, denom string) (types.SupplyPolicy, bool, error) {
+	policy, err := k.SupplyLimits.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SupplyPolicy{}, false, nil
+	} else if err != nil {
+		return types.SupplyPolicy{}, false, err
+	}
+	return policy, true, nil
+}
+
+// CheckMint returns an error if minting amount of denom would exceed the
+// supply cap or the mint limit of its supply policy. Mints within the limit
+// are added to the mint flow of the current period. Denoms without a policy
+// are always allowed.
+func (k Keeper) CheckMint(ctx context.Context, denom string, amount math.Int) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	policy, err := k.SupplyLimits.Get(ctx, denom)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if policy.HasMaxSupply() {
+		supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+		if supply.Add(amount).GT(policy.MaxSupply) {
+			return errorsmod.Wrapf(types.ErrMaxSupplyExceeded, "%s: %s remaining", denom, math.MaxInt(policy.MaxSupply.Sub(supply), math.ZeroInt()))
+		}
+	}
+	if !policy.HasMintLimit() {
+		return nil
+	}
+
+	flow, err := k.currentMintFlow(sdkCtx, policy)
+	if err != nil {
+		return err
+	}
+	flow.Amount = flow.Amount.Add(amount)
+	if flow.Amount.GT(policy.MintLimit) {
+		return errorsmod.Wrapf(types.ErrMintLimitExceeded, "%s: %s remaining", denom, policy.MintLimit.Sub(flow.Amount.Sub(amount)))
+	}
+	return k.MintFlows.Set(ctx, denom, flow)
+}
+
+// currentMintFlow returns the mint flow of the policy's denom in the mint
+// period containing the block time.
+func (k Keeper) currentMintFlow(ctx sdk.Context, policy types.SupplyPolicy) (types.MintFlow, error) {
+	flow, err := k.MintFlows.Get(ctx, policy.Denom)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.MintFlow{}, err
+	}
+	return policy.CurrentFlow(flow, ctx.BlockTime()), nil
+}
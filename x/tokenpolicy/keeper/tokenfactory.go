@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// tokenFactoryMsgServer checks the governance controlled capabilities of a
+// denom before passing force transfers and burns from other accounts to the
+// tokenfactory msg server, which has every capability compiled in, and the
+// supply policy of a denom before passing mints.
+type tokenFactoryMsgServer struct {
+	tokenfactorytypes.MsgServer
+	keeper Keeper
+}
+
+// NewTokenFactoryMsgServer wraps the tokenfactory msg server so its force
+// transfer and burn from capabilities are enabled per denom by this module and
+// mints stay within the supply policy of the denom.
+func NewTokenFactoryMsgServer(keeper Keeper, msgServer tokenfactorytypes.MsgServer) tokenfactorytypes.MsgServer {
+	return &tokenFactoryMsgServer{MsgServer: msgServer, keeper: keeper}
+}
+
+var _ tokenfactorytypes.MsgServer = tokenFactoryMsgServer{}
+
+// Mint implements tokenfactorytypes.MsgServer.
+func (s tokenFactoryMsgServer) Mint(ctx context.Context, msg *tokenfactorytypes.MsgMint) (*tokenfactorytypes.MsgMintResponse, error) {
+	if err := s.keeper.CheckMint(ctx, msg.Amount.Denom, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+	return s.MsgServer.Mint(ctx, msg)
+}
+
+// ForceTransfer implements tokenfactorytypes.MsgServer.
+func (s tokenFactoryMsgServer) ForceTransfer(ctx context.Context, msg *tokenfactorytypes.MsgForceTransfer) (*tokenfactorytypes.MsgForceTransferResponse, error) {
+	if err := s.keeper.CheckCapability(ctx, msg.Amount.Denom, tokenfactorytypes.EnableForceTransfer); err != nil {
+		return nil, err
+	}
+	return s.MsgServer.ForceTransfer(ctx, msg)
+}
+
+// Burn implements tokenfactorytypes.MsgServer.
+func (s tokenFactoryMsgServer) Burn(ctx context.Context, msg *tokenfactorytypes.MsgBurn) (*tokenfactorytypes.MsgBurnResponse, error) {
+	if msg.BurnFromAddress != "" {
+		if err := s.keeper.CheckCapability(ctx, msg.Amount.Denom, tokenfactorytypes.EnableBurnFrom); err != nil {
+			return nil, err
+		}
+	}
+	return s.MsgServer.Burn(ctx, msg)
+}
@@ -0,0 +1,47 @@
+package tokenpolicy
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory"
+	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/keeper"
+)
+
+// TokenFactoryAppModule is the tokenfactory app module with its msg server
+// wrapped by the tokenpolicy keeper, so force transfers and burns from other
+// accounts are only allowed for denoms that have the capability.
+type TokenFactoryAppModule struct {
+	tokenfactory.AppModule
+
+	tokenFactoryKeeper tokenfactorykeeper.Keeper
+	keeper             keeper.Keeper
+}
+
+// NewTokenFactoryAppModule wraps the tokenfactory app module.
+func NewTokenFactoryAppModule(am tokenfactory.AppModule, tokenFactoryKeeper tokenfactorykeeper.Keeper, k keeper.Keeper) TokenFactoryAppModule {
+	return TokenFactoryAppModule{
+		AppModule:          am,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		keeper:             k,
+	}
+}
+
+// RegisterServices registers the wrapped tokenfactory msg server, the
+// tokenfactory query server and its migrations.
+func (am TokenFactoryAppModule) RegisterServices(cfg module.Configurator) {
+	msgServer := keeper.NewTokenFactoryMsgServer(am.keeper, tokenfactorykeeper.NewMsgServerImpl(am.tokenFactoryKeeper))
+	tokenfactorytypes.RegisterMsgServer(cfg.MsgServer(), msgServer)
+	tokenfactorytypes.RegisterQueryServer(cfg.QueryServer(), am.tokenFactoryKeeper)
+
+	// the x/params subspace was never set on this chain, the migration only
+	// runs for chains upgrading from tokenfactory v1 state
+	m := tokenfactorykeeper.NewMigrator(am.tokenFactoryKeeper, nil)
+	if err := cfg.RegisterMigration(tokenfactorytypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", tokenfactorytypes.ModuleName, err))
+	}
+}
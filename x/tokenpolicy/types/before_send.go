@@ -0,0 +1,48 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// DefaultBeforeSendHookGasLimit is the default gas a before send hook
+// contract may use for every transfer.
+const DefaultBeforeSendHookGasLimit = uint64(500_000)
+
+// Validate checks that the hook is for a tokenfactory denom and names a
+// contract address.
+func (h BeforeSendHook) Validate() error {
+	if _, _, err := tokenfactorytypes.DeconstructDenom(h.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidHook, "not a tokenfactory denom: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(h.ContractAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidHook, "invalid contract address: %s", err)
+	}
+	return nil
+}
+
+// BeforeSendSudoMsg is sent to the before send hook contract of a denom
+// before every transfer of the denom. The message is compatible with the
+// block_before_send hook of the Osmosis tokenfactory.
+type BeforeSendSudoMsg struct {
+	BlockBeforeSend *BlockBeforeSend `json:"block_before_send,omitempty"`
+}
+
+// BlockBeforeSend describes a transfer. The contract blocks the transfer by
+// returning an error.
+type BlockBeforeSend struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Amount sdk.Coin `json:"amount"`
+}
+
+// BlockBeforeSendResponse is the optional JSON data a hook contract returns
+// to charge a fee in the transferred denom. The fee is paid by the sender to
+// the contract on top of the transferred amount.
+type BlockBeforeSendResponse struct {
+	Fee math.Int `json:"fee,omitempty"`
+}
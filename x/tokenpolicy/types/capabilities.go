@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// Capabilities are the tokenfactory capabilities controlled by governance.
+// They are compiled into the tokenfactory keeper and enforced per denom by
+// this module. This tokenfactory release has no sudo mint capability.
+var Capabilities = []string{
+	tokenfactorytypes.EnableForceTransfer,
+	tokenfactorytypes.EnableBurnFrom,
+}
+
+// DefaultParams returns the default parameters. No denom has a capability
+// unless its admin opts in at creation.
+func DefaultParams() Params {
+	return Params{
+		OptInCapabilities:      slices.Clone(Capabilities),
+		BeforeSendHookGasLimit: DefaultBeforeSendHookGasLimit,
+	}
+}
+
+// Validate checks that the params only list known capabilities and allow
+// before send hooks some gas.
+func (p Params) Validate() error {
+	if p.BeforeSendHookGasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "before send hook gas limit must be positive")
+	}
+	if err := validateCapabilities(p.EnabledCapabilities); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+	if err := validateCapabilities(p.OptInCapabilities); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
+
+// CapabilityEnabled reports whether capability is enabled for a denom that
+// opted in to optedIn.
+func (p Params) CapabilityEnabled(capability string, optedIn []string) bool {
+	if slices.Contains(p.EnabledCapabilities, capability) {
+		return true
+	}
+	return slices.Contains(optedIn, capability) && slices.Contains(p.OptInCapabilities, capability)
+}
+
+// Validate checks that the denom is a tokenfactory denom opting in to known
+// capabilities.
+func (c DenomCapabilities) Validate() error {
+	if _, _, err := tokenfactorytypes.DeconstructDenom(c.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCapabilities, "not a tokenfactory denom: %s", err)
+	}
+	if err := validateCapabilities(c.Capabilities); err != nil {
+		return errorsmod.Wrap(ErrInvalidCapabilities, err.Error())
+	}
+	return nil
+}
+
+// validateCapabilities checks that every entry is a unique known capability.
+func validateCapabilities(capabilities []string) error {
+	seen := make(map[string]struct{}, len(capabilities))
+	for _, c := range capabilities {
+		if !slices.Contains(Capabilities, c) {
+			return fmt.Errorf("unknown capability %q", c)
+		}
+		if _, ok := seen[c]; ok {
+			return fmt.Errorf("duplicate capability %s", c)
+		}
+		seen[c] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var amino = codec.NewLegacyAmino()
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	sdk.RegisterLegacyAminoCodec(amino)
+
+	// Register on the shared amino codec so the messages can be nested in
+	// authz and gov proposals.
+	RegisterLegacyAminoCodec(legacy.Cdc)
+
+	amino.Seal()
+}
+
+// RegisterInterfaces registers the module's interface types.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgSetIBCPolicy{},
+		&MsgRemoveIBCPolicy{},
+		&MsgSetDenomCapabilities{},
+		&MsgSetBeforeSendHook{},
+		&MsgSetSupplyPolicy{},
+		&MsgUpdateParams{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec registers the module's concrete types on the
+// given amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSetIBCPolicy{}, "enoki/tokenpolicy/MsgSetIBCPolicy", nil)
+	cdc.RegisterConcrete(&MsgRemoveIBCPolicy{}, "enoki/tokenpolicy/MsgRemoveIBCPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetDenomCapabilities{}, "enoki/tokenpolicy/MsgSetDenomCapabilities", nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, "enoki/tokenpolicy/MsgSetBeforeSendHook", nil)
+	cdc.RegisterConcrete(&MsgSetSupplyPolicy{}, "enoki/tokenpolicy/MsgSetSupplyPolicy", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "enoki/x/tokenpolicy/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&Params{}, "enoki/x/tokenpolicy/Params", nil)
+}
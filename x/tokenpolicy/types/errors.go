@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrUnauthorized        = errorsmod.Register(ModuleName, 2, "sender is not the denom admin")
+	ErrInvalidPolicy       = errorsmod.Register(ModuleName, 3, "invalid IBC policy")
+	ErrInvalidGenesis      = errorsmod.Register(ModuleName, 4, "invalid genesis")
+	ErrTransferDisabled    = errorsmod.Register(ModuleName, 5, "IBC transfers of the denom are disabled")
+	ErrChannelNotAllowed   = errorsmod.Register(ModuleName, 6, "IBC transfers of the denom are not allowed on the channel")
+	ErrQuotaExceeded       = errorsmod.Register(ModuleName, 7, "outbound IBC quota of the denom exceeded")
+	ErrPolicyNotFound      = errorsmod.Register(ModuleName, 8, "IBC policy not found")
+	ErrInvalidAuthority    = errorsmod.Register(ModuleName, 9, "invalid authority")
+	ErrInvalidParams       = errorsmod.Register(ModuleName, 10, "invalid params")
+	ErrInvalidCapabilities = errorsmod.Register(ModuleName, 11, "invalid denom capabilities")
+	ErrDenomHasSupply      = errorsmod.Register(ModuleName, 12, "capabilities can only be added while the denom has no supply")
+	ErrInvalidHook         = errorsmod.Register(ModuleName, 13, "invalid before send hook")
+	ErrSendBlocked         = errorsmod.Register(ModuleName, 14, "transfer blocked by the before send hook")
+	ErrInvalidSupplyPolicy = errorsmod.Register(ModuleName, 15, "invalid supply policy")
+	ErrMaxSupplyExceeded   = errorsmod.Register(ModuleName, 16, "max supply of the denom exceeded")
+	ErrMintLimitExceeded   = errorsmod.Register(ModuleName, 17, "mint limit of the denom exceeded")
+)
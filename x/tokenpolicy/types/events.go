@@ -0,0 +1,26 @@
+package types
+
+// tokenpolicy module event types and attribute keys
+const (
+	EventTypeSetIBCPolicy    = "set_ibc_policy"
+	EventTypeRemoveIBCPolicy = "remove_ibc_policy"
+	EventTypeSetCapabilities = "set_denom_capabilities"
+	EventTypeUpdateParams    = "tokenpolicy_params_updated"
+	EventTypeSetHook         = "set_before_send_hook"
+	EventTypeHookFee         = "before_send_hook_fee"
+	EventTypeSetSupplyPolicy = "set_supply_policy"
+
+	AttributeKeyDenom           = "denom"
+	AttributeKeyDisabled        = "disabled"
+	AttributeKeyAllowedChannels = "allowed_channels"
+	AttributeKeyOutboundQuota   = "outbound_quota"
+	AttributeKeyCapabilities    = "capabilities"
+	AttributeKeyOptIn           = "opt_in_capabilities"
+	AttributeKeyContract        = "contract_address"
+	AttributeKeyHookGasLimit    = "before_send_hook_gas_limit"
+	AttributeKeyFee             = "fee"
+	AttributeKeySender          = "sender"
+	AttributeKeyMaxSupply       = "max_supply"
+	AttributeKeyMintLimit       = "mint_limit"
+	AttributeKeyMintPeriod      = "mint_period"
+)
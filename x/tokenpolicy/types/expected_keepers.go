@@ -0,0 +1,29 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// TokenFactoryKeeper defines the tokenfactory methods used to authorize denom
+// admins.
+type TokenFactoryKeeper interface {
+	GetAuthorityMetadata(ctx context.Context, denom string) (tokenfactorytypes.DenomAuthorityMetadata, error)
+}
+
+// BankKeeper defines the bank methods used to check the supply of a denom and
+// to pay before send hook fees.
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// ContractKeeper defines the wasm methods used to call before send hook
+// contracts.
+type ContractKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultGenesis returns the default tokenpolicy genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		IbcPolicies:       []IBCPolicy{},
+		OutboundFlows:     []OutboundFlow{},
+		Params:            DefaultParams(),
+		DenomCapabilities: []DenomCapabilities{},
+		BeforeSendHooks:   []BeforeSendHook{},
+		SupplyPolicies:    []SupplyPolicy{},
+		MintFlows:         []MintFlow{},
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	policies := make(map[string]IBCPolicy, len(gs.IbcPolicies))
+	for _, p := range gs.IbcPolicies {
+		if err := p.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesis, err.Error())
+		}
+		if _, ok := policies[p.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate IBC policy for %s", p.Denom)
+		}
+		policies[p.Denom] = p
+	}
+
+	seen := make(map[string]struct{}, len(gs.OutboundFlows))
+	for _, f := range gs.OutboundFlows {
+		if p, ok := policies[f.Denom]; !ok || !p.HasQuota() {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "outbound flow for %s which has no quota", f.Denom)
+		}
+		if f.Amount.IsNil() || f.Amount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "negative outbound flow for %s", f.Denom)
+		}
+		if _, ok := seen[f.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate outbound flow for %s", f.Denom)
+		}
+		seen[f.Denom] = struct{}{}
+	}
+
+	seen = make(map[string]struct{}, len(gs.DenomCapabilities))
+	for _, c := range gs.DenomCapabilities {
+		if err := c.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesis, err.Error())
+		}
+		if _, ok := seen[c.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate capabilities for %s", c.Denom)
+		}
+		seen[c.Denom] = struct{}{}
+	}
+
+	seen = make(map[string]struct{}, len(gs.BeforeSendHooks))
+	for _, h := range gs.BeforeSendHooks {
+		if err := h.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesis, err.Error())
+		}
+		if _, ok := seen[h.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate before send hook for %s", h.Denom)
+		}
+		seen[h.Denom] = struct{}{}
+	}
+
+	supplyPolicies := make(map[string]SupplyPolicy, len(gs.SupplyPolicies))
+	for _, p := range gs.SupplyPolicies {
+		if err := p.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidGenesis, err.Error())
+		}
+		if _, ok := supplyPolicies[p.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate supply policy for %s", p.Denom)
+		}
+		supplyPolicies[p.Denom] = p
+	}
+
+	seen = make(map[string]struct{}, len(gs.MintFlows))
+	for _, f := range gs.MintFlows {
+		if p, ok := supplyPolicies[f.Denom]; !ok || !p.HasMintLimit() {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "mint flow for %s which has no mint limit", f.Denom)
+		}
+		if f.Amount.IsNil() || f.Amount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "negative mint flow for %s", f.Denom)
+		}
+		if _, ok := seen[f.Denom]; ok {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate mint flow for %s", f.Denom)
+		}
+		seen[f.Denom] = struct{}{}
+	}
+	return nil
+}
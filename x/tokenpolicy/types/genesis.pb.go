@@ -0,0 +1,717 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: enoki/tokenpolicy/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the tokenpolicy module's genesis state.
+type GenesisState struct {
+	// ibc_policies are the IBC policies set by denom admins.
+	IbcPolicies []IBCPolicy `protobuf:"bytes,1,rep,name=ibc_policies,json=ibcPolicies,proto3" json:"ibc_policies"`
+	// outbound_flows are the amounts sent out in the current quota periods.
+	OutboundFlows []OutboundFlow `protobuf:"bytes,2,rep,name=outbound_flows,json=outboundFlows,proto3" json:"outbound_flows"`
+	// params defines the governance controlled tokenfactory capabilities.
+	Params Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// denom_capabilities are the tokenfactory capabilities denoms opted in to.
+	DenomCapabilities []DenomCapabilities `protobuf:"bytes,4,rep,name=denom_capabilities,json=denomCapabilities,proto3" json:"denom_capabilities"`
+	// before_send_hooks are the before send hook contracts set by denom admins.
+	BeforeSendHooks []BeforeSendHook `protobuf:"bytes,5,rep,name=before_send_hooks,json=beforeSendHooks,proto3" json:"before_send_hooks"`
+	// supply_policies are the supply caps and mint limits set by denom admins.
+	SupplyPolicies []SupplyPolicy `protobuf:"bytes,6,rep,name=supply_policies,json=supplyPolicies,proto3" json:"supply_policies"`
+	// mint_flows are the amounts minted in the current mint periods.
+	MintFlows []MintFlow `protobuf:"bytes,7,rep,name=mint_flows,json=mintFlows,proto3" json:"mint_flows"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43bf6d27dade1ee5, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetIbcPolicies() []IBCPolicy {
+	if m != nil {
+		return m.IbcPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOutboundFlows() []OutboundFlow {
+	if m != nil {
+		return m.OutboundFlows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetDenomCapabilities() []DenomCapabilities {
+	if m != nil {
+		return m.DenomCapabilities
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBeforeSendHooks() []BeforeSendHook {
+	if m != nil {
+		return m.BeforeSendHooks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSupplyPolicies() []SupplyPolicy {
+	if m != nil {
+		return m.SupplyPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintFlows() []MintFlow {
+	if m != nil {
+		return m.MintFlows
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "enoki.tokenpolicy.v1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("enoki/tokenpolicy/v1/genesis.proto", fileDescriptor_43bf6d27dade1ee5)
+}
+
+var fileDescriptor_43bf6d27dade1ee5 = []byte{
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0x80, 0x13, 0x5b, 0x57, 0x3a, 0x5b, 0x5b, 0x76, 0xe8, 0x21, 0x14, 0x49, 0xcb, 0x22, 0x5a,
+	0x04, 0x13, 0x5a, 0xf1, 0x2c, 0xa4, 0xa2, 0xf5, 0x50, 0xba, 0xb8, 0xe2, 0x41, 0x0f, 0x61, 0x26,
+	0x99, 0x26, 0x43, 0x92, 0x79, 0xc3, 0xce, 0xa4, 0x35, 0xff, 0xc2, 0x9f, 0xe1, 0xd1, 0x9f, 0xd1,
+	0x63, 0x4f, 0xe2, 0x49, 0x64, 0xf7, 0xe0, 0xdf, 0x90, 0xcc, 0x06, 0x3a, 0xbb, 0x06, 0x2f, 0x61,
+	0x32, 0xef, 0x7b, 0xdf, 0x7b, 0xc9, 0x7b, 0x68, 0xcc, 0x04, 0x14, 0x3c, 0xd4, 0x50, 0x30, 0x21,
+	0xa1, 0xe4, 0x49, 0x13, 0x5e, 0x1d, 0x87, 0x19, 0x13, 0x4c, 0x71, 0x15, 0xc8, 0x19, 0x68, 0xc0,
+	0x7b, 0x86, 0x09, 0x2c, 0x26, 0xb8, 0x3a, 0xde, 0x1f, 0x91, 0x8a, 0x0b, 0x08, 0xcd, 0x73, 0x09,
+	0xee, 0xef, 0x65, 0x90, 0x81, 0x39, 0x86, 0xed, 0xa9, 0xbb, 0x7d, 0xd2, 0x5b, 0xc2, 0xb6, 0x19,
+	0x6e, 0xfc, 0x63, 0x13, 0x6d, 0xbf, 0x5d, 0x16, 0x9e, 0x6a, 0xa2, 0x19, 0x3e, 0x47, 0xdb, 0x9c,
+	0x26, 0xb1, 0x81, 0x38, 0x53, 0x9e, 0x7b, 0xb8, 0x71, 0x34, 0x3c, 0x39, 0x08, 0xfa, 0xda, 0x09,
+	0xde, 0x45, 0xa7, 0x13, 0xf3, 0x12, 0x6d, 0xdd, 0xfc, 0x3a, 0x70, 0xbe, 0xfd, 0xf9, 0xfe, 0xcc,
+	0x7d, 0x3f, 0xe4, 0x34, 0x99, 0x74, 0xe9, 0xf8, 0x03, 0xda, 0x81, 0x5a, 0x53, 0xa8, 0x45, 0x1a,
+	0x5f, 0x96, 0x70, 0xad, 0xbc, 0x7b, 0x46, 0x38, 0xee, 0x17, 0x5e, 0x74, 0xec, 0x9b, 0x12, 0xae,
+	0x6d, 0xe7, 0x43, 0xb0, 0x02, 0x0a, 0xbf, 0x42, 0x03, 0x49, 0x66, 0xa4, 0x52, 0xde, 0xc6, 0xa1,
+	0x7b, 0x34, 0x3c, 0x79, 0xd4, 0x6f, 0x9b, 0x18, 0xc6, 0xf6, 0x74, 0x69, 0x98, 0x20, 0x9c, 0x32,
+	0x01, 0x55, 0x9c, 0x10, 0x49, 0x28, 0x2f, 0xb9, 0x6e, 0xbf, 0x75, 0xd3, 0xb4, 0xf6, 0xb4, 0x5f,
+	0xf6, 0xba, 0xe5, 0x4f, 0x2d, 0xdc, 0xf6, 0x8e, 0xd2, 0xf5, 0x28, 0xfe, 0x8c, 0x46, 0x94, 0x5d,
+	0xc2, 0x8c, 0xc5, 0x8a, 0x89, 0x34, 0xce, 0x01, 0x0a, 0xe5, 0xdd, 0x37, 0x15, 0x1e, 0xf7, 0x57,
+	0x88, 0x0c, 0x3e, 0x65, 0x22, 0x3d, 0x03, 0x28, 0x6c, 0xfd, 0x2e, 0x5d, 0x09, 0x29, 0xfc, 0x11,
+	0xed, 0xaa, 0x5a, 0xca, 0xb2, 0xb9, 0x1b, 0xd4, 0xe0, 0x7f, 0xff, 0x75, 0x6a, 0xe0, 0x7f, 0x67,
+	0xb5, 0xa3, 0xee, 0x02, 0x6d, 0xd3, 0x67, 0x08, 0x55, 0x5c, 0xe8, 0x6e, 0x54, 0x0f, 0x8c, 0xd2,
+	0xef, 0x57, 0x9e, 0x73, 0xa1, 0xd7, 0xc7, 0xb4, 0x55, 0x75, 0x97, 0x2a, 0xba, 0xb8, 0x99, 0xfb,
+	0xee, 0xed, 0xdc, 0x77, 0x7f, 0xcf, 0x7d, 0xf7, 0xeb, 0xc2, 0x77, 0x6e, 0x17, 0xbe, 0xf3, 0x73,
+	0xe1, 0x3b, 0x9f, 0x5e, 0x66, 0x5c, 0xe7, 0x35, 0x0d, 0x12, 0xa8, 0xc2, 0xbc, 0x91, 0x39, 0x49,
+	0x00, 0x64, 0x98, 0x80, 0xaa, 0x40, 0x3d, 0x5f, 0xae, 0xed, 0x97, 0x95, 0xc5, 0xd5, 0x8d, 0x64,
+	0x8a, 0x0e, 0xcc, 0xc2, 0xbe, 0xf8, 0x3b, 0x00, 0xc0, 0xc5, 0x72, 0x72, 0x3d, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintFlows) > 0 {
+		for iNdEx := len(m.MintFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SupplyPolicies) > 0 {
+		for iNdEx := len(m.SupplyPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BeforeSendHooks) > 0 {
+		for iNdEx := len(m.BeforeSendHooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BeforeSendHooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DenomCapabilities) > 0 {
+		for iNdEx := len(m.DenomCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCapabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OutboundFlows) > 0 {
+		for iNdEx := len(m.OutboundFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.IbcPolicies) > 0 {
+		for iNdEx := len(m.IbcPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IbcPolicies) > 0 {
+		for _, e := range m.IbcPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OutboundFlows) > 0 {
+		for _, e := range m.OutboundFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.DenomCapabilities) > 0 {
+		for _, e := range m.DenomCapabilities {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BeforeSendHooks) > 0 {
+		for _, e := range m.BeforeSendHooks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyPolicies) > 0 {
+		for _, e := range m.SupplyPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintFlows) > 0 {
+		for _, e := range m.MintFlows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcPolicies = append(m.IbcPolicies, IBCPolicy{})
+			if err := m.IbcPolicies[len(m.IbcPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundFlows = append(m.OutboundFlows, OutboundFlow{})
+			if err := m.OutboundFlows[len(m.OutboundFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCapabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCapabilities = append(m.DenomCapabilities, DenomCapabilities{})
+			if err := m.DenomCapabilities[len(m.DenomCapabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHooks = append(m.BeforeSendHooks, BeforeSendHook{})
+			if err := m.BeforeSendHooks[len(m.BeforeSendHooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyPolicies = append(m.SupplyPolicies, SupplyPolicy{})
+			if err := m.SupplyPolicies[len(m.SupplyPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFlows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintFlows = append(m.MintFlows, MintFlow{})
+			if err := m.MintFlows[len(m.MintFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
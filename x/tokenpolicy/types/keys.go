@@ -0,0 +1,32 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "tokenpolicy"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+)
+
+var (
+	// IBCPoliciesKey stores the IBC policy of each tokenfactory denom.
+	IBCPoliciesKey = collections.NewPrefix(0)
+	// OutboundFlowsKey stores the amount of each denom sent out in the
+	// current quota period.
+	OutboundFlowsKey = collections.NewPrefix(1)
+	// ParamsKey stores the module parameters.
+	ParamsKey = collections.NewPrefix(2)
+	// DenomCapabilitiesKey stores the capabilities each denom opted in to.
+	DenomCapabilitiesKey = collections.NewPrefix(3)
+	// BeforeSendHooksKey stores the before send hook contract of each denom.
+	BeforeSendHooksKey = collections.NewPrefix(4)
+	// SupplyPoliciesKey stores the supply policy of each tokenfactory denom.
+	SupplyPoliciesKey = collections.NewPrefix(5)
+	// MintFlowsKey stores the amount of each denom minted in the current
+	// mint period.
+	MintFlowsKey = collections.NewPrefix(6)
+)
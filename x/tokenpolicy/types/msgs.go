@@ -0,0 +1,126 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+var (
+	_ sdk.Msg = &MsgSetIBCPolicy{}
+	_ sdk.Msg = &MsgRemoveIBCPolicy{}
+	_ sdk.Msg = &MsgSetDenomCapabilities{}
+	_ sdk.Msg = &MsgSetBeforeSendHook{}
+	_ sdk.Msg = &MsgSetSupplyPolicy{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgSetIBCPolicy creates a new MsgSetIBCPolicy instance.
+func NewMsgSetIBCPolicy(sender string, policy IBCPolicy) *MsgSetIBCPolicy {
+	return &MsgSetIBCPolicy{
+		Sender: sender,
+		Policy: policy,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgSetIBCPolicy.
+func (msg *MsgSetIBCPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	return msg.Policy.Validate()
+}
+
+// NewMsgRemoveIBCPolicy creates a new MsgRemoveIBCPolicy instance.
+func NewMsgRemoveIBCPolicy(sender, denom string) *MsgRemoveIBCPolicy {
+	return &MsgRemoveIBCPolicy{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgRemoveIBCPolicy.
+func (msg *MsgRemoveIBCPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if _, _, err := tokenfactorytypes.DeconstructDenom(msg.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPolicy, "not a tokenfactory denom: %s", err)
+	}
+	return nil
+}
+
+// NewMsgSetDenomCapabilities creates a new MsgSetDenomCapabilities instance.
+func NewMsgSetDenomCapabilities(sender, denom string, capabilities []string) *MsgSetDenomCapabilities {
+	return &MsgSetDenomCapabilities{
+		Sender:       sender,
+		Denom:        denom,
+		Capabilities: capabilities,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgSetDenomCapabilities.
+func (msg *MsgSetDenomCapabilities) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	return DenomCapabilities{Denom: msg.Denom, Capabilities: msg.Capabilities}.Validate()
+}
+
+// NewMsgSetBeforeSendHook creates a new MsgSetBeforeSendHook instance.
+func NewMsgSetBeforeSendHook(sender, denom, contractAddress string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:          sender,
+		Denom:           denom,
+		ContractAddress: contractAddress,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgSetBeforeSendHook.
+func (msg *MsgSetBeforeSendHook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if msg.ContractAddress == "" {
+		if _, _, err := tokenfactorytypes.DeconstructDenom(msg.Denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidHook, "not a tokenfactory denom: %s", err)
+		}
+		return nil
+	}
+	return BeforeSendHook{Denom: msg.Denom, ContractAddress: msg.ContractAddress}.Validate()
+}
+
+// NewMsgSetSupplyPolicy creates a new MsgSetSupplyPolicy instance.
+func NewMsgSetSupplyPolicy(sender string, policy SupplyPolicy) *MsgSetSupplyPolicy {
+	return &MsgSetSupplyPolicy{
+		Sender: sender,
+		Policy: policy,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgSetSupplyPolicy.
+func (msg *MsgSetSupplyPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	return msg.Policy.Validate()
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic performs stateless validation of MsgUpdateParams.
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+	return msg.Params.Validate()
+}
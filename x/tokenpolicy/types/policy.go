@@ -0,0 +1,68 @@
+package types
+
+import (
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// Validate checks that the policy is for a tokenfactory denom and that its
+// channels and quota are well formed.
+func (p IBCPolicy) Validate() error {
+	if _, _, err := tokenfactorytypes.DeconstructDenom(p.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPolicy, "not a tokenfactory denom: %s", err)
+	}
+
+	seen := make(map[string]struct{}, len(p.AllowedChannels))
+	for _, id := range p.AllowedChannels {
+		if host.ChannelIdentifierValidator(id) != nil && host.ClientIdentifierValidator(id) != nil {
+			return errorsmod.Wrapf(ErrInvalidPolicy, "%q is neither a channel nor a client identifier", id)
+		}
+		if _, ok := seen[id]; ok {
+			return errorsmod.Wrapf(ErrInvalidPolicy, "duplicate channel %s", id)
+		}
+		seen[id] = struct{}{}
+	}
+
+	if p.OutboundQuota.IsNil() || p.OutboundQuota.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidPolicy, "outbound quota must not be negative")
+	}
+	if p.HasQuota() && p.QuotaPeriod <= 0 {
+		return errorsmod.Wrap(ErrInvalidPolicy, "quota period must be positive")
+	}
+	if !p.HasQuota() && p.QuotaPeriod != 0 {
+		return errorsmod.Wrap(ErrInvalidPolicy, "quota period set without an outbound quota")
+	}
+	return nil
+}
+
+// HasQuota reports whether the policy limits the amount sent out per period.
+func (p IBCPolicy) HasQuota() bool {
+	return !p.OutboundQuota.IsNil() && p.OutboundQuota.IsPositive()
+}
+
+// AllowsChannel reports whether the denom may be sent over the channel, or
+// client for IBC v2. The quota is not checked.
+func (p IBCPolicy) AllowsChannel(channelID string) error {
+	if p.Disabled {
+		return errorsmod.Wrap(ErrTransferDisabled, p.Denom)
+	}
+	if len(p.AllowedChannels) > 0 && !slices.Contains(p.AllowedChannels, channelID) {
+		return errorsmod.Wrapf(ErrChannelNotAllowed, "%s on %s", p.Denom, channelID)
+	}
+	return nil
+}
+
+// CurrentFlow returns the flow of the quota period containing now. A flow
+// from an earlier period is reset to zero starting at now.
+func (p IBCPolicy) CurrentFlow(flow OutboundFlow, now time.Time) OutboundFlow {
+	if flow.Amount.IsNil() || !now.Before(flow.PeriodStart.Add(p.QuotaPeriod)) {
+		return OutboundFlow{Denom: p.Denom, Amount: math.ZeroInt(), PeriodStart: now}
+	}
+	return flow
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// Validate checks that the policy is for a tokenfactory denom and that its
+// cap and mint limit are well formed.
+func (p SupplyPolicy) Validate() error {
+	if _, _, err := tokenfactorytypes.DeconstructDenom(p.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSupplyPolicy, "not a tokenfactory denom: %s", err)
+	}
+	if p.MaxSupply.IsNil() || p.MaxSupply.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidSupplyPolicy, "max supply must not be negative")
+	}
+	if p.MintLimit.IsNil() || p.MintLimit.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidSupplyPolicy, "mint limit must not be negative")
+	}
+	if p.HasMintLimit() && p.MintPeriod <= 0 {
+		return errorsmod.Wrap(ErrInvalidSupplyPolicy, "mint period must be positive")
+	}
+	if !p.HasMintLimit() && p.MintPeriod != 0 {
+		return errorsmod.Wrap(ErrInvalidSupplyPolicy, "mint period set without a mint limit")
+	}
+	return nil
+}
+
+// HasMaxSupply reports whether the policy caps the supply.
+func (p SupplyPolicy) HasMaxSupply() bool {
+	return !p.MaxSupply.IsNil() && p.MaxSupply.IsPositive()
+}
+
+// HasMintLimit reports whether the policy limits the amount minted per
+// period.
+func (p SupplyPolicy) HasMintLimit() bool {
+	return !p.MintLimit.IsNil() && p.MintLimit.IsPositive()
+}
+
+// ValidateUpdate returns an error unless next keeps the supply cap of the
+// policy and does not loosen its mint limit.
+func (p SupplyPolicy) ValidateUpdate(next SupplyPolicy) error {
+	if p.HasMaxSupply() && !next.MaxSupply.Equal(p.MaxSupply) {
+		return errorsmod.Wrapf(ErrInvalidSupplyPolicy, "the max supply of %s is fixed at %s", p.Denom, p.MaxSupply)
+	}
+	if p.HasMintLimit() {
+		if !next.HasMintLimit() || next.MintLimit.GT(p.MintLimit) {
+			return errorsmod.Wrapf(ErrInvalidSupplyPolicy, "the mint limit of %s can only be lowered from %s", p.Denom, p.MintLimit)
+		}
+		if next.MintPeriod < p.MintPeriod {
+			return errorsmod.Wrapf(ErrInvalidSupplyPolicy, "the mint period of %s can only be lengthened from %s", p.Denom, p.MintPeriod)
+		}
+	}
+	return nil
+}
+
+// CurrentFlow returns the flow of the mint period containing now. A flow from
+// an earlier period is reset to zero starting at now.
+func (p SupplyPolicy) CurrentFlow(flow MintFlow, now time.Time) MintFlow {
+	if flow.Amount.IsNil() || !now.Before(flow.PeriodStart.Add(p.MintPeriod)) {
+		return MintFlow{Denom: p.Denom, Amount: math.ZeroInt(), PeriodStart: now}
+	}
+	return flow
+}
@@ -0,0 +1,136 @@
+package v2
+
+import (
+	"bytes"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/keeper"
+)
+
+var (
+	_ api.IBCModule             = IBCMiddleware{}
+	_ api.PacketDataUnmarshaler = IBCMiddleware{}
+)
+
+// IBCMiddleware enforces the IBC policies of tokenfactory denoms on the IBC
+// v2 transfer application it wraps and refunds failed transfers without
+// calling the before send hooks. IBC v2 packets are identified by the client
+// on this chain.
+type IBCMiddleware struct {
+	app    api.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer stack.
+func NewIBCMiddleware(app api.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		app:    app,
+		keeper: k,
+	}
+}
+
+// OnSendPacket implements the IBCModule interface. Transfers the IBC policy
+// of their denom does not allow fail the send.
+func (im IBCMiddleware) OnSendPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	signer sdk.AccAddress,
+) error {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return err
+	}
+	amount, ok := math.NewIntFromString(data.Token.Amount)
+	if !ok {
+		return transfertypes.ErrInvalidAmount.Wrapf("unable to parse transfer amount: %s", data.Token.Amount)
+	}
+
+	if err := im.keeper.CheckSend(ctx, sourceClient, data.Token.Denom.IBCDenom(), amount); err != nil {
+		return err
+	}
+
+	return im.app.OnSendPacket(ctx, sourceClient, destinationClient, sequence, payload, signer)
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	return im.app.OnRecvPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. Timed out transfers are
+// returned to the outbound quota.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	ctx = keeper.WithRefundEscrow(ctx, transfertypes.GetEscrowAddress(payload.SourcePort, sourceClient))
+	if err := im.app.OnTimeoutPacket(ctx, sourceClient, destinationClient, sequence, payload, relayer); err != nil {
+		return err
+	}
+
+	im.refund(ctx, payload)
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. Transfers
+// acknowledged with an error are returned to the outbound quota.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	ctx = keeper.WithRefundEscrow(ctx, transfertypes.GetEscrowAddress(payload.SourcePort, sourceClient))
+	if err := im.app.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+
+	if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		im.refund(ctx, payload)
+	}
+	return nil
+}
+
+// refund returns the amount of a failed transfer to the outbound quota of its
+// denom.
+func (im IBCMiddleware) refund(ctx sdk.Context, payload channeltypesv2.Payload) {
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return
+	}
+	amount, ok := math.NewIntFromString(data.Token.Amount)
+	if !ok {
+		return
+	}
+	im.keeper.RefundSend(ctx, data.Token.Denom.IBCDenom(), amount)
+}
+
+// UnmarshalPacketData implements the PacketDataUnmarshaler interface by
+// delegating to the wrapped app.
+func (im IBCMiddleware) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return im.app.(api.PacketDataUnmarshaler).UnmarshalPacketData(payload)
+}
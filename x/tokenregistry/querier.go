@@ -0,0 +1,195 @@
+/*
+Package tokenregistry serves the tokenfactory denom registry, a query service
+returning every tokenfactory denom with the state clients would otherwise join
+from several queries.
+
+  - The creator, admin and bank metadata of the denom
+  - Its supply and the number of accounts holding it
+  - The amount escrowed by IBC transfers, in total and per transfer channel
+  - Its supply policy from the tokenpolicy module
+
+The service has no state of its own. It is registered on the gRPC query
+router and the API server next to the tokenfactory routes.
+*/
+package tokenregistry
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	"github.com/hyphacoop/cosmos-enoki/x/tokenregistry/types"
+)
+
+// Querier implements the tokenregistry query service.
+type Querier struct {
+	tokenFactoryKeeper types.TokenFactoryKeeper
+	bankKeeper         types.BankKeeper
+	transferKeeper     types.TransferKeeper
+	channelKeeper      types.ChannelKeeper
+	tokenPolicyKeeper  types.TokenPolicyKeeper
+}
+
+var _ types.QueryServer = Querier{}
+
+// NewQuerier returns a new tokenregistry querier.
+func NewQuerier(
+	tokenFactoryKeeper types.TokenFactoryKeeper,
+	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
+	tokenPolicyKeeper types.TokenPolicyKeeper,
+) Querier {
+	return Querier{
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		bankKeeper:         bankKeeper,
+		transferKeeper:     transferKeeper,
+		channelKeeper:      channelKeeper,
+		tokenPolicyKeeper:  tokenPolicyKeeper,
+	}
+}
+
+// Denom returns a tokenfactory denom with its registry information.
+func (q Querier) Denom(goCtx context.Context, req *types.QueryDenomRequest) (*types.QueryDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	creator, _, err := tokenfactorytypes.DeconstructDenom(req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !q.tokenFactoryKeeper.GetCreatorPrefixStore(ctx, creator).Has([]byte(req.Denom)) {
+		return nil, status.Errorf(codes.NotFound, "tokenfactory denom %s not found", req.Denom)
+	}
+
+	info, err := q.denomInfo(ctx, req.Denom, true)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomResponse{Denom: info}, nil
+}
+
+// Denoms lists the tokenfactory denoms with their registry information,
+// optionally only those of a creator or admin, or with or without bank
+// metadata. The filters only read the authority and bank metadata of a
+// denom, the rest of its information is joined for the denoms of the page.
+func (q Querier) Denoms(goCtx context.Context, req *types.QueryDenomsRequest) (*types.QueryDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := q.tokenFactoryKeeper.GetCreatorsPrefixStore(ctx)
+	if req.Creator != "" {
+		store = q.tokenFactoryKeeper.GetCreatorPrefixStore(ctx, req.Creator)
+	}
+
+	var denoms []types.DenomInfo
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		denom := string(value)
+		if req.Admin != "" {
+			authority, err := q.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+			if err != nil {
+				return false, err
+			}
+			if authority.Admin != req.Admin {
+				return false, nil
+			}
+		}
+		if req.Metadata != types.METADATA_FILTER_UNSPECIFIED {
+			metadata, ok := q.bankKeeper.GetDenomMetaData(ctx, denom)
+			hasMetadata := ok && !isPlaceholderMetadata(metadata)
+			if hasMetadata != (req.Metadata == types.METADATA_FILTER_WITH) {
+				return false, nil
+			}
+		}
+		if !accumulate {
+			return true, nil
+		}
+
+		info, err := q.denomInfo(ctx, denom, req.CountHolders)
+		if err != nil {
+			return false, err
+		}
+		denoms = append(denoms, info)
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
+
+// denomInfo joins the registry information of a tokenfactory denom. Holders
+// are only counted with countHolders.
+func (q Querier) denomInfo(ctx sdk.Context, denom string, countHolders bool) (types.DenomInfo, error) {
+	creator, _, err := tokenfactorytypes.DeconstructDenom(denom)
+	if err != nil {
+		return types.DenomInfo{}, err
+	}
+	authority, err := q.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return types.DenomInfo{}, err
+	}
+
+	info := types.DenomInfo{
+		Denom:           denom,
+		Creator:         creator,
+		Admin:           authority.Admin,
+		Supply:          q.bankKeeper.GetSupply(ctx, denom),
+		IbcEscrowed:     q.transferKeeper.GetTotalEscrowForDenom(ctx, denom),
+		ChannelExposure: []types.ChannelExposure{},
+	}
+	if countHolders {
+		owners, err := q.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+			Denom:      denom,
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		if err != nil {
+			return types.DenomInfo{}, err
+		}
+		info.Holders = owners.Pagination.Total
+	}
+	if metadata, ok := q.bankKeeper.GetDenomMetaData(ctx, denom); ok {
+		info.Metadata = &metadata
+		info.HasMetadata = !isPlaceholderMetadata(metadata)
+	}
+	if policy, ok, err := q.tokenPolicyKeeper.GetSupplyPolicy(ctx, denom); err != nil {
+		return types.DenomInfo{}, err
+	} else if ok {
+		info.SupplyPolicy = &policy
+	}
+
+	if !info.IbcEscrowed.IsZero() {
+		for _, channel := range q.channelKeeper.GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID) {
+			escrowed := q.bankKeeper.GetBalance(ctx, transfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId), denom)
+			if escrowed.IsPositive() {
+				info.ChannelExposure = append(info.ChannelExposure, types.ChannelExposure{
+					ChannelId: channel.ChannelId,
+					Escrowed:  escrowed.Amount,
+				})
+			}
+		}
+	}
+	return info, nil
+}
+
+// isPlaceholderMetadata reports whether metadata is the placeholder
+// tokenfactory sets when creating a denom, which only names the base denom.
+func isPlaceholderMetadata(metadata banktypes.Metadata) bool {
+	denom := metadata.Base
+	return metadata.Display == denom && metadata.Name == denom && metadata.Symbol == denom &&
+		metadata.Description == "" && metadata.URI == "" && len(metadata.DenomUnits) <= 1
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	tokenpolicytypes "github.com/hyphacoop/cosmos-enoki/x/tokenpolicy/types"
+)
+
+// TokenFactoryKeeper defines the tokenfactory methods used to list denoms and
+// their admins.
+type TokenFactoryKeeper interface {
+	GetCreatorsPrefixStore(ctx sdk.Context) storetypes.KVStore
+	GetCreatorPrefixStore(ctx sdk.Context, creator string) storetypes.KVStore
+	GetAuthorityMetadata(ctx context.Context, denom string) (tokenfactorytypes.DenomAuthorityMetadata, error)
+}
+
+// BankKeeper defines the bank methods used to read the metadata, supply and
+// holders of a denom.
+type BankKeeper interface {
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	DenomOwners(ctx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
+}
+
+// TransferKeeper defines the IBC transfer methods used to read the escrowed
+// amount of a denom.
+type TransferKeeper interface {
+	GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin
+}
+
+// ChannelKeeper defines the IBC channel methods used to list transfer
+// channels.
+type ChannelKeeper interface {
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+}
+
+// TokenPolicyKeeper defines the tokenpolicy methods used to read the supply
+// policy of a denom.
+type TokenPolicyKeeper interface {
+	GetSupplyPolicy(ctx context.Context, denom string) (tokenpolicytypes.SupplyPolicy, bool, error)
+}